@@ -0,0 +1,277 @@
+package authorization
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	Error "github.com/starkinfra/core-go/starkcore/error"
+	"github.com/starkinfra/core-go/starkcore/user/user"
+	IssuingPurchase "github.com/starkinfra/sdk-go/starkinfra/issuingpurchase"
+	IssuingToken "github.com/starkinfra/sdk-go/starkinfra/issuingtoken"
+	PixRequest "github.com/starkinfra/sdk-go/starkinfra/pixrequest"
+	PixReversal "github.com/starkinfra/sdk-go/starkinfra/pixreversal"
+	"github.com/starkinfra/sdk-go/starkinfra/utils"
+	"io"
+	"net/http"
+	"time"
+)
+
+//	Authorization Handler struct
+//
+//	http.Handler that answers the authorization requests Stark Infra sends
+//	before settling inbound PixRequests and PixReversals, IssuingPurchases and
+//	IssuingToken authorizations and activations. Each request is routed by its
+//	url path to the decision callback registered for it, after its
+//	"Digital-Signature" header is checked against the Stark Infra public key.
+//
+//	Stark Infra only waits a short time for the answer, so the callback runs
+//	under a Deadline. If it does not return in time, panics or returns an
+//	answer that fails validation, such as a denial without a reason, the
+//	fallback answer registered with it is sent instead. Requests
+//	with an invalid signature are refused with status 401, and requests that
+//	cannot be verified at all, such as when the public key cannot be fetched,
+//	with status 500. The Deadline also covers the verification, and since no
+//	answer can be sent for unverified content, requests still being verified
+//	when it is reached, such as while the public key is fetched, get status 504.
+//
+//	Callbacks must be registered before the Handler starts serving requests.
+//
+//	Attributes:
+//	- Api [*utils.Client]: Client used to fetch the Stark Infra public key
+//	- Deadline [time.Duration, default 800ms]: Time budget of each request, from its arrival to its answer. ex: 500 * time.Millisecond
+//	- OnFallback [func(path string, cause error), default nil]: Called whenever a fallback answer is sent, with context.DeadlineExceeded, the error of the panic or the validation error of the answer
+//
+//	Example:
+//	handler := authorization.NewHandler(nil)
+//	handler.OnPixRequest("/pix-request", func(ctx context.Context, request PixRequest.PixRequest) PixRequest.Authorization {
+//		return PixRequest.Approve()
+//	}, PixRequest.Deny(PixRequest.ReasonOrderRejected))
+//	http.Handle("/", handler)
+
+type Handler struct {
+	Api        *utils.Client
+	Deadline   time.Duration
+	OnFallback func(path string, cause error)
+	routes     map[string]route
+}
+
+//	Authorization Client struct
+//
+//	Creates authorization Handlers with the settings of its own utils.Client
+//	instead of starkinfra.User and the other package defaults.
+
+type Client struct {
+	Api *utils.Client
+}
+
+type route struct {
+	parse    func(ctx context.Context, content string, signature string) (interface{}, Error.StarkErrors)
+	decide   func(ctx context.Context, request interface{}) (string, Error.StarkErrors)
+	fallback string
+}
+
+func NewHandler(user user.User) *Handler {
+	//	Create an authorization Handler
+	//
+	//	Parameters (optional):
+	//	- user [Organization/Project struct, default nil]: Organization or Project struct. Not necessary if starkinfra.User was set before function call
+	//
+	//	Return:
+	//	- Handler with no callbacks registered
+	return Client{Api: utils.Default(user)}.NewHandler()
+}
+
+func (c Client) NewHandler() *Handler {
+	//	Create an authorization Handler
+	return &Handler{Api: c.Api, routes: map[string]route{}}
+}
+
+func (h *Handler) OnPixRequest(path string, decide func(ctx context.Context, request PixRequest.PixRequest) PixRequest.Authorization, fallback PixRequest.Authorization) {
+	//	Register the decision callback for inbound PixRequest authorizations
+	//
+	//	Parameters (required):
+	//	- path [string]: Url path the authorizations are sent to. ex: "/pix-request"
+	//	- decide [func]: Callback returning the PixRequest.Authorization to be sent. ex: PixRequest.Approve()
+	//	- fallback [PixRequest.Authorization struct]: Answer sent if decide is too slow, panics or returns an invalid answer. Panics if it is invalid itself. ex: PixRequest.Deny(PixRequest.ReasonOrderRejected)
+	h.handle(path, route{
+		parse: func(ctx context.Context, content string, signature string) (interface{}, Error.StarkErrors) {
+			return PixRequest.Client{Api: h.Api}.ParseCtx(ctx, content, signature)
+		},
+		decide: func(ctx context.Context, request interface{}) (string, Error.StarkErrors) {
+			return decide(ctx, request.(PixRequest.PixRequest)).Response()
+		},
+		fallback: fallbackResponse(path, fallback.Response),
+	})
+}
+
+func (h *Handler) OnPixReversal(path string, decide func(ctx context.Context, reversal PixReversal.PixReversal) PixReversal.Authorization, fallback PixReversal.Authorization) {
+	//	Register the decision callback for inbound PixReversal authorizations
+	//
+	//	Parameters (required):
+	//	- path [string]: Url path the authorizations are sent to. ex: "/pix-reversal"
+	//	- decide [func]: Callback returning the PixReversal.Authorization to be sent. ex: PixReversal.Approve()
+	//	- fallback [PixReversal.Authorization struct]: Answer sent if decide is too slow, panics or returns an invalid answer. Panics if it is invalid itself. ex: PixReversal.Deny(PixReversal.ReasonOrderRejected)
+	h.handle(path, route{
+		parse: func(ctx context.Context, content string, signature string) (interface{}, Error.StarkErrors) {
+			return PixReversal.Client{Api: h.Api}.ParseCtx(ctx, content, signature)
+		},
+		decide: func(ctx context.Context, reversal interface{}) (string, Error.StarkErrors) {
+			return decide(ctx, reversal.(PixReversal.PixReversal)).Response()
+		},
+		fallback: fallbackResponse(path, fallback.Response),
+	})
+}
+
+func (h *Handler) OnIssuingPurchase(path string, decide func(ctx context.Context, purchase IssuingPurchase.IssuingPurchase) IssuingPurchase.Authorization, fallback IssuingPurchase.Authorization) {
+	//	Register the decision callback for IssuingPurchase authorizations
+	//
+	//	Parameters (required):
+	//	- path [string]: Url path the authorizations are sent to. ex: "/issuing-purchase"
+	//	- decide [func]: Callback returning the IssuingPurchase.Authorization to be sent. Validated against the IssuingPurchase with ValidateFor. ex: IssuingPurchase.Approve()
+	//	- fallback [IssuingPurchase.Authorization struct]: Answer sent if decide is too slow, panics or returns an invalid answer. Panics if it is invalid itself. ex: IssuingPurchase.Deny(IssuingPurchase.ReasonSubIssuerError)
+	h.handle(path, route{
+		parse: func(ctx context.Context, content string, signature string) (interface{}, Error.StarkErrors) {
+			return IssuingPurchase.Client{Api: h.Api}.ParseCtx(ctx, content, signature)
+		},
+		decide: func(ctx context.Context, request interface{}) (string, Error.StarkErrors) {
+			purchase := request.(IssuingPurchase.IssuingPurchase)
+			answer := decide(ctx, purchase)
+			if err := answer.ValidateFor(purchase); err.Errors != nil {
+				return "", err
+			}
+			return answer.Response()
+		},
+		fallback: fallbackResponse(path, fallback.Response),
+	})
+}
+
+func (h *Handler) OnIssuingTokenAuthorization(path string, decide func(ctx context.Context, token IssuingToken.IssuingToken) IssuingToken.Authorization, fallback IssuingToken.Authorization) {
+	//	Register the decision callback for IssuingToken authorizations
+	//
+	//	Parameters (required):
+	//	- path [string]: Url path the authorizations are sent to. ex: "/issuing-token/authorization"
+	//	- decide [func]: Callback returning the IssuingToken.Authorization to be sent. ex: IssuingToken.ApproveAuthorization("5656565656565656", IssuingToken.ActivationMethod{Type: "text", Value: "+5511989898989"})
+	//	- fallback [IssuingToken.Authorization struct]: Answer sent if decide is too slow, panics or returns an invalid answer. Panics if it is invalid itself. ex: IssuingToken.DenyAuthorization(IssuingToken.ReasonSubIssuerError)
+	h.handle(path, route{
+		parse: func(ctx context.Context, content string, signature string) (interface{}, Error.StarkErrors) {
+			return IssuingToken.Client{Api: h.Api}.ParseCtx(ctx, content, signature)
+		},
+		decide: func(ctx context.Context, token interface{}) (string, Error.StarkErrors) {
+			return decide(ctx, token.(IssuingToken.IssuingToken)).Response()
+		},
+		fallback: fallbackResponse(path, fallback.Response),
+	})
+}
+
+func (h *Handler) OnIssuingTokenActivation(path string, decide func(ctx context.Context, token IssuingToken.IssuingToken) IssuingToken.Activation, fallback IssuingToken.Activation) {
+	//	Register the decision callback for IssuingToken activations
+	//
+	//	Parameters (required):
+	//	- path [string]: Url path the activations are sent to. ex: "/issuing-token/activation"
+	//	- decide [func]: Callback returning the IssuingToken.Activation to be sent. ex: IssuingToken.ApproveActivation()
+	//	- fallback [IssuingToken.Activation struct]: Answer sent if decide is too slow, panics or returns an invalid answer. Panics if it is invalid itself. ex: IssuingToken.DenyActivation(IssuingToken.ReasonSubIssuerError)
+	h.handle(path, route{
+		parse: func(ctx context.Context, content string, signature string) (interface{}, Error.StarkErrors) {
+			return IssuingToken.Client{Api: h.Api}.ParseCtx(ctx, content, signature)
+		},
+		decide: func(ctx context.Context, token interface{}) (string, Error.StarkErrors) {
+			return decide(ctx, token.(IssuingToken.IssuingToken)).Response()
+		},
+		fallback: fallbackResponse(path, fallback.Response),
+	})
+}
+
+func fallbackResponse(path string, response func() (string, Error.StarkErrors)) string {
+	fallback, err := response()
+	if err.Errors != nil {
+		panic(fmt.Sprintf("invalid fallback answer for %v: %v", path, err.Errors[0].Message))
+	}
+	return fallback
+}
+
+func (h *Handler) handle(path string, callback route) {
+	if h.routes == nil {
+		h.routes = map[string]route{}
+	}
+	h.routes[path] = callback
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	callback, ok := h.routes[r.URL.Path]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "Authorizations must be sent with POST", http.StatusMethodNotAllowed)
+		return
+	}
+	ctx, cancel := context.WithTimeout(r.Context(), h.deadline())
+	defer cancel()
+
+	body, readError := io.ReadAll(r.Body)
+	if readError != nil {
+		http.Error(w, "The request body could not be read", http.StatusBadRequest)
+		return
+	}
+	request, err := callback.parse(ctx, string(body), r.Header.Get("Digital-Signature"))
+	if err.Errors != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		http.Error(w, "The authorization request was not verified in time", http.StatusGatewayTimeout)
+		return
+	}
+	if err.Errors != nil {
+		status := http.StatusInternalServerError
+		if err.Errors[0].Code == "invalidSignatureError" {
+			status = http.StatusUnauthorized
+		}
+		http.Error(w, fmt.Sprintf("%v: %v", err.Errors[0].Code, err.Errors[0].Message), status)
+		return
+	}
+
+	answer, cause := decide(ctx, callback, request)
+	if cause != nil {
+		answer = callback.fallback
+		if h.OnFallback != nil {
+			h.OnFallback(r.URL.Path, cause)
+		}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	io.WriteString(w, answer)
+}
+
+func decide(ctx context.Context, callback route, request interface{}) (string, error) {
+	type result struct {
+		answer string
+		cause  error
+	}
+	results := make(chan result, 1)
+	go func() {
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				results <- result{cause: fmt.Errorf("authorization callback panicked: %v", recovered)}
+			}
+		}()
+		answer, err := callback.decide(ctx, request)
+		if err.Errors != nil {
+			results <- result{cause: fmt.Errorf("authorization callback returned an invalid answer: %v", err.Errors[0].Message)}
+			return
+		}
+		results <- result{answer: answer}
+	}()
+
+	select {
+	case result := <-results:
+		return result.answer, result.cause
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+}
+
+func (h *Handler) deadline() time.Duration {
+	if h.Deadline <= 0 {
+		return 800 * time.Millisecond
+	}
+	return h.Deadline
+}
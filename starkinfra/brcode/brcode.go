@@ -0,0 +1,376 @@
+package brcode
+
+import (
+	"fmt"
+	Error "github.com/starkinfra/core-go/starkcore/error"
+	"github.com/starkinfra/sdk-go/starkinfra/utils"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+//	Brcode struct
+//
+//	Decoded form of a BR Code, the EMV "copia e cola" string of a Pix QR Code,
+//	such as StaticBrcode.Id, DynamicBrcode.Id or IssuingBillingInvoice.Brcode.
+//	Parse reads one without any request to the API, checking its CRC, and Encode
+//	builds one, filling in the default of every empty field and the CRC.
+//
+//	Attributes:
+//	- MerchantAccount [MerchantAccount struct]: Pix merchant account information (ID 26). ex: MerchantAccount{KeyId: "+5511989898989"}
+//	- InitiationMethod [string, default nil]: Point of initiation method (ID 01). Options: "11" for reusable and "12" for single use BR Codes
+//	- MerchantCategoryCode [string, default "0000"]: Merchant category code (ID 52). ex: "0000"
+//	- Currency [string, default "986"]: ISO 4217 numeric currency code (ID 53). ex: "986"
+//	- Amount [int, default 0]: Amount in cents (ID 54). If zero, the payer chooses the amount. ex: 1234 (= R$ 12.34)
+//	- Country [string, default "BR"]: ISO 3166 country code (ID 58). ex: "BR"
+//	- Name [string]: Receiver name, up to 25 characters (ID 59). ex: "Tony Stark"
+//	- City [string]: Receiver city, up to 15 characters (ID 60). ex: "Sao Paulo"
+//	- ZipCode [string, default nil]: Receiver postal code (ID 61). ex: "01311200"
+//	- ReconciliationId [string, default "***"]: Reconciliation id, up to 25 alphanumeric characters, or "***" if there is none (ID 62, subfield 05). ex: "ah27s53agj6493hjds6836v49"
+//	- AdditionalData [slice of Field structs, default nil]: Subfields of the additional data field (ID 62) other than the ReconciliationId, kept so the BR Code can be encoded again. ex: []Field{{Id: "50", Value: "0017br.gov.bcb.brcode"}}
+//	- Fields [slice of Field structs, default nil]: Top-level fields not listed above, such as other merchant account templates, kept so the BR Code can be encoded again
+//
+//	Attributes (return-only):
+//	- Crc [string]: CRC read by Parse (ID 63). Encode always computes it again. ex: "FC6C"
+
+type Brcode struct {
+	MerchantAccount      MerchantAccount
+	InitiationMethod     string
+	MerchantCategoryCode string
+	Currency             string
+	Amount               int
+	Country              string
+	Name                 string
+	City                 string
+	ZipCode              string
+	ReconciliationId     string
+	AdditionalData       []Field
+	Fields               []Field
+	Crc                  string
+}
+
+//	MerchantAccount struct
+//
+//	Pix merchant account information of a BR Code. Static BR Codes carry the
+//	receiver Pix key, while dynamic ones carry the url their payload is read from.
+//
+//	Attributes:
+//	- Id [string, default "26"]: Field ID of the account, from "26" to "51". ex: "26"
+//	- Gui [string, default "br.gov.bcb.pix"]: Globally unique identifier of the arrangement (subfield 00). ex: "br.gov.bcb.pix"
+//	- KeyId [string, default nil]: Receiver Pix key, for static BR Codes (subfield 01). ex: "+5511989898989"
+//	- Description [string, default nil]: Description shown to the payer, for static BR Codes (subfield 02). ex: "Payment for service #1234"
+//	- Url [string, default nil]: Payload url without its scheme, for dynamic BR Codes (subfield 25). ex: "brcode-h.sandbox.starkinfra.com/v2/97756273400d42ce9086404fe10ea0d6"
+//	- Fields [slice of Field structs, default nil]: Subfields not listed above, kept so the BR Code can be encoded again. ex: []Field{{Id: "03", Value: "0000"}}
+
+type MerchantAccount struct {
+	Id          string
+	Gui         string
+	KeyId       string
+	Description string
+	Url         string
+	Fields      []Field
+}
+
+//	Field struct
+//
+//	Raw EMV field of a BR Code.
+//
+//	Attributes:
+//	- Id [string]: Two digit field ID. ex: "80"
+//	- Value [string]: Field value, up to 99 characters. ex: "0014br.com.example"
+
+type Field struct {
+	Id    string
+	Value string
+}
+
+const pixGui = "br.gov.bcb.pix"
+
+var reconciliationIdPattern = regexp.MustCompile(`^([a-zA-Z0-9]{1,25}|\*\*\*)$`)
+var amountPattern = regexp.MustCompile(`^\d+(\.\d{1,2})?$`)
+
+func Parse(code string) (Brcode, Error.StarkErrors) {
+	//	Decode a BR Code
+	//
+	//	Parameters (required):
+	//	- code [string]: BR Code string. ex: "00020126360014br.gov.bcb.pix0114+552840092118152040000530398654040.095802BR5915Jamie Lannister6009Sao Paulo620705038566304FC6C"
+	//
+	//	Return:
+	//	- Brcode struct, or an inputError if code is not a well formed BR Code with a matching CRC
+	var brcode Brcode
+	fields, err := split(code)
+	if err.Errors != nil {
+		return brcode, err
+	}
+	if len(fields) < 2 || fields[0].Id != "00" || fields[0].Value != "01" {
+		return brcode, utils.ValidationError("A BR Code must start with the payload format indicator \"000201\"")
+	}
+	last := fields[len(fields)-1]
+	if last.Id != "63" || len(last.Value) != 4 {
+		return brcode, utils.ValidationError("A BR Code must end with a 4 digit CRC field \"6304\"")
+	}
+	if expected := Crc(code[:len(code)-4]); !strings.EqualFold(expected, last.Value) {
+		return brcode, utils.ValidationError(fmt.Sprintf("BR Code CRC %v does not match its content, expected %v", last.Value, expected))
+	}
+	brcode.Crc = strings.ToUpper(last.Value)
+
+	for _, field := range fields[1 : len(fields)-1] {
+		switch field.Id {
+		case "01":
+			brcode.InitiationMethod = field.Value
+		case "52":
+			brcode.MerchantCategoryCode = field.Value
+		case "53":
+			brcode.Currency = field.Value
+		case "54":
+			if !amountPattern.MatchString(field.Value) {
+				return brcode, utils.ValidationError(fmt.Sprintf("Invalid BR Code amount %q", field.Value))
+			}
+			brcode.Amount = cents(field.Value)
+		case "58":
+			brcode.Country = field.Value
+		case "59":
+			brcode.Name = field.Value
+		case "60":
+			brcode.City = field.Value
+		case "61":
+			brcode.ZipCode = field.Value
+		case "62":
+			subfields, err := split(field.Value)
+			if err.Errors != nil {
+				return brcode, err
+			}
+			for _, subfield := range subfields {
+				if subfield.Id == "05" {
+					brcode.ReconciliationId = subfield.Value
+					continue
+				}
+				brcode.AdditionalData = append(brcode.AdditionalData, subfield)
+			}
+		default:
+			if account, ok := merchantAccount(field); ok && brcode.MerchantAccount.Gui == "" {
+				brcode.MerchantAccount = account
+				continue
+			}
+			brcode.Fields = append(brcode.Fields, field)
+		}
+	}
+	if brcode.MerchantAccount.Gui == "" {
+		return brcode, utils.ValidationError("A BR Code must have a Pix merchant account information field")
+	}
+	return brcode, Error.StarkErrors{}
+}
+
+func (b Brcode) Encode() (string, Error.StarkErrors) {
+	//	Build the BR Code string
+	//
+	//	Return:
+	//	- BR Code string ending with its CRC, or an inputError if a field is missing or out of bounds
+	b = b.withDefaults()
+	if err := b.Validate(); err.Errors != nil {
+		return "", err
+	}
+
+	account := []Field{{"00", b.MerchantAccount.Gui}}
+	if b.MerchantAccount.KeyId != "" {
+		account = append(account, Field{"01", b.MerchantAccount.KeyId})
+	}
+	if b.MerchantAccount.Description != "" {
+		account = append(account, Field{"02", b.MerchantAccount.Description})
+	}
+	if b.MerchantAccount.Url != "" {
+		account = append(account, Field{"25", b.MerchantAccount.Url})
+	}
+	account = append(account, b.MerchantAccount.Fields...)
+	sortFields(account)
+
+	fields := []Field{{"00", "01"}}
+	if b.InitiationMethod != "" {
+		fields = append(fields, Field{"01", b.InitiationMethod})
+	}
+	fields = append(fields, Field{b.MerchantAccount.Id, join(account)}, Field{"52", b.MerchantCategoryCode}, Field{"53", b.Currency})
+	if b.Amount > 0 {
+		fields = append(fields, Field{"54", fmt.Sprintf("%d.%02d", b.Amount/100, b.Amount%100)})
+	}
+	fields = append(fields, Field{"58", b.Country}, Field{"59", b.Name}, Field{"60", b.City})
+	if b.ZipCode != "" {
+		fields = append(fields, Field{"61", b.ZipCode})
+	}
+	additionalData := append([]Field{{"05", b.ReconciliationId}}, b.AdditionalData...)
+	sortFields(additionalData)
+	fields = append(fields, Field{"62", join(additionalData)})
+	fields = append(fields, b.Fields...)
+	sortFields(fields)
+
+	for _, field := range fields {
+		if len(field.Value) > 99 {
+			return "", utils.ValidationError(fmt.Sprintf("BR Code field %v is longer than 99 characters", field.Id))
+		}
+	}
+	payload := join(fields) + "6304"
+	return payload + Crc(payload), Error.StarkErrors{}
+}
+
+func (b Brcode) Validate() Error.StarkErrors {
+	//	Check the Brcode fields before it is encoded
+	//
+	//	Empty fields with a default are checked as Encode fills them in.
+	//
+	//	Return:
+	//	- inputError if a required field is missing or a field is out of bounds
+	b = b.withDefaults()
+	if b.MerchantAccount.KeyId == "" && b.MerchantAccount.Url == "" {
+		return utils.ValidationError("A BR Code needs either a MerchantAccount KeyId or Url")
+	}
+	if id, _ := strconv.Atoi(b.MerchantAccount.Id); !isField(b.MerchantAccount.Id) || id < 26 || id > 51 {
+		return utils.ValidationError(fmt.Sprintf("BR Code MerchantAccount Id must be from \"26\" to \"51\", got %q", b.MerchantAccount.Id))
+	}
+	for _, field := range b.MerchantAccount.Fields {
+		if !isField(field.Id) || field.Id == "00" || field.Id == "01" || field.Id == "02" || field.Id == "25" {
+			return utils.ValidationError(fmt.Sprintf("BR Code MerchantAccount Fields cannot have ID %q, which is invalid or set through the MerchantAccount attributes", field.Id))
+		}
+	}
+	if b.InitiationMethod != "" && b.InitiationMethod != "11" && b.InitiationMethod != "12" {
+		return utils.ValidationError(fmt.Sprintf("BR Code InitiationMethod must be \"11\" or \"12\", got %q", b.InitiationMethod))
+	}
+	if b.Amount < 0 {
+		return utils.ValidationError(fmt.Sprintf("BR Code Amount cannot be negative, got %v", b.Amount))
+	}
+	if b.Name == "" || len(b.Name) > 25 {
+		return utils.ValidationError(fmt.Sprintf("BR Code Name must have from 1 to 25 characters, got %q", b.Name))
+	}
+	if b.City == "" || len(b.City) > 15 {
+		return utils.ValidationError(fmt.Sprintf("BR Code City must have from 1 to 15 characters, got %q", b.City))
+	}
+	if !reconciliationIdPattern.MatchString(b.ReconciliationId) {
+		return utils.ValidationError(fmt.Sprintf("BR Code ReconciliationId must have up to 25 alphanumeric characters or be \"***\", got %q", b.ReconciliationId))
+	}
+	for _, field := range b.AdditionalData {
+		if !isField(field.Id) || field.Id == "05" {
+			return utils.ValidationError(fmt.Sprintf("BR Code AdditionalData cannot have ID %q, which is invalid or set through the ReconciliationId", field.Id))
+		}
+	}
+	for _, field := range b.Fields {
+		id, _ := strconv.Atoi(field.Id)
+		if !isField(field.Id) || known(id) || field.Id == b.MerchantAccount.Id {
+			return utils.ValidationError(fmt.Sprintf("BR Code Fields cannot have ID %q, which is invalid or set through the Brcode attributes", field.Id))
+		}
+	}
+	return Error.StarkErrors{}
+}
+
+func (b Brcode) withDefaults() Brcode {
+	if b.MerchantAccount.Id == "" {
+		b.MerchantAccount.Id = "26"
+	}
+	if b.MerchantAccount.Gui == "" {
+		b.MerchantAccount.Gui = pixGui
+	}
+	if b.MerchantCategoryCode == "" {
+		b.MerchantCategoryCode = "0000"
+	}
+	if b.Currency == "" {
+		b.Currency = "986"
+	}
+	if b.Country == "" {
+		b.Country = "BR"
+	}
+	if b.ReconciliationId == "" {
+		b.ReconciliationId = "***"
+	}
+	return b
+}
+
+func known(id int) bool {
+	switch id {
+	case 0, 1, 52, 53, 54, 58, 59, 60, 61, 62, 63:
+		return true
+	}
+	return false
+}
+
+func merchantAccount(field Field) (MerchantAccount, bool) {
+	account := MerchantAccount{Id: field.Id}
+	id, _ := strconv.Atoi(field.Id)
+	if id < 26 || id > 51 {
+		return account, false
+	}
+	subfields, err := split(field.Value)
+	if err.Errors != nil || len(subfields) == 0 || subfields[0].Id != "00" || !strings.EqualFold(subfields[0].Value, pixGui) {
+		return account, false
+	}
+	for _, subfield := range subfields {
+		switch subfield.Id {
+		case "00":
+			account.Gui = subfield.Value
+		case "01":
+			account.KeyId = subfield.Value
+		case "02":
+			account.Description = subfield.Value
+		case "25":
+			account.Url = subfield.Value
+		default:
+			account.Fields = append(account.Fields, subfield)
+		}
+	}
+	return account, true
+}
+
+func split(content string) ([]Field, Error.StarkErrors) {
+	var fields []Field
+	for position := 0; position < len(content); {
+		if position+4 > len(content) {
+			return nil, utils.ValidationError(fmt.Sprintf("Truncated BR Code field at position %v", position))
+		}
+		id := content[position : position+2]
+		length, err := strconv.Atoi(content[position+2 : position+4])
+		if err != nil || !isDigits(id) || !isDigits(content[position+2:position+4]) {
+			return nil, utils.ValidationError(fmt.Sprintf("Invalid BR Code field header %q at position %v", content[position:position+4], position))
+		}
+		if position+4+length > len(content) {
+			return nil, utils.ValidationError(fmt.Sprintf("BR Code field %v at position %v is longer than the remaining content", id, position))
+		}
+		fields = append(fields, Field{Id: id, Value: content[position+4 : position+4+length]})
+		position += 4 + length
+	}
+	return fields, Error.StarkErrors{}
+}
+
+func join(fields []Field) string {
+	var builder strings.Builder
+	for _, field := range fields {
+		builder.WriteString(fmt.Sprintf("%v%02d%v", field.Id, len(field.Value), field.Value))
+	}
+	return builder.String()
+}
+
+func sortFields(fields []Field) {
+	sort.SliceStable(fields, func(i, j int) bool {
+		return fields[i].Id < fields[j].Id
+	})
+}
+
+func isField(id string) bool {
+	return len(id) == 2 && isDigits(id)
+}
+
+func isDigits(value string) bool {
+	for _, character := range value {
+		if character < '0' || character > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func cents(amount string) int {
+	parts := strings.SplitN(amount, ".", 2)
+	reais, _ := strconv.Atoi(parts[0])
+	total := reais * 100
+	if len(parts) == 2 {
+		decimals, _ := strconv.Atoi((parts[1] + "0")[:2])
+		total += decimals
+	}
+	return total
+}
@@ -0,0 +1,31 @@
+package brcode
+
+import (
+	"fmt"
+)
+
+func Crc(payload string) string {
+	//	Compute the CRC of a BR Code
+	//
+	//	CRC16-CCITT with polynomial 0x1021 and initial value 0xFFFF, as required
+	//	by the EMV QR Code specification, over every character of the BR Code up
+	//	to and including the ID and length of the CRC field itself.
+	//
+	//	Parameters (required):
+	//	- payload [string]: BR Code up to "6304". ex: "00020126360014br.gov.bcb.pix...6304"
+	//
+	//	Return:
+	//	- CRC as 4 uppercase hexadecimal digits. ex: "FC6C"
+	crc := uint16(0xFFFF)
+	for i := 0; i < len(payload); i++ {
+		crc ^= uint16(payload[i]) << 8
+		for bit := 0; bit < 8; bit++ {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return fmt.Sprintf("%04X", crc)
+}
@@ -0,0 +1,160 @@
+package brcode
+
+import (
+	"bytes"
+	"fmt"
+	QrCode "github.com/skip2/go-qrcode"
+	Error "github.com/starkinfra/core-go/starkcore/error"
+	"github.com/starkinfra/sdk-go/starkinfra/utils"
+	"image"
+	"image/color"
+	"image/png"
+	"strings"
+)
+
+//	Image struct
+//
+//	Settings to render a BR Code as a QR Code locally, instead of downloading
+//	the image at StaticBrcode.Url or DynamicBrcode.Url. The BR Code is checked
+//	with Parse before it is rendered, so a code with a wrong CRC is never printed.
+//
+//	Attributes:
+//	- Size [int, default 256]: Width and height of the image in pixels. Modules are kept a whole number of pixels wide and any leftover pixels go to the quiet zone. ex: 512
+//	- QuietZone [*int, default 4]: Width of the blank border around the code, in modules. ex: &margin
+//	- Level [string, default LevelMedium]: Error correction level. Options: LevelLow, LevelMedium, LevelQuartile, LevelHigh
+
+type Image struct {
+	Size      int
+	QuietZone *int
+	Level     string
+}
+
+//	Error correction levels, each restoring up to the given share of a damaged QR Code
+
+const LevelLow = "L"
+const LevelMedium = "M"
+const LevelQuartile = "Q"
+const LevelHigh = "H"
+
+var levels = map[string]QrCode.RecoveryLevel{
+	LevelLow:      QrCode.Low,
+	LevelMedium:   QrCode.Medium,
+	LevelQuartile: QrCode.High,
+	LevelHigh:     QrCode.Highest,
+}
+
+func (i Image) Png(code string) ([]byte, Error.StarkErrors) {
+	//	Render a BR Code as a PNG image
+	//
+	//	Parameters (required):
+	//	- code [string]: BR Code string, such as StaticBrcode.Id, DynamicBrcode.Id or Invoice.Brcode. ex: "00020126360014br.gov.bcb.pix0114+552840092118152040000530398654040.095802BR5915Jamie Lannister6009Sao Paulo620705038566304FC6C"
+	//
+	//	Return:
+	//	- PNG encoded image, or an inputError if code or the Image settings are invalid
+	modules, err := i.modules(code)
+	if err.Errors != nil {
+		return nil, err
+	}
+	size := i.size()
+	scale := size / (len(modules) + 2*i.quietZone())
+	offset := (size - scale*len(modules)) / 2
+
+	picture := image.NewPaletted(image.Rect(0, 0, size, size), color.Palette{color.White, color.Black})
+	for y, row := range modules {
+		for x, dark := range row {
+			if !dark {
+				continue
+			}
+			for dy := 0; dy < scale; dy++ {
+				for dx := 0; dx < scale; dx++ {
+					picture.SetColorIndex(offset+x*scale+dx, offset+y*scale+dy, 1)
+				}
+			}
+		}
+	}
+
+	var buffer bytes.Buffer
+	if encodeError := png.Encode(&buffer, picture); encodeError != nil {
+		return nil, Error.UnknownError(encodeError.Error())
+	}
+	return buffer.Bytes(), Error.StarkErrors{}
+}
+
+func (i Image) Svg(code string) (string, Error.StarkErrors) {
+	//	Render a BR Code as an SVG image
+	//
+	//	The drawing is scaled to Size through its viewBox, so it stays sharp at any size it is printed at.
+	//
+	//	Parameters (required):
+	//	- code [string]: BR Code string, such as StaticBrcode.Id, DynamicBrcode.Id or Invoice.Brcode. ex: "00020126360014br.gov.bcb.pix0114+552840092118152040000530398654040.095802BR5915Jamie Lannister6009Sao Paulo620705038566304FC6C"
+	//
+	//	Return:
+	//	- SVG document, or an inputError if code or the Image settings are invalid
+	modules, err := i.modules(code)
+	if err.Errors != nil {
+		return "", err
+	}
+	quietZone := i.quietZone()
+	width := len(modules) + 2*quietZone
+
+	var path strings.Builder
+	for y, row := range modules {
+		for x := 0; x < len(row); x++ {
+			if !row[x] {
+				continue
+			}
+			run := 1
+			for x+run < len(row) && row[x+run] {
+				run++
+			}
+			path.WriteString(fmt.Sprintf("M%d %dh%dv1h-%dz", x+quietZone, y+quietZone, run, run))
+			x += run - 1
+		}
+	}
+	return fmt.Sprintf(
+		`<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" shape-rendering="crispEdges">`+
+			`<rect width="%d" height="%d" fill="#fff"/><path d="%v" fill="#000"/></svg>`,
+		i.size(), i.size(), width, width, width, width, path.String(),
+	), Error.StarkErrors{}
+}
+
+func (i Image) modules(code string) ([][]bool, Error.StarkErrors) {
+	if _, err := Parse(code); err.Errors != nil {
+		return nil, err
+	}
+	level := i.Level
+	if level == "" {
+		level = LevelMedium
+	}
+	recovery, ok := levels[level]
+	if !ok {
+		return nil, utils.ValidationError(fmt.Sprintf("Image Level must be one of %q, %q, %q or %q, got %q", LevelLow, LevelMedium, LevelQuartile, LevelHigh, i.Level))
+	}
+	if i.quietZone() < 0 {
+		return nil, utils.ValidationError(fmt.Sprintf("Image QuietZone cannot be negative, got %v", i.quietZone()))
+	}
+	qr, encodeError := QrCode.New(code, recovery)
+	if encodeError != nil {
+		return nil, utils.ValidationError(fmt.Sprintf("BR Code cannot be rendered as a QR Code: %v", encodeError))
+	}
+	qr.DisableBorder = true
+	modules := qr.Bitmap()
+	if i.size() < len(modules)+2*i.quietZone() {
+		return nil, utils.ValidationError(fmt.Sprintf("Image Size must be at least %v pixels to fit this BR Code, got %v", len(modules)+2*i.quietZone(), i.size()))
+	}
+	return modules, Error.StarkErrors{}
+}
+
+func (i Image) size() int {
+	if i.Size == 0 {
+		return 256
+	}
+	return i.Size
+}
+
+func (i Image) quietZone() int {
+	if i.QuietZone == nil {
+		return 4
+	}
+	return *i.QuietZone
+}
@@ -1,6 +1,7 @@
 package brcodepreview
 
 import (
+	"context"
 	"encoding/json"
 	Error "github.com/starkinfra/core-go/starkcore/error"
 	"github.com/starkinfra/core-go/starkcore/user/user"
@@ -44,39 +45,50 @@ import (
 //	- Expired [time.Time]: Date/time after which the dynamic QR Code is considered expired. ex: time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC)
 //	- Data [slice of maps]: Slice of additional data in key/value pairs. ex: []map[string]interface{}{{"key": "additional-info", "value": "order #12345"}}
 //	- Jws [string]: JWS of the dynamic QR Code, returned only when "jws" is passed in the expand query parameter. ex: "eyJhbGciOiJFUzI1NiIsInR5cCI6IkpXVCJ9..."
+//	- Extra [map[string]json.RawMessage]: Fields of the BrcodePreview sent by the API but not declared in this SDK version yet, keyed by their JSON name. ex: map[string]json.RawMessage{"newField": json.RawMessage(`"value"`)}
 
 type BrcodePreview struct {
-	Id               string                   `json:",omitempty"`
-	PayerId          string                   `json:",omitempty"`
-	EndToEndId       string                   `json:",omitempty"`
-	AccountNumber    string                   `json:",omitempty"`
-	AccountType      string                   `json:",omitempty"`
-	Amount           int                      `json:",omitempty"`
-	AmountType       string                   `json:",omitempty"`
-	BankCode         string                   `json:",omitempty"`
-	BranchCode       string                   `json:",omitempty"`
-	CashAmount       int                      `json:",omitempty"`
-	CashierBankCode  string                   `json:",omitempty"`
-	CashierType      string                   `json:",omitempty"`
-	DiscountAmount   int                      `json:",omitempty"`
-	FineAmount       int                      `json:",omitempty"`
-	InterestAmount   int                      `json:",omitempty"`
-	KeyId            string                   `json:",omitempty"`
-	Name             string                   `json:",omitempty"`
-	NominalAmount    int                      `json:",omitempty"`
-	ReconciliationId string                   `json:",omitempty"`
-	ReductionAmount  int                      `json:",omitempty"`
-	Scheduled        *time.Time               `json:",omitempty"`
-	Status           string                   `json:",omitempty"`
-	TaxId            string                   `json:",omitempty"`
-	Subscription     *Subscription            `json:",omitempty"`
-	Expired          *time.Time               `json:",omitempty"`
-	Data             []map[string]interface{} `json:",omitempty"`
-	Jws              string                   `json:",omitempty"`
+	Id               string                     `json:",omitempty"`
+	PayerId          string                     `json:",omitempty"`
+	EndToEndId       string                     `json:",omitempty"`
+	AccountNumber    string                     `json:",omitempty"`
+	AccountType      string                     `json:",omitempty"`
+	Amount           int                        `json:",omitempty"`
+	AmountType       string                     `json:",omitempty"`
+	BankCode         string                     `json:",omitempty"`
+	BranchCode       string                     `json:",omitempty"`
+	CashAmount       int                        `json:",omitempty"`
+	CashierBankCode  string                     `json:",omitempty"`
+	CashierType      string                     `json:",omitempty"`
+	DiscountAmount   int                        `json:",omitempty"`
+	FineAmount       int                        `json:",omitempty"`
+	InterestAmount   int                        `json:",omitempty"`
+	KeyId            string                     `json:",omitempty"`
+	Name             string                     `json:",omitempty"`
+	NominalAmount    int                        `json:",omitempty"`
+	ReconciliationId string                     `json:",omitempty"`
+	ReductionAmount  int                        `json:",omitempty"`
+	Scheduled        *time.Time                 `json:",omitempty"`
+	Status           string                     `json:",omitempty"`
+	TaxId            string                     `json:",omitempty"`
+	Subscription     *Subscription              `json:",omitempty"`
+	Expired          *time.Time                 `json:",omitempty"`
+	Data             []map[string]interface{}   `json:",omitempty"`
+	Jws              string                     `json:",omitempty"`
+	Extra            map[string]json.RawMessage `json:"-"`
 }
 
 var resource = map[string]string{"name": "BrcodePreview"}
 
+//	BrcodePreview Client struct
+//
+//	Runs the BrcodePreview functions with the settings of its own utils.Client
+//	instead of starkinfra.User and the other package defaults.
+
+type Client struct {
+	Api *utils.Client
+}
+
 func Create(previews []BrcodePreview, user user.User) ([]BrcodePreview, Error.StarkErrors) {
 	//	Retrieve BrcodePreviews
 	//
@@ -90,10 +102,27 @@ func Create(previews []BrcodePreview, user user.User) ([]BrcodePreview, Error.St
 	//
 	//	Return:
 	//	- Slice of BrcodePreview structs with updated attributes
-	create, err := utils.Multi(resource, previews, nil, user)
-	unmarshalError := json.Unmarshal(create, &previews)
-	if unmarshalError != nil {
+	return Client{Api: utils.Default(user)}.Create(previews)
+}
+
+func CreateCtx(ctx context.Context, previews []BrcodePreview, user user.User) ([]BrcodePreview, Error.StarkErrors) {
+	//	Retrieve BrcodePreviews
+	//
+	//	Same as Create, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.CreateCtx(ctx, previews)
+}
+
+func (c Client) Create(previews []BrcodePreview) ([]BrcodePreview, Error.StarkErrors) {
+	//	Retrieve BrcodePreviews
+	return c.CreateCtx(context.Background(), previews)
+}
+
+func (c Client) CreateCtx(ctx context.Context, previews []BrcodePreview) ([]BrcodePreview, Error.StarkErrors) {
+	//	Retrieve BrcodePreviews
+	create, err := c.Api.Multi(ctx, resource, previews, nil)
+	if err.Errors != nil {
 		return previews, err
 	}
+	err = c.Api.Unmarshal(create, &previews)
 	return previews, err
 }
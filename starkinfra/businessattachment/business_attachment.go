@@ -1,6 +1,7 @@
 package businessattachment
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	Error "github.com/starkinfra/core-go/starkcore/error"
@@ -33,22 +34,33 @@ import (
 //	- Status [string]: current status of the BusinessAttachment. Options: "created", "canceled", "approved", "denied"
 //	- Created [time.Time]: creation datetime for the BusinessAttachment. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC),
 //	- Updated [time.Time]: latest update datetime for the BusinessAttachment. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC),
+//	- Extra [map[string]json.RawMessage]: Fields of the BusinessAttachment sent by the API but not declared in this SDK version yet, keyed by their JSON name. ex: map[string]json.RawMessage{"newField": json.RawMessage(`"value"`)}
 
 type BusinessAttachment struct {
-	Name               string     `json:",omitempty"`
-	Content            string     `json:",omitempty"`
-	ContentType        string     `json:",omitempty"`
-	BusinessIdentityId string     `json:",omitempty"`
-	Tags               []string   `json:",omitempty"`
-	Id                 string     `json:",omitempty"`
-	AttachmentId       string     `json:",omitempty"`
-	Status             string     `json:",omitempty"`
-	Created            *time.Time `json:",omitempty"`
-	Updated            *time.Time `json:",omitempty"`
+	Name               string                     `json:",omitempty"`
+	Content            string                     `json:",omitempty"`
+	ContentType        string                     `json:",omitempty"`
+	BusinessIdentityId string                     `json:",omitempty"`
+	Tags               []string                   `json:",omitempty"`
+	Id                 string                     `json:",omitempty"`
+	AttachmentId       string                     `json:",omitempty"`
+	Status             string                     `json:",omitempty"`
+	Created            *time.Time                 `json:",omitempty"`
+	Updated            *time.Time                 `json:",omitempty"`
+	Extra              map[string]json.RawMessage `json:"-"`
 }
 
 var resource = map[string]string{"name": "BusinessAttachment"}
 
+//	BusinessAttachment Client struct
+//
+//	Runs the BusinessAttachment functions with the settings of its own utils.Client
+//	instead of starkinfra.User and the other package defaults.
+
+type Client struct {
+	Api *utils.Client
+}
+
 func Create(attachments []BusinessAttachment, user user.User) ([]BusinessAttachment, Error.StarkErrors) {
 	//	Create BusinessAttachments
 	//
@@ -62,17 +74,34 @@ func Create(attachments []BusinessAttachment, user user.User) ([]BusinessAttachm
 	//
 	//	Return:
 	//	- slice of BusinessAttachment struct with updated attributes
+	return Client{Api: utils.Default(user)}.Create(attachments)
+}
+
+func CreateCtx(ctx context.Context, attachments []BusinessAttachment, user user.User) ([]BusinessAttachment, Error.StarkErrors) {
+	//	Create BusinessAttachments
+	//
+	//	Same as Create, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.CreateCtx(ctx, attachments)
+}
+
+func (c Client) Create(attachments []BusinessAttachment) ([]BusinessAttachment, Error.StarkErrors) {
+	//	Create BusinessAttachments
+	return c.CreateCtx(context.Background(), attachments)
+}
+
+func (c Client) CreateCtx(ctx context.Context, attachments []BusinessAttachment) ([]BusinessAttachment, Error.StarkErrors) {
+	//	Create BusinessAttachments
 	for i := 0; i < len(attachments); i++ {
 		if attachments[i].ContentType != "" {
 			attachments[i].Content = fmt.Sprintf("data:%s;base64,%s", attachments[i].ContentType, attachments[i].Content)
 			attachments[i].ContentType = ""
 		}
 	}
-	create, err := utils.Multi(resource, attachments, nil, user)
-	unmarshalError := json.Unmarshal(create, &attachments)
-	if unmarshalError != nil {
+	create, err := c.Api.Multi(ctx, resource, attachments, nil)
+	if err.Errors != nil {
 		return attachments, err
 	}
+	err = c.Api.Unmarshal(create, &attachments)
 	return attachments, err
 }
 
@@ -90,12 +119,29 @@ func Get(id string, expand map[string]interface{}, user user.User) (BusinessAtta
 	//
 	//	Return:
 	//	- businessAttachment struct that corresponds to the given id.
+	return Client{Api: utils.Default(user)}.Get(id, expand)
+}
+
+func GetCtx(ctx context.Context, id string, expand map[string]interface{}, user user.User) (BusinessAttachment, Error.StarkErrors) {
+	//	Retrieve a specific BusinessAttachment by its id
+	//
+	//	Same as Get, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.GetCtx(ctx, id, expand)
+}
+
+func (c Client) Get(id string, expand map[string]interface{}) (BusinessAttachment, Error.StarkErrors) {
+	//	Retrieve a specific BusinessAttachment by its id
+	return c.GetCtx(context.Background(), id, expand)
+}
+
+func (c Client) GetCtx(ctx context.Context, id string, expand map[string]interface{}) (BusinessAttachment, Error.StarkErrors) {
+	//	Retrieve a specific BusinessAttachment by its id
 	var businessAttachment BusinessAttachment
-	get, err := utils.Get(resource, id, expand, user)
-	unmarshalError := json.Unmarshal(get, &businessAttachment)
-	if unmarshalError != nil {
+	get, err := c.Api.Get(ctx, resource, id, expand)
+	if err.Errors != nil {
 		return businessAttachment, err
 	}
+	err = c.Api.Unmarshal(get, &businessAttachment)
 	return businessAttachment, err
 }
 
@@ -116,29 +162,133 @@ func Query(params map[string]interface{}, user user.User) (chan BusinessAttachme
 	//
 	//	Return:
 	//	- channel of BusinessAttachment structs with updated attributes
-	var businessAttachment BusinessAttachment
+	return Client{Api: utils.Default(user)}.Query(params)
+}
+
+func QueryCtx(ctx context.Context, params map[string]interface{}, user user.User) (chan BusinessAttachment, chan Error.StarkErrors) {
+	//	Retrieve BusinessAttachments
+	//
+	//	Same as Query, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.QueryCtx(ctx, params)
+}
+
+func (c Client) Query(params map[string]interface{}) (chan BusinessAttachment, chan Error.StarkErrors) {
+	//	Retrieve BusinessAttachments
+	return c.QueryCtx(context.Background(), params)
+}
+
+func (c Client) QueryCtx(ctx context.Context, params map[string]interface{}) (chan BusinessAttachment, chan Error.StarkErrors) {
+	//	Retrieve BusinessAttachments
 	attachments := make(chan BusinessAttachment)
 	attachmentsError := make(chan Error.StarkErrors)
-	query, errorChannel := utils.Query(resource, params, user)
+	query, errorChannel := c.Api.Stream(ctx, resource, params)
 	go func() {
+		defer close(attachmentsError)
+		defer close(attachments)
 		for content := range query {
-			contentByte, _ := json.Marshal(content)
-			err := json.Unmarshal(contentByte, &businessAttachment)
-			if err != nil {
-				attachmentsError <- Error.UnknownError(err.Error())
+			var businessAttachment BusinessAttachment
+			err := c.Api.Unmarshal(content, &businessAttachment)
+			if err.Errors != nil {
+				if !utils.SendError(ctx, attachmentsError, err) {
+					return
+				}
 				continue
 			}
-			attachments <- businessAttachment
+			select {
+			case attachments <- businessAttachment:
+			case <-ctx.Done():
+				return
+			}
 		}
 		for err := range errorChannel {
-			attachmentsError <- err
+			if !utils.SendError(ctx, attachmentsError, err) {
+				return
+			}
 		}
-		close(attachments)
-		close(attachmentsError)
 	}()
 	return attachments, attachmentsError
 }
 
+//	BusinessAttachment Iterator struct
+//
+//	Returned by Iterate to walk through BusinessAttachment structs one at a time,
+//	requesting a new page only once the current one is exhausted. Check Err
+//	once Next returns false and Close it when leaving the loop early.
+
+type Iterator struct {
+	api   *utils.Client
+	pages *utils.Iterator
+	value BusinessAttachment
+	err   Error.StarkErrors
+}
+
+func Iterate(params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve BusinessAttachments
+	//
+	//	Same as Query, but returns an Iterator instead of a pair of channels
+	//
+	//	Example:
+	//	iterator := Iterate(params, nil)
+	//	defer iterator.Close()
+	//	for iterator.Next() {
+	//		fmt.Println(iterator.Value())
+	//	}
+	//	if err := iterator.Err(); err.Errors != nil {
+	//		...
+	//	}
+	return Client{Api: utils.Default(user)}.Iterate(params)
+}
+
+func IterateCtx(ctx context.Context, params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve BusinessAttachments
+	//
+	//	Same as Iterate, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.IterateCtx(ctx, params)
+}
+
+func (c Client) Iterate(params map[string]interface{}) *Iterator {
+	//	Retrieve BusinessAttachments
+	return c.IterateCtx(context.Background(), params)
+}
+
+func (c Client) IterateCtx(ctx context.Context, params map[string]interface{}) *Iterator {
+	//	Retrieve BusinessAttachments
+	return &Iterator{api: c.Api, pages: c.Api.Iterate(ctx, resource, params)}
+}
+
+func (i *Iterator) Next() bool {
+	//	Move to the next BusinessAttachment, returning false when there are no more or a request failed
+	var value BusinessAttachment
+	if i.err.Errors != nil || !i.pages.Next() {
+		return false
+	}
+	i.err = i.api.Unmarshal(i.pages.Value(), &value)
+	if i.err.Errors != nil {
+		i.pages.Close()
+		return false
+	}
+	i.value = value
+	return true
+}
+
+func (i *Iterator) Value() BusinessAttachment {
+	//	Current BusinessAttachment, set by the last call to Next
+	return i.value
+}
+
+func (i *Iterator) Err() Error.StarkErrors {
+	//	Error that stopped the iteration, if any
+	if i.err.Errors != nil {
+		return i.err
+	}
+	return i.pages.Err()
+}
+
+func (i *Iterator) Close() {
+	//	Stop the iteration, releasing the current page
+	i.pages.Close()
+}
+
 func Page(params map[string]interface{}, user user.User) ([]BusinessAttachment, string, Error.StarkErrors) {
 	//	Retrieve paged BusinessAttachment structs
 	//
@@ -159,12 +309,29 @@ func Page(params map[string]interface{}, user user.User) ([]BusinessAttachment,
 	//	Return:
 	//	- slice of BusinessAttachment structs with updated attributes
 	//	- cursor to retrieve the next page of BusinessAttachment structs
+	return Client{Api: utils.Default(user)}.Page(params)
+}
+
+func PageCtx(ctx context.Context, params map[string]interface{}, user user.User) ([]BusinessAttachment, string, Error.StarkErrors) {
+	//	Retrieve paged BusinessAttachment structs
+	//
+	//	Same as Page, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.PageCtx(ctx, params)
+}
+
+func (c Client) Page(params map[string]interface{}) ([]BusinessAttachment, string, Error.StarkErrors) {
+	//	Retrieve paged BusinessAttachment structs
+	return c.PageCtx(context.Background(), params)
+}
+
+func (c Client) PageCtx(ctx context.Context, params map[string]interface{}) ([]BusinessAttachment, string, Error.StarkErrors) {
+	//	Retrieve paged BusinessAttachment structs
 	var businessAttachments []BusinessAttachment
-	page, cursor, err := utils.Page(resource, params, user)
-	unmarshalError := json.Unmarshal(page, &businessAttachments)
-	if unmarshalError != nil {
+	page, cursor, err := c.Api.Page(ctx, resource, params)
+	if err.Errors != nil {
 		return businessAttachments, cursor, err
 	}
+	err = c.Api.Unmarshal(page, &businessAttachments)
 	return businessAttachments, cursor, err
 }
 
@@ -181,11 +348,28 @@ func Cancel(id string, user user.User) (BusinessAttachment, Error.StarkErrors) {
 	//
 	//	Return:
 	//	- canceled BusinessAttachment struct
+	return Client{Api: utils.Default(user)}.Cancel(id)
+}
+
+func CancelCtx(ctx context.Context, id string, user user.User) (BusinessAttachment, Error.StarkErrors) {
+	//	Cancel a BusinessAttachment entity
+	//
+	//	Same as Cancel, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.CancelCtx(ctx, id)
+}
+
+func (c Client) Cancel(id string) (BusinessAttachment, Error.StarkErrors) {
+	//	Cancel a BusinessAttachment entity
+	return c.CancelCtx(context.Background(), id)
+}
+
+func (c Client) CancelCtx(ctx context.Context, id string) (BusinessAttachment, Error.StarkErrors) {
+	//	Cancel a BusinessAttachment entity
 	var businessAttachment BusinessAttachment
-	cancel, err := utils.Delete(resource, id, user)
-	unmarshalError := json.Unmarshal(cancel, &businessAttachment)
-	if unmarshalError != nil {
+	cancel, err := c.Api.Delete(ctx, resource, id)
+	if err.Errors != nil {
 		return businessAttachment, err
 	}
+	err = c.Api.Unmarshal(cancel, &businessAttachment)
 	return businessAttachment, err
 }
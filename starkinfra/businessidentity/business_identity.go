@@ -1,6 +1,7 @@
 package businessidentity
 
 import (
+	"context"
 	"encoding/json"
 	Error "github.com/starkinfra/core-go/starkcore/error"
 	"github.com/starkinfra/core-go/starkcore/user/user"
@@ -39,26 +40,37 @@ import (
 //	- Status [string]: current status of the BusinessIdentity. Options: "created", "pending", "canceled", "processing", "success", "failed"
 //	- Created [time.Time]: creation datetime for the BusinessIdentity. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC),
 //	- Updated [time.Time]: latest update datetime for the BusinessIdentity. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC),
+//	- Extra [map[string]json.RawMessage]: Fields of the BusinessIdentity sent by the API but not declared in this SDK version yet, keyed by their JSON name. ex: map[string]json.RawMessage{"newField": json.RawMessage(`"value"`)}
 
 type BusinessIdentity struct {
-	TaxId               string     `json:",omitempty"`
-	Tags                []string   `json:",omitempty"`
-	Id                  string     `json:",omitempty"`
-	Name                string     `json:",omitempty"`
-	TaxIdStatus         string     `json:",omitempty"`
-	InsightTaxId        string     `json:",omitempty"`
-	InsightDocumentType string     `json:",omitempty"`
-	NumPages            int        `json:",omitempty"`
-	Representatives     string     `json:",omitempty"`
-	Attachments         []string   `json:",omitempty"`
-	Rules               string     `json:",omitempty"`
-	Status              string     `json:",omitempty"`
-	Created             *time.Time `json:",omitempty"`
-	Updated             *time.Time `json:",omitempty"`
+	TaxId               string                     `json:",omitempty"`
+	Tags                []string                   `json:",omitempty"`
+	Id                  string                     `json:",omitempty"`
+	Name                string                     `json:",omitempty"`
+	TaxIdStatus         string                     `json:",omitempty"`
+	InsightTaxId        string                     `json:",omitempty"`
+	InsightDocumentType string                     `json:",omitempty"`
+	NumPages            int                        `json:",omitempty"`
+	Representatives     string                     `json:",omitempty"`
+	Attachments         []string                   `json:",omitempty"`
+	Rules               string                     `json:",omitempty"`
+	Status              string                     `json:",omitempty"`
+	Created             *time.Time                 `json:",omitempty"`
+	Updated             *time.Time                 `json:",omitempty"`
+	Extra               map[string]json.RawMessage `json:"-"`
 }
 
 var resource = map[string]string{"name": "BusinessIdentity"}
 
+//	BusinessIdentity Client struct
+//
+//	Runs the BusinessIdentity functions with the settings of its own utils.Client
+//	instead of starkinfra.User and the other package defaults.
+
+type Client struct {
+	Api *utils.Client
+}
+
 func Create(identities []BusinessIdentity, user user.User) ([]BusinessIdentity, Error.StarkErrors) {
 	//	Create BusinessIdentities
 	//
@@ -72,11 +84,28 @@ func Create(identities []BusinessIdentity, user user.User) ([]BusinessIdentity,
 	//
 	//	Return:
 	//	- slice of BusinessIdentity struct with updated attributes
-	create, err := utils.Multi(resource, identities, nil, user)
-	unmarshalError := json.Unmarshal(create, &identities)
-	if unmarshalError != nil {
+	return Client{Api: utils.Default(user)}.Create(identities)
+}
+
+func CreateCtx(ctx context.Context, identities []BusinessIdentity, user user.User) ([]BusinessIdentity, Error.StarkErrors) {
+	//	Create BusinessIdentities
+	//
+	//	Same as Create, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.CreateCtx(ctx, identities)
+}
+
+func (c Client) Create(identities []BusinessIdentity) ([]BusinessIdentity, Error.StarkErrors) {
+	//	Create BusinessIdentities
+	return c.CreateCtx(context.Background(), identities)
+}
+
+func (c Client) CreateCtx(ctx context.Context, identities []BusinessIdentity) ([]BusinessIdentity, Error.StarkErrors) {
+	//	Create BusinessIdentities
+	create, err := c.Api.Multi(ctx, resource, identities, nil)
+	if err.Errors != nil {
 		return identities, err
 	}
+	err = c.Api.Unmarshal(create, &identities)
 	return identities, err
 }
 
@@ -93,12 +122,29 @@ func Get(id string, user user.User) (BusinessIdentity, Error.StarkErrors) {
 	//
 	//	Return:
 	//	- BusinessIdentity struct that corresponds to the given id.
+	return Client{Api: utils.Default(user)}.Get(id)
+}
+
+func GetCtx(ctx context.Context, id string, user user.User) (BusinessIdentity, Error.StarkErrors) {
+	//	Retrieve a specific BusinessIdentity by its id
+	//
+	//	Same as Get, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.GetCtx(ctx, id)
+}
+
+func (c Client) Get(id string) (BusinessIdentity, Error.StarkErrors) {
+	//	Retrieve a specific BusinessIdentity by its id
+	return c.GetCtx(context.Background(), id)
+}
+
+func (c Client) GetCtx(ctx context.Context, id string) (BusinessIdentity, Error.StarkErrors) {
+	//	Retrieve a specific BusinessIdentity by its id
 	var businessIdentity BusinessIdentity
-	get, err := utils.Get(resource, id, nil, user)
-	unmarshalError := json.Unmarshal(get, &businessIdentity)
-	if unmarshalError != nil {
+	get, err := c.Api.Get(ctx, resource, id, nil)
+	if err.Errors != nil {
 		return businessIdentity, err
 	}
+	err = c.Api.Unmarshal(get, &businessIdentity)
 	return businessIdentity, err
 }
 
@@ -120,29 +166,133 @@ func Query(params map[string]interface{}, user user.User) (chan BusinessIdentity
 	//
 	//	Return:
 	//	- channel of BusinessIdentity structs with updated attributes
-	var businessIdentity BusinessIdentity
+	return Client{Api: utils.Default(user)}.Query(params)
+}
+
+func QueryCtx(ctx context.Context, params map[string]interface{}, user user.User) (chan BusinessIdentity, chan Error.StarkErrors) {
+	//	Retrieve BusinessIdentitys
+	//
+	//	Same as Query, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.QueryCtx(ctx, params)
+}
+
+func (c Client) Query(params map[string]interface{}) (chan BusinessIdentity, chan Error.StarkErrors) {
+	//	Retrieve BusinessIdentitys
+	return c.QueryCtx(context.Background(), params)
+}
+
+func (c Client) QueryCtx(ctx context.Context, params map[string]interface{}) (chan BusinessIdentity, chan Error.StarkErrors) {
+	//	Retrieve BusinessIdentitys
 	identities := make(chan BusinessIdentity)
 	identitiesError := make(chan Error.StarkErrors)
-	query, errorChannel := utils.Query(resource, params, user)
+	query, errorChannel := c.Api.Stream(ctx, resource, params)
 	go func() {
+		defer close(identitiesError)
+		defer close(identities)
 		for content := range query {
-			contentByte, _ := json.Marshal(content)
-			err := json.Unmarshal(contentByte, &businessIdentity)
-			if err != nil {
-				identitiesError <- Error.UnknownError(err.Error())
+			var businessIdentity BusinessIdentity
+			err := c.Api.Unmarshal(content, &businessIdentity)
+			if err.Errors != nil {
+				if !utils.SendError(ctx, identitiesError, err) {
+					return
+				}
 				continue
 			}
-			identities <- businessIdentity
+			select {
+			case identities <- businessIdentity:
+			case <-ctx.Done():
+				return
+			}
 		}
 		for err := range errorChannel {
-			identitiesError <- err
+			if !utils.SendError(ctx, identitiesError, err) {
+				return
+			}
 		}
-		close(identities)
-		close(identitiesError)
 	}()
 	return identities, identitiesError
 }
 
+//	BusinessIdentity Iterator struct
+//
+//	Returned by Iterate to walk through BusinessIdentity structs one at a time,
+//	requesting a new page only once the current one is exhausted. Check Err
+//	once Next returns false and Close it when leaving the loop early.
+
+type Iterator struct {
+	api   *utils.Client
+	pages *utils.Iterator
+	value BusinessIdentity
+	err   Error.StarkErrors
+}
+
+func Iterate(params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve BusinessIdentitys
+	//
+	//	Same as Query, but returns an Iterator instead of a pair of channels
+	//
+	//	Example:
+	//	iterator := Iterate(params, nil)
+	//	defer iterator.Close()
+	//	for iterator.Next() {
+	//		fmt.Println(iterator.Value())
+	//	}
+	//	if err := iterator.Err(); err.Errors != nil {
+	//		...
+	//	}
+	return Client{Api: utils.Default(user)}.Iterate(params)
+}
+
+func IterateCtx(ctx context.Context, params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve BusinessIdentitys
+	//
+	//	Same as Iterate, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.IterateCtx(ctx, params)
+}
+
+func (c Client) Iterate(params map[string]interface{}) *Iterator {
+	//	Retrieve BusinessIdentitys
+	return c.IterateCtx(context.Background(), params)
+}
+
+func (c Client) IterateCtx(ctx context.Context, params map[string]interface{}) *Iterator {
+	//	Retrieve BusinessIdentitys
+	return &Iterator{api: c.Api, pages: c.Api.Iterate(ctx, resource, params)}
+}
+
+func (i *Iterator) Next() bool {
+	//	Move to the next BusinessIdentity, returning false when there are no more or a request failed
+	var value BusinessIdentity
+	if i.err.Errors != nil || !i.pages.Next() {
+		return false
+	}
+	i.err = i.api.Unmarshal(i.pages.Value(), &value)
+	if i.err.Errors != nil {
+		i.pages.Close()
+		return false
+	}
+	i.value = value
+	return true
+}
+
+func (i *Iterator) Value() BusinessIdentity {
+	//	Current BusinessIdentity, set by the last call to Next
+	return i.value
+}
+
+func (i *Iterator) Err() Error.StarkErrors {
+	//	Error that stopped the iteration, if any
+	if i.err.Errors != nil {
+		return i.err
+	}
+	return i.pages.Err()
+}
+
+func (i *Iterator) Close() {
+	//	Stop the iteration, releasing the current page
+	i.pages.Close()
+}
+
 func Page(params map[string]interface{}, user user.User) ([]BusinessIdentity, string, Error.StarkErrors) {
 	//	Retrieve paged BusinessIdentity structs
 	//
@@ -164,12 +314,29 @@ func Page(params map[string]interface{}, user user.User) ([]BusinessIdentity, st
 	//	Return:
 	//	- slice of BusinessIdentity structs with updated attributes
 	//	- cursor to retrieve the next page of BusinessIdentity structs
+	return Client{Api: utils.Default(user)}.Page(params)
+}
+
+func PageCtx(ctx context.Context, params map[string]interface{}, user user.User) ([]BusinessIdentity, string, Error.StarkErrors) {
+	//	Retrieve paged BusinessIdentity structs
+	//
+	//	Same as Page, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.PageCtx(ctx, params)
+}
+
+func (c Client) Page(params map[string]interface{}) ([]BusinessIdentity, string, Error.StarkErrors) {
+	//	Retrieve paged BusinessIdentity structs
+	return c.PageCtx(context.Background(), params)
+}
+
+func (c Client) PageCtx(ctx context.Context, params map[string]interface{}) ([]BusinessIdentity, string, Error.StarkErrors) {
+	//	Retrieve paged BusinessIdentity structs
 	var businessIdentities []BusinessIdentity
-	page, cursor, err := utils.Page(resource, params, user)
-	unmarshalError := json.Unmarshal(page, &businessIdentities)
-	if unmarshalError != nil {
+	page, cursor, err := c.Api.Page(ctx, resource, params)
+	if err.Errors != nil {
 		return businessIdentities, cursor, err
 	}
+	err = c.Api.Unmarshal(page, &businessIdentities)
 	return businessIdentities, cursor, err
 }
 
@@ -189,12 +356,29 @@ func Update(id string, patchData map[string]interface{}, user user.User) (Busine
 	//
 	//	Return:
 	//	- target BusinessIdentity with updated attributes
+	return Client{Api: utils.Default(user)}.Update(id, patchData)
+}
+
+func UpdateCtx(ctx context.Context, id string, patchData map[string]interface{}, user user.User) (BusinessIdentity, Error.StarkErrors) {
+	//	Update a BusinessIdentity entity
+	//
+	//	Same as Update, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.UpdateCtx(ctx, id, patchData)
+}
+
+func (c Client) Update(id string, patchData map[string]interface{}) (BusinessIdentity, Error.StarkErrors) {
+	//	Update a BusinessIdentity entity
+	return c.UpdateCtx(context.Background(), id, patchData)
+}
+
+func (c Client) UpdateCtx(ctx context.Context, id string, patchData map[string]interface{}) (BusinessIdentity, Error.StarkErrors) {
+	//	Update a BusinessIdentity entity
 	var businessIdentity BusinessIdentity
-	update, err := utils.Patch(resource, id, patchData, user)
-	unmarshalError := json.Unmarshal(update, &businessIdentity)
-	if unmarshalError != nil {
+	update, err := c.Api.Patch(ctx, resource, id, patchData)
+	if err.Errors != nil {
 		return businessIdentity, err
 	}
+	err = c.Api.Unmarshal(update, &businessIdentity)
 	return businessIdentity, err
 }
 
@@ -211,11 +395,28 @@ func Cancel(id string, user user.User) (BusinessIdentity, Error.StarkErrors) {
 	//
 	//	Return:
 	//	- canceled BusinessIdentity struct
+	return Client{Api: utils.Default(user)}.Cancel(id)
+}
+
+func CancelCtx(ctx context.Context, id string, user user.User) (BusinessIdentity, Error.StarkErrors) {
+	//	Cancel a BusinessIdentity entity
+	//
+	//	Same as Cancel, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.CancelCtx(ctx, id)
+}
+
+func (c Client) Cancel(id string) (BusinessIdentity, Error.StarkErrors) {
+	//	Cancel a BusinessIdentity entity
+	return c.CancelCtx(context.Background(), id)
+}
+
+func (c Client) CancelCtx(ctx context.Context, id string) (BusinessIdentity, Error.StarkErrors) {
+	//	Cancel a BusinessIdentity entity
 	var businessIdentity BusinessIdentity
-	cancel, err := utils.Delete(resource, id, user)
-	unmarshalError := json.Unmarshal(cancel, &businessIdentity)
-	if unmarshalError != nil {
+	cancel, err := c.Api.Delete(ctx, resource, id)
+	if err.Errors != nil {
 		return businessIdentity, err
 	}
+	err = c.Api.Unmarshal(cancel, &businessIdentity)
 	return businessIdentity, err
 }
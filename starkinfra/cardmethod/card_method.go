@@ -1,10 +1,11 @@
 package cardmethod
 
 import (
+	"context"
 	"encoding/json"
+	Error "github.com/starkinfra/core-go/starkcore/error"
 	"github.com/starkinfra/core-go/starkcore/user/user"
 	"github.com/starkinfra/sdk-go/starkinfra/utils"
-	Error "github.com/starkinfra/core-go/starkcore/error"
 )
 
 //	CardMethod struct
@@ -17,15 +18,26 @@ import (
 //	Attributes (return-only):
 //	- Name [string]: Method's name. ex: "token"
 //	- Number [string]: Method's number. ex: "81"
+//	- Extra [map[string]json.RawMessage]: Fields of the CardMethod sent by the API but not declared in this SDK version yet, keyed by their JSON name. ex: map[string]json.RawMessage{"newField": json.RawMessage(`"value"`)}
 
 type CardMethod struct {
-	Code   string `json:",omitempty"`
-	Name   string `json:",omitempty"`
-	Number string `json:",omitempty"`
+	Code   string                     `json:",omitempty"`
+	Name   string                     `json:",omitempty"`
+	Number string                     `json:",omitempty"`
+	Extra  map[string]json.RawMessage `json:"-"`
 }
 
 var resource = map[string]string{"name": "CardMethod"}
 
+//	CardMethod Client struct
+//
+//	Runs the CardMethod functions with the settings of its own utils.Client
+//	instead of starkinfra.User and the other package defaults.
+
+type Client struct {
+	Api *utils.Client
+}
+
 func Query(params map[string]interface{}, user user.User) (chan CardMethod, chan Error.StarkErrors) {
 	//	Retrieve CardMethod structs
 	//
@@ -38,25 +50,129 @@ func Query(params map[string]interface{}, user user.User) (chan CardMethod, chan
 	//
 	//	Return:
 	//	- Channel of CardMethod structs with updated attributes
-	var cardMethod CardMethod
+	return Client{Api: utils.Default(user)}.Query(params)
+}
+
+func QueryCtx(ctx context.Context, params map[string]interface{}, user user.User) (chan CardMethod, chan Error.StarkErrors) {
+	//	Retrieve CardMethod structs
+	//
+	//	Same as Query, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.QueryCtx(ctx, params)
+}
+
+func (c Client) Query(params map[string]interface{}) (chan CardMethod, chan Error.StarkErrors) {
+	//	Retrieve CardMethod structs
+	return c.QueryCtx(context.Background(), params)
+}
+
+func (c Client) QueryCtx(ctx context.Context, params map[string]interface{}) (chan CardMethod, chan Error.StarkErrors) {
+	//	Retrieve CardMethod structs
 	methods := make(chan CardMethod)
 	methodsError := make(chan Error.StarkErrors)
-	query, errorChannel := utils.Query(resource, params, user)
+	query, errorChannel := c.Api.Stream(ctx, resource, params)
 	go func() {
+		defer close(methodsError)
+		defer close(methods)
 		for content := range query {
-			contentByte, _ := json.Marshal(content)
-			err := json.Unmarshal(contentByte, &cardMethod)
-			if err != nil {
-				methodsError <- Error.UnknownError(err.Error())
+			var cardMethod CardMethod
+			err := c.Api.Unmarshal(content, &cardMethod)
+			if err.Errors != nil {
+				if !utils.SendError(ctx, methodsError, err) {
+					return
+				}
 				continue
 			}
-			methods <- cardMethod
+			select {
+			case methods <- cardMethod:
+			case <-ctx.Done():
+				return
+			}
 		}
 		for err := range errorChannel {
-			methodsError <- err
+			if !utils.SendError(ctx, methodsError, err) {
+				return
+			}
 		}
-		close(methods)
-		close(methodsError)
 	}()
 	return methods, methodsError
 }
+
+//	CardMethod Iterator struct
+//
+//	Returned by Iterate to walk through CardMethod structs one at a time,
+//	requesting a new page only once the current one is exhausted. Check Err
+//	once Next returns false and Close it when leaving the loop early.
+
+type Iterator struct {
+	api   *utils.Client
+	pages *utils.Iterator
+	value CardMethod
+	err   Error.StarkErrors
+}
+
+func Iterate(params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve CardMethod structs
+	//
+	//	Same as Query, but returns an Iterator instead of a pair of channels
+	//
+	//	Example:
+	//	iterator := Iterate(params, nil)
+	//	defer iterator.Close()
+	//	for iterator.Next() {
+	//		fmt.Println(iterator.Value())
+	//	}
+	//	if err := iterator.Err(); err.Errors != nil {
+	//		...
+	//	}
+	return Client{Api: utils.Default(user)}.Iterate(params)
+}
+
+func IterateCtx(ctx context.Context, params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve CardMethod structs
+	//
+	//	Same as Iterate, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.IterateCtx(ctx, params)
+}
+
+func (c Client) Iterate(params map[string]interface{}) *Iterator {
+	//	Retrieve CardMethod structs
+	return c.IterateCtx(context.Background(), params)
+}
+
+func (c Client) IterateCtx(ctx context.Context, params map[string]interface{}) *Iterator {
+	//	Retrieve CardMethod structs
+	return &Iterator{api: c.Api, pages: c.Api.Iterate(ctx, resource, params)}
+}
+
+func (i *Iterator) Next() bool {
+	//	Move to the next CardMethod, returning false when there are no more or a request failed
+	var value CardMethod
+	if i.err.Errors != nil || !i.pages.Next() {
+		return false
+	}
+	i.err = i.api.Unmarshal(i.pages.Value(), &value)
+	if i.err.Errors != nil {
+		i.pages.Close()
+		return false
+	}
+	i.value = value
+	return true
+}
+
+func (i *Iterator) Value() CardMethod {
+	//	Current CardMethod, set by the last call to Next
+	return i.value
+}
+
+func (i *Iterator) Err() Error.StarkErrors {
+	//	Error that stopped the iteration, if any
+	if i.err.Errors != nil {
+		return i.err
+	}
+	return i.pages.Err()
+}
+
+func (i *Iterator) Close() {
+	//	Stop the iteration, releasing the current page
+	i.pages.Close()
+}
@@ -0,0 +1,21 @@
+package cardmethod
+
+import (
+	"github.com/starkinfra/sdk-go/starkinfra/utils"
+)
+
+//	CardMethod QueryParams struct
+//
+//	Typed filters for Query and Iterate. Fields left empty are not sent.
+//
+//	Attributes:
+//	- Search [string, default nil]: Keyword to search for code, name, number or shortCode
+
+type QueryParams struct {
+	Search string `json:"search,omitempty"`
+}
+
+func (p QueryParams) Map() map[string]interface{} {
+	//	Convert the QueryParams into the params map taken by Query and Iterate
+	return utils.ParamsMap(p)
+}
@@ -0,0 +1,283 @@
+package starkinfra
+
+import (
+	"github.com/starkinfra/core-go/starkcore/user/user"
+	"github.com/starkinfra/sdk-go/starkinfra/authorization"
+	"github.com/starkinfra/sdk-go/starkinfra/brcodepreview"
+	"github.com/starkinfra/sdk-go/starkinfra/businessattachment"
+	BusinessAttachmentLog "github.com/starkinfra/sdk-go/starkinfra/businessattachment/log"
+	"github.com/starkinfra/sdk-go/starkinfra/businessidentity"
+	BusinessIdentityLog "github.com/starkinfra/sdk-go/starkinfra/businessidentity/log"
+	"github.com/starkinfra/sdk-go/starkinfra/cardmethod"
+	"github.com/starkinfra/sdk-go/starkinfra/creditholmes"
+	CreditHolmesLog "github.com/starkinfra/sdk-go/starkinfra/creditholmes/log"
+	"github.com/starkinfra/sdk-go/starkinfra/creditnote"
+	CreditNoteLog "github.com/starkinfra/sdk-go/starkinfra/creditnote/log"
+	"github.com/starkinfra/sdk-go/starkinfra/creditpreview"
+	"github.com/starkinfra/sdk-go/starkinfra/dynamicbrcode"
+	"github.com/starkinfra/sdk-go/starkinfra/event"
+	"github.com/starkinfra/sdk-go/starkinfra/event/attempt"
+	"github.com/starkinfra/sdk-go/starkinfra/individualdocument"
+	IndividualDocumentLog "github.com/starkinfra/sdk-go/starkinfra/individualdocument/log"
+	"github.com/starkinfra/sdk-go/starkinfra/individualidentity"
+	IndividualIdentityLog "github.com/starkinfra/sdk-go/starkinfra/individualidentity/log"
+	"github.com/starkinfra/sdk-go/starkinfra/issuingbalance"
+	"github.com/starkinfra/sdk-go/starkinfra/issuingbillinginvoice"
+	"github.com/starkinfra/sdk-go/starkinfra/issuingbillingtransaction"
+	"github.com/starkinfra/sdk-go/starkinfra/issuingcard"
+	IssuingCardLog "github.com/starkinfra/sdk-go/starkinfra/issuingcard/log"
+	"github.com/starkinfra/sdk-go/starkinfra/issuingdesign"
+	"github.com/starkinfra/sdk-go/starkinfra/issuingembossingkit"
+	"github.com/starkinfra/sdk-go/starkinfra/issuingembossingrequest"
+	IssuingEmbossingRequestLog "github.com/starkinfra/sdk-go/starkinfra/issuingembossingrequest/log"
+	"github.com/starkinfra/sdk-go/starkinfra/issuingholder"
+	IssuingHolderLog "github.com/starkinfra/sdk-go/starkinfra/issuingholder/log"
+	"github.com/starkinfra/sdk-go/starkinfra/issuinginvoice"
+	IssuingInvoiceLog "github.com/starkinfra/sdk-go/starkinfra/issuinginvoice/log"
+	"github.com/starkinfra/sdk-go/starkinfra/issuingproduct"
+	"github.com/starkinfra/sdk-go/starkinfra/issuingpurchase"
+	IssuingPurchaseLog "github.com/starkinfra/sdk-go/starkinfra/issuingpurchase/log"
+	"github.com/starkinfra/sdk-go/starkinfra/issuingrestock"
+	IssuingRestockLog "github.com/starkinfra/sdk-go/starkinfra/issuingrestock/log"
+	"github.com/starkinfra/sdk-go/starkinfra/issuingstock"
+	IssuingStockLog "github.com/starkinfra/sdk-go/starkinfra/issuingstock/log"
+	"github.com/starkinfra/sdk-go/starkinfra/issuingstockrule"
+	"github.com/starkinfra/sdk-go/starkinfra/issuingtoken"
+	IssuingTokenLog "github.com/starkinfra/sdk-go/starkinfra/issuingtoken/log"
+	"github.com/starkinfra/sdk-go/starkinfra/issuingtokendesign"
+	"github.com/starkinfra/sdk-go/starkinfra/issuingtokenrequest"
+	"github.com/starkinfra/sdk-go/starkinfra/issuingtransaction"
+	"github.com/starkinfra/sdk-go/starkinfra/issuingwithdrawal"
+	"github.com/starkinfra/sdk-go/starkinfra/ledger"
+	LedgerLog "github.com/starkinfra/sdk-go/starkinfra/ledger/log"
+	"github.com/starkinfra/sdk-go/starkinfra/ledgertransaction"
+	"github.com/starkinfra/sdk-go/starkinfra/merchantcategory"
+	"github.com/starkinfra/sdk-go/starkinfra/merchantcountry"
+	"github.com/starkinfra/sdk-go/starkinfra/pixbalance"
+	"github.com/starkinfra/sdk-go/starkinfra/pixchargeback"
+	PixChargebackLog "github.com/starkinfra/sdk-go/starkinfra/pixchargeback/log"
+	"github.com/starkinfra/sdk-go/starkinfra/pixclaim"
+	PixClaimLog "github.com/starkinfra/sdk-go/starkinfra/pixclaim/log"
+	"github.com/starkinfra/sdk-go/starkinfra/pixdirector"
+	"github.com/starkinfra/sdk-go/starkinfra/pixdispute"
+	PixDisputeLog "github.com/starkinfra/sdk-go/starkinfra/pixdispute/log"
+	"github.com/starkinfra/sdk-go/starkinfra/pixdomain"
+	"github.com/starkinfra/sdk-go/starkinfra/pixfraud"
+	PixFraudLog "github.com/starkinfra/sdk-go/starkinfra/pixfraud/log"
+	"github.com/starkinfra/sdk-go/starkinfra/pixinfraction"
+	PixInfractionLog "github.com/starkinfra/sdk-go/starkinfra/pixinfraction/log"
+	"github.com/starkinfra/sdk-go/starkinfra/pixinternaltransactionreport"
+	PixInternalTransactionReportLog "github.com/starkinfra/sdk-go/starkinfra/pixinternaltransactionreport/log"
+	"github.com/starkinfra/sdk-go/starkinfra/pixkey"
+	PixKeyLog "github.com/starkinfra/sdk-go/starkinfra/pixkey/log"
+	"github.com/starkinfra/sdk-go/starkinfra/pixkeyholmes"
+	"github.com/starkinfra/sdk-go/starkinfra/pixpullrequest"
+	PixPullRequestLog "github.com/starkinfra/sdk-go/starkinfra/pixpullrequest/log"
+	"github.com/starkinfra/sdk-go/starkinfra/pixpullsubscription"
+	PixPullSubscriptionLog "github.com/starkinfra/sdk-go/starkinfra/pixpullsubscription/log"
+	"github.com/starkinfra/sdk-go/starkinfra/pixrequest"
+	PixRequestLog "github.com/starkinfra/sdk-go/starkinfra/pixrequest/log"
+	"github.com/starkinfra/sdk-go/starkinfra/pixreversal"
+	PixReversalLog "github.com/starkinfra/sdk-go/starkinfra/pixreversal/log"
+	"github.com/starkinfra/sdk-go/starkinfra/pixstatement"
+	"github.com/starkinfra/sdk-go/starkinfra/pixuser"
+	"github.com/starkinfra/sdk-go/starkinfra/request"
+	"github.com/starkinfra/sdk-go/starkinfra/staticbrcode"
+	"github.com/starkinfra/sdk-go/starkinfra/utils"
+	"github.com/starkinfra/sdk-go/starkinfra/webhook"
+)
+
+//	Client struct
+//
+//	A Client holds its own user, host, timeout, language and HTTP settings, so
+//	one process can talk to several workspaces or environments at once without
+//	racing on the package-level defaults. Every resource is reachable through it,
+//	ex: client.PixRequest.Create(requests). The settings may be changed right after
+//	NewClient returns, since every resource shares the same utils.Client.
+//
+//	Attributes:
+//	- Client [*utils.Client]: User, Host, ApiVersion, Language, Timeout and HttpClient used by every resource
+
+type Client struct {
+	*utils.Client
+	Authorization                   authorization.Client
+	BrcodePreview                   brcodepreview.Client
+	BusinessAttachment              businessattachment.Client
+	BusinessAttachmentLog           BusinessAttachmentLog.Client
+	BusinessIdentity                businessidentity.Client
+	BusinessIdentityLog             BusinessIdentityLog.Client
+	CardMethod                      cardmethod.Client
+	CreditHolmes                    creditholmes.Client
+	CreditHolmesLog                 CreditHolmesLog.Client
+	CreditNote                      creditnote.Client
+	CreditNoteLog                   CreditNoteLog.Client
+	CreditPreview                   creditpreview.Client
+	DynamicBrcode                   dynamicbrcode.Client
+	Event                           event.Client
+	EventAttempt                    attempt.Client
+	IndividualDocument              individualdocument.Client
+	IndividualDocumentLog           IndividualDocumentLog.Client
+	IndividualIdentity              individualidentity.Client
+	IndividualIdentityLog           IndividualIdentityLog.Client
+	IssuingBalance                  issuingbalance.Client
+	IssuingBillingInvoice           issuingbillinginvoice.Client
+	IssuingBillingTransaction       issuingbillingtransaction.Client
+	IssuingCard                     issuingcard.Client
+	IssuingCardLog                  IssuingCardLog.Client
+	IssuingDesign                   issuingdesign.Client
+	IssuingEmbossingKit             issuingembossingkit.Client
+	IssuingEmbossingRequest         issuingembossingrequest.Client
+	IssuingEmbossingRequestLog      IssuingEmbossingRequestLog.Client
+	IssuingHolder                   issuingholder.Client
+	IssuingHolderLog                IssuingHolderLog.Client
+	IssuingInvoice                  issuinginvoice.Client
+	IssuingInvoiceLog               IssuingInvoiceLog.Client
+	IssuingProduct                  issuingproduct.Client
+	IssuingPurchase                 issuingpurchase.Client
+	IssuingPurchaseLog              IssuingPurchaseLog.Client
+	IssuingRestock                  issuingrestock.Client
+	IssuingRestockLog               IssuingRestockLog.Client
+	IssuingStock                    issuingstock.Client
+	IssuingStockLog                 IssuingStockLog.Client
+	IssuingStockRule                issuingstockrule.Client
+	IssuingToken                    issuingtoken.Client
+	IssuingTokenDesign              issuingtokendesign.Client
+	IssuingTokenLog                 IssuingTokenLog.Client
+	IssuingTokenRequest             issuingtokenrequest.Client
+	IssuingTransaction              issuingtransaction.Client
+	IssuingWithdrawal               issuingwithdrawal.Client
+	Ledger                          ledger.Client
+	LedgerLog                       LedgerLog.Client
+	LedgerTransaction               ledgertransaction.Client
+	MerchantCategory                merchantcategory.Client
+	MerchantCountry                 merchantcountry.Client
+	PixBalance                      pixbalance.Client
+	PixChargeback                   pixchargeback.Client
+	PixChargebackLog                PixChargebackLog.Client
+	PixClaim                        pixclaim.Client
+	PixClaimLog                     PixClaimLog.Client
+	PixDirector                     pixdirector.Client
+	PixDispute                      pixdispute.Client
+	PixDisputeLog                   PixDisputeLog.Client
+	PixDomain                       pixdomain.Client
+	PixFraud                        pixfraud.Client
+	PixFraudLog                     PixFraudLog.Client
+	PixInfraction                   pixinfraction.Client
+	PixInfractionLog                PixInfractionLog.Client
+	PixInternalTransactionReport    pixinternaltransactionreport.Client
+	PixInternalTransactionReportLog PixInternalTransactionReportLog.Client
+	PixKey                          pixkey.Client
+	PixKeyHolmes                    pixkeyholmes.Client
+	PixKeyLog                       PixKeyLog.Client
+	PixPullRequest                  pixpullrequest.Client
+	PixPullRequestLog               PixPullRequestLog.Client
+	PixPullSubscription             pixpullsubscription.Client
+	PixPullSubscriptionLog          PixPullSubscriptionLog.Client
+	PixRequest                      pixrequest.Client
+	PixRequestLog                   PixRequestLog.Client
+	PixReversal                     pixreversal.Client
+	PixReversalLog                  PixReversalLog.Client
+	PixStatement                    pixstatement.Client
+	PixUser                         pixuser.Client
+	Request                         request.Client
+	StaticBrcode                    staticbrcode.Client
+	Webhook                         webhook.Client
+}
+
+func NewClient(user user.User) *Client {
+	//	Create a Client
+	//
+	//	Create a Client starting from the current package defaults (Host, ApiVersion, Language and Timeout)
+	//
+	//	Parameters (required):
+	//	- user [Organization/Project struct]: Organization or Project struct used on every request made by the Client
+	//
+	//	Return:
+	//	- Client struct
+	api := utils.Default(user)
+	return &Client{
+		Client:                          api,
+		Authorization:                   authorization.Client{Api: api},
+		BrcodePreview:                   brcodepreview.Client{Api: api},
+		BusinessAttachment:              businessattachment.Client{Api: api},
+		BusinessAttachmentLog:           BusinessAttachmentLog.Client{Api: api},
+		BusinessIdentity:                businessidentity.Client{Api: api},
+		BusinessIdentityLog:             BusinessIdentityLog.Client{Api: api},
+		CardMethod:                      cardmethod.Client{Api: api},
+		CreditHolmes:                    creditholmes.Client{Api: api},
+		CreditHolmesLog:                 CreditHolmesLog.Client{Api: api},
+		CreditNote:                      creditnote.Client{Api: api},
+		CreditNoteLog:                   CreditNoteLog.Client{Api: api},
+		CreditPreview:                   creditpreview.Client{Api: api},
+		DynamicBrcode:                   dynamicbrcode.Client{Api: api},
+		Event:                           event.Client{Api: api},
+		EventAttempt:                    attempt.Client{Api: api},
+		IndividualDocument:              individualdocument.Client{Api: api},
+		IndividualDocumentLog:           IndividualDocumentLog.Client{Api: api},
+		IndividualIdentity:              individualidentity.Client{Api: api},
+		IndividualIdentityLog:           IndividualIdentityLog.Client{Api: api},
+		IssuingBalance:                  issuingbalance.Client{Api: api},
+		IssuingBillingInvoice:           issuingbillinginvoice.Client{Api: api},
+		IssuingBillingTransaction:       issuingbillingtransaction.Client{Api: api},
+		IssuingCard:                     issuingcard.Client{Api: api},
+		IssuingCardLog:                  IssuingCardLog.Client{Api: api},
+		IssuingDesign:                   issuingdesign.Client{Api: api},
+		IssuingEmbossingKit:             issuingembossingkit.Client{Api: api},
+		IssuingEmbossingRequest:         issuingembossingrequest.Client{Api: api},
+		IssuingEmbossingRequestLog:      IssuingEmbossingRequestLog.Client{Api: api},
+		IssuingHolder:                   issuingholder.Client{Api: api},
+		IssuingHolderLog:                IssuingHolderLog.Client{Api: api},
+		IssuingInvoice:                  issuinginvoice.Client{Api: api},
+		IssuingInvoiceLog:               IssuingInvoiceLog.Client{Api: api},
+		IssuingProduct:                  issuingproduct.Client{Api: api},
+		IssuingPurchase:                 issuingpurchase.Client{Api: api},
+		IssuingPurchaseLog:              IssuingPurchaseLog.Client{Api: api},
+		IssuingRestock:                  issuingrestock.Client{Api: api},
+		IssuingRestockLog:               IssuingRestockLog.Client{Api: api},
+		IssuingStock:                    issuingstock.Client{Api: api},
+		IssuingStockLog:                 IssuingStockLog.Client{Api: api},
+		IssuingStockRule:                issuingstockrule.Client{Api: api},
+		IssuingToken:                    issuingtoken.Client{Api: api},
+		IssuingTokenDesign:              issuingtokendesign.Client{Api: api},
+		IssuingTokenLog:                 IssuingTokenLog.Client{Api: api},
+		IssuingTokenRequest:             issuingtokenrequest.Client{Api: api},
+		IssuingTransaction:              issuingtransaction.Client{Api: api},
+		IssuingWithdrawal:               issuingwithdrawal.Client{Api: api},
+		Ledger:                          ledger.Client{Api: api},
+		LedgerLog:                       LedgerLog.Client{Api: api},
+		LedgerTransaction:               ledgertransaction.Client{Api: api},
+		MerchantCategory:                merchantcategory.Client{Api: api},
+		MerchantCountry:                 merchantcountry.Client{Api: api},
+		PixBalance:                      pixbalance.Client{Api: api},
+		PixChargeback:                   pixchargeback.Client{Api: api},
+		PixChargebackLog:                PixChargebackLog.Client{Api: api},
+		PixClaim:                        pixclaim.Client{Api: api},
+		PixClaimLog:                     PixClaimLog.Client{Api: api},
+		PixDirector:                     pixdirector.Client{Api: api},
+		PixDispute:                      pixdispute.Client{Api: api},
+		PixDisputeLog:                   PixDisputeLog.Client{Api: api},
+		PixDomain:                       pixdomain.Client{Api: api},
+		PixFraud:                        pixfraud.Client{Api: api},
+		PixFraudLog:                     PixFraudLog.Client{Api: api},
+		PixInfraction:                   pixinfraction.Client{Api: api},
+		PixInfractionLog:                PixInfractionLog.Client{Api: api},
+		PixInternalTransactionReport:    pixinternaltransactionreport.Client{Api: api},
+		PixInternalTransactionReportLog: PixInternalTransactionReportLog.Client{Api: api},
+		PixKey:                          pixkey.Client{Api: api},
+		PixKeyHolmes:                    pixkeyholmes.Client{Api: api},
+		PixKeyLog:                       PixKeyLog.Client{Api: api},
+		PixPullRequest:                  pixpullrequest.Client{Api: api},
+		PixPullRequestLog:               PixPullRequestLog.Client{Api: api},
+		PixPullSubscription:             pixpullsubscription.Client{Api: api},
+		PixPullSubscriptionLog:          PixPullSubscriptionLog.Client{Api: api},
+		PixRequest:                      pixrequest.Client{Api: api},
+		PixRequestLog:                   PixRequestLog.Client{Api: api},
+		PixReversal:                     pixreversal.Client{Api: api},
+		PixReversalLog:                  PixReversalLog.Client{Api: api},
+		PixStatement:                    pixstatement.Client{Api: api},
+		PixUser:                         pixuser.Client{Api: api},
+		Request:                         request.Client{Api: api},
+		StaticBrcode:                    staticbrcode.Client{Api: api},
+		Webhook:                         webhook.Client{Api: api},
+	}
+}
@@ -1,6 +1,7 @@
 package creditholmes
 
 import (
+	"context"
 	"encoding/json"
 	Error "github.com/starkinfra/core-go/starkcore/error"
 	"github.com/starkinfra/core-go/starkcore/user/user"
@@ -31,20 +32,31 @@ import (
 //	- Status [string]: current status of the CreditHolmes. ex: "created", "failed", "success"
 //	- Created [time.Time]: Creation datetime for the CreditHolmes. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC),
 //	- Updated [time.Time]: Latest update datetime for the CreditHolmes. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC),
+//	- Extra [map[string]json.RawMessage]: Fields of the CreditHolmes sent by the API but not declared in this SDK version yet, keyed by their JSON name. ex: map[string]json.RawMessage{"newField": json.RawMessage(`"value"`)}
 
 type CreditHolmes struct {
-	TaxId      string                 `json:",omitempty"`
-	Competence string                 `json:",omitempty"`
-	Tags       []string               `json:",omitempty"`
-	Id         string                 `json:",omitempty"`
-	Result     map[string]interface{} `json:",omitempty"`
-	Status     string                 `json:",omitempty"`
-	Created    *time.Time             `json:",omitempty"`
-	Updated    *time.Time             `json:",omitempty"`
+	TaxId      string                     `json:",omitempty"`
+	Competence string                     `json:",omitempty"`
+	Tags       []string                   `json:",omitempty"`
+	Id         string                     `json:",omitempty"`
+	Result     map[string]interface{}     `json:",omitempty"`
+	Status     string                     `json:",omitempty"`
+	Created    *time.Time                 `json:",omitempty"`
+	Updated    *time.Time                 `json:",omitempty"`
+	Extra      map[string]json.RawMessage `json:"-"`
 }
 
 var resource = map[string]string{"name": "CreditHolmes"}
 
+//	CreditHolmes Client struct
+//
+//	Runs the CreditHolmes functions with the settings of its own utils.Client
+//	instead of starkinfra.User and the other package defaults.
+
+type Client struct {
+	Api *utils.Client
+}
+
 func Create(holmes []CreditHolmes, user user.User) ([]CreditHolmes, Error.StarkErrors) {
 	//	Create CreditHolmes
 	//
@@ -58,11 +70,28 @@ func Create(holmes []CreditHolmes, user user.User) ([]CreditHolmes, Error.StarkE
 	//
 	//	Return:
 	//	- Slice of CreditHolmes structs with updated attributes
-	create, err := utils.Multi(resource, holmes, nil, user)
-	unmarshalError := json.Unmarshal(create, &holmes)
-	if unmarshalError != nil {
+	return Client{Api: utils.Default(user)}.Create(holmes)
+}
+
+func CreateCtx(ctx context.Context, holmes []CreditHolmes, user user.User) ([]CreditHolmes, Error.StarkErrors) {
+	//	Create CreditHolmes
+	//
+	//	Same as Create, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.CreateCtx(ctx, holmes)
+}
+
+func (c Client) Create(holmes []CreditHolmes) ([]CreditHolmes, Error.StarkErrors) {
+	//	Create CreditHolmes
+	return c.CreateCtx(context.Background(), holmes)
+}
+
+func (c Client) CreateCtx(ctx context.Context, holmes []CreditHolmes) ([]CreditHolmes, Error.StarkErrors) {
+	//	Create CreditHolmes
+	create, err := c.Api.Multi(ctx, resource, holmes, nil)
+	if err.Errors != nil {
 		return holmes, err
 	}
+	err = c.Api.Unmarshal(create, &holmes)
 	return holmes, err
 }
 
@@ -79,12 +108,29 @@ func Get(id string, user user.User) (CreditHolmes, Error.StarkErrors) {
 	//
 	//	Return:
 	//	- CreditHolmes struct that corresponds to the given id.
+	return Client{Api: utils.Default(user)}.Get(id)
+}
+
+func GetCtx(ctx context.Context, id string, user user.User) (CreditHolmes, Error.StarkErrors) {
+	//	Retrieve a specific CreditHolmes
+	//
+	//	Same as Get, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.GetCtx(ctx, id)
+}
+
+func (c Client) Get(id string) (CreditHolmes, Error.StarkErrors) {
+	//	Retrieve a specific CreditHolmes
+	return c.GetCtx(context.Background(), id)
+}
+
+func (c Client) GetCtx(ctx context.Context, id string) (CreditHolmes, Error.StarkErrors) {
+	//	Retrieve a specific CreditHolmes
 	var creditHolmes CreditHolmes
-	get, err := utils.Get(resource, id, nil, user)
-	unmarshalError := json.Unmarshal(get, &creditHolmes)
-	if unmarshalError != nil {
+	get, err := c.Api.Get(ctx, resource, id, nil)
+	if err.Errors != nil {
 		return creditHolmes, err
 	}
+	err = c.Api.Unmarshal(get, &creditHolmes)
 	return creditHolmes, err
 }
 
@@ -105,29 +151,133 @@ func Query(params map[string]interface{}, user user.User) (chan CreditHolmes, ch
 	//
 	//	Return:
 	//	- Channel  of CreditHolmes structs with updated attributes
-	var creditHolmes CreditHolmes
+	return Client{Api: utils.Default(user)}.Query(params)
+}
+
+func QueryCtx(ctx context.Context, params map[string]interface{}, user user.User) (chan CreditHolmes, chan Error.StarkErrors) {
+	//	Retrieve CreditHolmes
+	//
+	//	Same as Query, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.QueryCtx(ctx, params)
+}
+
+func (c Client) Query(params map[string]interface{}) (chan CreditHolmes, chan Error.StarkErrors) {
+	//	Retrieve CreditHolmes
+	return c.QueryCtx(context.Background(), params)
+}
+
+func (c Client) QueryCtx(ctx context.Context, params map[string]interface{}) (chan CreditHolmes, chan Error.StarkErrors) {
+	//	Retrieve CreditHolmes
 	holmes := make(chan CreditHolmes)
 	holmesError := make(chan Error.StarkErrors)
-	query, errorChannel := utils.Query(resource, params, user)
+	query, errorChannel := c.Api.Stream(ctx, resource, params)
 	go func() {
+		defer close(holmesError)
+		defer close(holmes)
 		for content := range query {
-			contentByte, _ := json.Marshal(content)
-			err := json.Unmarshal(contentByte, &creditHolmes)
-			if err != nil {
-				holmesError <- Error.UnknownError(err.Error())
+			var creditHolmes CreditHolmes
+			err := c.Api.Unmarshal(content, &creditHolmes)
+			if err.Errors != nil {
+				if !utils.SendError(ctx, holmesError, err) {
+					return
+				}
 				continue
 			}
-			holmes <- creditHolmes
+			select {
+			case holmes <- creditHolmes:
+			case <-ctx.Done():
+				return
+			}
 		}
 		for err := range errorChannel {
-			holmesError <- err
+			if !utils.SendError(ctx, holmesError, err) {
+				return
+			}
 		}
-		close(holmes)
-		close(holmesError)
 	}()
 	return holmes, holmesError
 }
 
+//	CreditHolmes Iterator struct
+//
+//	Returned by Iterate to walk through CreditHolmes structs one at a time,
+//	requesting a new page only once the current one is exhausted. Check Err
+//	once Next returns false and Close it when leaving the loop early.
+
+type Iterator struct {
+	api   *utils.Client
+	pages *utils.Iterator
+	value CreditHolmes
+	err   Error.StarkErrors
+}
+
+func Iterate(params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve CreditHolmes
+	//
+	//	Same as Query, but returns an Iterator instead of a pair of channels
+	//
+	//	Example:
+	//	iterator := Iterate(params, nil)
+	//	defer iterator.Close()
+	//	for iterator.Next() {
+	//		fmt.Println(iterator.Value())
+	//	}
+	//	if err := iterator.Err(); err.Errors != nil {
+	//		...
+	//	}
+	return Client{Api: utils.Default(user)}.Iterate(params)
+}
+
+func IterateCtx(ctx context.Context, params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve CreditHolmes
+	//
+	//	Same as Iterate, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.IterateCtx(ctx, params)
+}
+
+func (c Client) Iterate(params map[string]interface{}) *Iterator {
+	//	Retrieve CreditHolmes
+	return c.IterateCtx(context.Background(), params)
+}
+
+func (c Client) IterateCtx(ctx context.Context, params map[string]interface{}) *Iterator {
+	//	Retrieve CreditHolmes
+	return &Iterator{api: c.Api, pages: c.Api.Iterate(ctx, resource, params)}
+}
+
+func (i *Iterator) Next() bool {
+	//	Move to the next CreditHolmes, returning false when there are no more or a request failed
+	var value CreditHolmes
+	if i.err.Errors != nil || !i.pages.Next() {
+		return false
+	}
+	i.err = i.api.Unmarshal(i.pages.Value(), &value)
+	if i.err.Errors != nil {
+		i.pages.Close()
+		return false
+	}
+	i.value = value
+	return true
+}
+
+func (i *Iterator) Value() CreditHolmes {
+	//	Current CreditHolmes, set by the last call to Next
+	return i.value
+}
+
+func (i *Iterator) Err() Error.StarkErrors {
+	//	Error that stopped the iteration, if any
+	if i.err.Errors != nil {
+		return i.err
+	}
+	return i.pages.Err()
+}
+
+func (i *Iterator) Close() {
+	//	Stop the iteration, releasing the current page
+	i.pages.Close()
+}
+
 func Page(params map[string]interface{}, user user.User) ([]CreditHolmes, string, Error.StarkErrors) {
 	//	Retrieve paged CreditHolmes structs
 	//
@@ -148,11 +298,28 @@ func Page(params map[string]interface{}, user user.User) ([]CreditHolmes, string
 	//	Return:
 	//	- Slice of CreditHolmes structs with updated attributes
 	//	- Cursor to retrieve the next page of CreditHolmes structs
+	return Client{Api: utils.Default(user)}.Page(params)
+}
+
+func PageCtx(ctx context.Context, params map[string]interface{}, user user.User) ([]CreditHolmes, string, Error.StarkErrors) {
+	//	Retrieve paged CreditHolmes structs
+	//
+	//	Same as Page, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.PageCtx(ctx, params)
+}
+
+func (c Client) Page(params map[string]interface{}) ([]CreditHolmes, string, Error.StarkErrors) {
+	//	Retrieve paged CreditHolmes structs
+	return c.PageCtx(context.Background(), params)
+}
+
+func (c Client) PageCtx(ctx context.Context, params map[string]interface{}) ([]CreditHolmes, string, Error.StarkErrors) {
+	//	Retrieve paged CreditHolmes structs
 	var creditHolmes []CreditHolmes
-	page, cursor, err := utils.Page(resource, params, user)
-	unmarshalError := json.Unmarshal(page, &creditHolmes)
-	if unmarshalError != nil {
+	page, cursor, err := c.Api.Page(ctx, resource, params)
+	if err.Errors != nil {
 		return creditHolmes, cursor, err
 	}
+	err = c.Api.Unmarshal(page, &creditHolmes)
 	return creditHolmes, cursor, err
 }
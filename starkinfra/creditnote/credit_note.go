@@ -1,6 +1,7 @@
 package creditnote
 
 import (
+	"context"
 	"encoding/json"
 	Error "github.com/starkinfra/core-go/starkcore/error"
 	"github.com/starkinfra/core-go/starkcore/user/user"
@@ -57,42 +58,53 @@ import (
 //	- Interest [float64]: Yearly effective interest rate of the credit note, in percentage. ex: 12.5
 //	- Created [time.Time]: Creation datetime for the CreditNote. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC)
 //	- Updated [time.Time]: Latest update datetime for the CreditNote. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC)
+//	- Extra [map[string]json.RawMessage]: Fields of the CreditNote sent by the API but not declared in this SDK version yet, keyed by their JSON name. ex: map[string]json.RawMessage{"newField": json.RawMessage(`"value"`)}
 
 type CreditNote struct {
-	Id                string                `json:",omitempty"`
-	TemplateId        string                `json:",omitempty"`
-	Name              string                `json:",omitempty"`
-	TaxId             string                `json:",omitempty"`
-	Scheduled         *time.Time            `json:",omitempty"`
-	Payment           Transfer              `json:",omitempty"`
-	Invoices          []Invoice.Invoice     `json:",omitempty"`
-	Signers           []Signer.CreditSigner `json:",omitempty"`
-	ExternalId        string                `json:",omitempty"`
-	StreetLine1       string                `json:",omitempty"`
-	StreetLine2       string                `json:",omitempty"`
-	District          string                `json:",omitempty"`
-	City              string                `json:",omitempty"`
-	StateCode         string                `json:",omitempty"`
-	ZipCode           string                `json:",omitempty"`
-	NominalAmount     int                   `json:",omitempty"`
-	PaymentType       string                `json:",omitempty"`
-	Amount            int                   `json:",omitempty"`
-	RebateAmount      int                   `json:",omitempty"`
-	Tags              []string              `json:",omitempty"`
-	Rules             []Rule                `json:",omitempty"`
-	DocumentId        string                `json:",omitempty"`
-	Status            string                `json:",omitempty"`
-	TransactionIds    []string              `json:",omitempty"`
-	WorkspaceId       string                `json:",omitempty"`
-	DebtorWorkspaceId string                `json:",omitempty"`
-	TaxAmount         int                   `json:",omitempty"`
-	Interest          float64               `json:",omitempty"`
-	Created           *time.Time            `json:",omitempty"`
-	Updated           *time.Time            `json:",omitempty"`
+	Id                string                     `json:",omitempty"`
+	TemplateId        string                     `json:",omitempty"`
+	Name              string                     `json:",omitempty"`
+	TaxId             string                     `json:",omitempty"`
+	Scheduled         *time.Time                 `json:",omitempty"`
+	Payment           Transfer                   `json:",omitempty"`
+	Invoices          []Invoice.Invoice          `json:",omitempty"`
+	Signers           []Signer.CreditSigner      `json:",omitempty"`
+	ExternalId        string                     `json:",omitempty"`
+	StreetLine1       string                     `json:",omitempty"`
+	StreetLine2       string                     `json:",omitempty"`
+	District          string                     `json:",omitempty"`
+	City              string                     `json:",omitempty"`
+	StateCode         string                     `json:",omitempty"`
+	ZipCode           string                     `json:",omitempty"`
+	NominalAmount     int                        `json:",omitempty"`
+	PaymentType       string                     `json:",omitempty"`
+	Amount            int                        `json:",omitempty"`
+	RebateAmount      int                        `json:",omitempty"`
+	Tags              []string                   `json:",omitempty"`
+	Rules             []Rule                     `json:",omitempty"`
+	DocumentId        string                     `json:",omitempty"`
+	Status            string                     `json:",omitempty"`
+	TransactionIds    []string                   `json:",omitempty"`
+	WorkspaceId       string                     `json:",omitempty"`
+	DebtorWorkspaceId string                     `json:",omitempty"`
+	TaxAmount         int                        `json:",omitempty"`
+	Interest          float64                    `json:",omitempty"`
+	Created           *time.Time                 `json:",omitempty"`
+	Updated           *time.Time                 `json:",omitempty"`
+	Extra             map[string]json.RawMessage `json:"-"`
 }
 
 var resource = map[string]string{"name": "CreditNote"}
 
+//	CreditNote Client struct
+//
+//	Runs the CreditNote functions with the settings of its own utils.Client
+//	instead of starkinfra.User and the other package defaults.
+
+type Client struct {
+	Api *utils.Client
+}
+
 func Create(notes []CreditNote, user user.User) ([]CreditNote, Error.StarkErrors) {
 	//	Create CreditNotes
 	//
@@ -106,12 +118,29 @@ func Create(notes []CreditNote, user user.User) ([]CreditNote, Error.StarkErrors
 	//
 	//	Return:
 	//	- Slice of CreditNote structs with updated attributes
+	return Client{Api: utils.Default(user)}.Create(notes)
+}
+
+func CreateCtx(ctx context.Context, notes []CreditNote, user user.User) ([]CreditNote, Error.StarkErrors) {
+	//	Create CreditNotes
+	//
+	//	Same as Create, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.CreateCtx(ctx, notes)
+}
+
+func (c Client) Create(notes []CreditNote) ([]CreditNote, Error.StarkErrors) {
+	//	Create CreditNotes
+	return c.CreateCtx(context.Background(), notes)
+}
+
+func (c Client) CreateCtx(ctx context.Context, notes []CreditNote) ([]CreditNote, Error.StarkErrors) {
+	//	Create CreditNotes
 	var creditNote []CreditNote
-	create, err := utils.Multi(resource, notes, nil, user)
-	unmarshalError := json.Unmarshal(create, &creditNote)
-	if unmarshalError != nil {
+	create, err := c.Api.Multi(ctx, resource, notes, nil)
+	if err.Errors != nil {
 		return creditNote, err
 	}
+	err = c.Api.Unmarshal(create, &creditNote)
 	return creditNote, err
 }
 
@@ -128,12 +157,29 @@ func Get(id string, user user.User) (CreditNote, Error.StarkErrors) {
 	//
 	//	Return:
 	//	- CreditNote struct that corresponds to the given id.
+	return Client{Api: utils.Default(user)}.Get(id)
+}
+
+func GetCtx(ctx context.Context, id string, user user.User) (CreditNote, Error.StarkErrors) {
+	//	Retrieve a specific CreditNote
+	//
+	//	Same as Get, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.GetCtx(ctx, id)
+}
+
+func (c Client) Get(id string) (CreditNote, Error.StarkErrors) {
+	//	Retrieve a specific CreditNote
+	return c.GetCtx(context.Background(), id)
+}
+
+func (c Client) GetCtx(ctx context.Context, id string) (CreditNote, Error.StarkErrors) {
+	//	Retrieve a specific CreditNote
 	var creditNote CreditNote
-	get, err := utils.Get(resource, id, nil, user)
-	unmarshalError := json.Unmarshal(get, &creditNote)
-	if unmarshalError != nil {
+	get, err := c.Api.Get(ctx, resource, id, nil)
+	if err.Errors != nil {
 		return creditNote, err
 	}
+	err = c.Api.Unmarshal(get, &creditNote)
 	return creditNote, err
 }
 
@@ -154,29 +200,133 @@ func Query(params map[string]interface{}, user user.User) (chan CreditNote, chan
 	//
 	//	Return:
 	//	- channel of CreditNote structs with updated attributes
-	var creditNote CreditNote
+	return Client{Api: utils.Default(user)}.Query(params)
+}
+
+func QueryCtx(ctx context.Context, params map[string]interface{}, user user.User) (chan CreditNote, chan Error.StarkErrors) {
+	//	Retrieve CreditNote structs
+	//
+	//	Same as Query, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.QueryCtx(ctx, params)
+}
+
+func (c Client) Query(params map[string]interface{}) (chan CreditNote, chan Error.StarkErrors) {
+	//	Retrieve CreditNote structs
+	return c.QueryCtx(context.Background(), params)
+}
+
+func (c Client) QueryCtx(ctx context.Context, params map[string]interface{}) (chan CreditNote, chan Error.StarkErrors) {
+	//	Retrieve CreditNote structs
 	notes := make(chan CreditNote)
 	notesError := make(chan Error.StarkErrors)
-	query, errorChannel := utils.Query(resource, params, user)
+	query, errorChannel := c.Api.Stream(ctx, resource, params)
 	go func() {
+		defer close(notesError)
+		defer close(notes)
 		for content := range query {
-			contentByte, _ := json.Marshal(content)
-			err := json.Unmarshal(contentByte, &creditNote)
-			if err != nil {
-				notesError <- Error.UnknownError(err.Error())
+			var creditNote CreditNote
+			err := c.Api.Unmarshal(content, &creditNote)
+			if err.Errors != nil {
+				if !utils.SendError(ctx, notesError, err) {
+					return
+				}
 				continue
 			}
-			notes <- creditNote
+			select {
+			case notes <- creditNote:
+			case <-ctx.Done():
+				return
+			}
 		}
 		for err := range errorChannel {
-			notesError <- err
+			if !utils.SendError(ctx, notesError, err) {
+				return
+			}
 		}
-		close(notes)
-		close(notesError)
 	}()
 	return notes, notesError
 }
 
+//	CreditNote Iterator struct
+//
+//	Returned by Iterate to walk through CreditNote structs one at a time,
+//	requesting a new page only once the current one is exhausted. Check Err
+//	once Next returns false and Close it when leaving the loop early.
+
+type Iterator struct {
+	api   *utils.Client
+	pages *utils.Iterator
+	value CreditNote
+	err   Error.StarkErrors
+}
+
+func Iterate(params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve CreditNote structs
+	//
+	//	Same as Query, but returns an Iterator instead of a pair of channels
+	//
+	//	Example:
+	//	iterator := Iterate(params, nil)
+	//	defer iterator.Close()
+	//	for iterator.Next() {
+	//		fmt.Println(iterator.Value())
+	//	}
+	//	if err := iterator.Err(); err.Errors != nil {
+	//		...
+	//	}
+	return Client{Api: utils.Default(user)}.Iterate(params)
+}
+
+func IterateCtx(ctx context.Context, params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve CreditNote structs
+	//
+	//	Same as Iterate, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.IterateCtx(ctx, params)
+}
+
+func (c Client) Iterate(params map[string]interface{}) *Iterator {
+	//	Retrieve CreditNote structs
+	return c.IterateCtx(context.Background(), params)
+}
+
+func (c Client) IterateCtx(ctx context.Context, params map[string]interface{}) *Iterator {
+	//	Retrieve CreditNote structs
+	return &Iterator{api: c.Api, pages: c.Api.Iterate(ctx, resource, params)}
+}
+
+func (i *Iterator) Next() bool {
+	//	Move to the next CreditNote, returning false when there are no more or a request failed
+	var value CreditNote
+	if i.err.Errors != nil || !i.pages.Next() {
+		return false
+	}
+	i.err = i.api.Unmarshal(i.pages.Value(), &value)
+	if i.err.Errors != nil {
+		i.pages.Close()
+		return false
+	}
+	i.value = value
+	return true
+}
+
+func (i *Iterator) Value() CreditNote {
+	//	Current CreditNote, set by the last call to Next
+	return i.value
+}
+
+func (i *Iterator) Err() Error.StarkErrors {
+	//	Error that stopped the iteration, if any
+	if i.err.Errors != nil {
+		return i.err
+	}
+	return i.pages.Err()
+}
+
+func (i *Iterator) Close() {
+	//	Stop the iteration, releasing the current page
+	i.pages.Close()
+}
+
 func Page(params map[string]interface{}, user user.User) ([]CreditNote, string, Error.StarkErrors) {
 	//	Retrieve paged CreditNote structs
 	//
@@ -197,12 +347,29 @@ func Page(params map[string]interface{}, user user.User) ([]CreditNote, string,
 	//	Return:
 	//	- slice of CreditNote structs with updated attributes
 	//	- cursor to retrieve the next page of CreditNote structs
+	return Client{Api: utils.Default(user)}.Page(params)
+}
+
+func PageCtx(ctx context.Context, params map[string]interface{}, user user.User) ([]CreditNote, string, Error.StarkErrors) {
+	//	Retrieve paged CreditNote structs
+	//
+	//	Same as Page, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.PageCtx(ctx, params)
+}
+
+func (c Client) Page(params map[string]interface{}) ([]CreditNote, string, Error.StarkErrors) {
+	//	Retrieve paged CreditNote structs
+	return c.PageCtx(context.Background(), params)
+}
+
+func (c Client) PageCtx(ctx context.Context, params map[string]interface{}) ([]CreditNote, string, Error.StarkErrors) {
+	//	Retrieve paged CreditNote structs
 	var creditNotes []CreditNote
-	page, cursor, err := utils.Page(resource, params, user)
-	unmarshalError := json.Unmarshal(page, &creditNotes)
-	if unmarshalError != nil {
+	page, cursor, err := c.Api.Page(ctx, resource, params)
+	if err.Errors != nil {
 		return creditNotes, cursor, err
 	}
+	err = c.Api.Unmarshal(page, &creditNotes)
 	return creditNotes, cursor, err
 }
 
@@ -219,11 +386,28 @@ func Cancel(id string, user user.User) (CreditNote, Error.StarkErrors) {
 	//
 	//	Return:
 	//	- canceled CreditNote struct
+	return Client{Api: utils.Default(user)}.Cancel(id)
+}
+
+func CancelCtx(ctx context.Context, id string, user user.User) (CreditNote, Error.StarkErrors) {
+	//	Cancel a CreditNote entity
+	//
+	//	Same as Cancel, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.CancelCtx(ctx, id)
+}
+
+func (c Client) Cancel(id string) (CreditNote, Error.StarkErrors) {
+	//	Cancel a CreditNote entity
+	return c.CancelCtx(context.Background(), id)
+}
+
+func (c Client) CancelCtx(ctx context.Context, id string) (CreditNote, Error.StarkErrors) {
+	//	Cancel a CreditNote entity
 	var creditNote CreditNote
-	deleted, err := utils.Delete(resource, id, user)
-	unmarshalError := json.Unmarshal(deleted, &creditNote)
-	if unmarshalError != nil {
+	deleted, err := c.Api.Delete(ctx, resource, id)
+	if err.Errors != nil {
 		return creditNote, err
 	}
+	err = c.Api.Unmarshal(deleted, &creditNote)
 	return creditNote, err
 }
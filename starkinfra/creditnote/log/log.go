@@ -1,6 +1,7 @@
 package log
 
 import (
+	"context"
 	"encoding/json"
 	Error "github.com/starkinfra/core-go/starkcore/error"
 	"github.com/starkinfra/core-go/starkcore/user/user"
@@ -22,17 +23,28 @@ import (
 //	- Errors [slice of strings]: Slice of errors linked to this CreditNote event
 //	- Type [string]: Type of the CreditNote event which triggered the log creation. ex: "canceled", "created", "expired", "failed", "refunded", "registered", "sending", "sent", "signed", "success"
 //	- Created [time.Time]: Creation datetime for the log. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC),
+//	- Extra [map[string]json.RawMessage]: Fields of the CreditNote.Log sent by the API but not declared in this SDK version yet, keyed by their JSON name. ex: map[string]json.RawMessage{"newField": json.RawMessage(`"value"`)}
 
 type Log struct {
-	Id      string                `json:",omitempty"`
-	Note    CreditNote.CreditNote `json:",omitempty"`
-	Errors  []string              `json:",omitempty"`
-	Type    string                `json:",omitempty"`
-	Created *time.Time            `json:",omitempty"`
+	Id      string                     `json:",omitempty"`
+	Note    CreditNote.CreditNote      `json:",omitempty"`
+	Errors  []string                   `json:",omitempty"`
+	Type    string                     `json:",omitempty"`
+	Created *time.Time                 `json:",omitempty"`
+	Extra   map[string]json.RawMessage `json:"-"`
 }
 
 var resource = map[string]string{"name": "CreditNoteLog"}
 
+//	CreditNote.Log Client struct
+//
+//	Runs the CreditNote.Log functions with the settings of its own utils.Client
+//	instead of starkinfra.User and the other package defaults.
+
+type Client struct {
+	Api *utils.Client
+}
+
 func Get(id string, user user.User) (Log, Error.StarkErrors) {
 	//	Retrieve a specific CreditNote.Log
 	//
@@ -46,12 +58,29 @@ func Get(id string, user user.User) (Log, Error.StarkErrors) {
 	//
 	//	Return:
 	//	- CreditNote.Log struct that corresponds to the given id.
+	return Client{Api: utils.Default(user)}.Get(id)
+}
+
+func GetCtx(ctx context.Context, id string, user user.User) (Log, Error.StarkErrors) {
+	//	Retrieve a specific CreditNote.Log
+	//
+	//	Same as Get, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.GetCtx(ctx, id)
+}
+
+func (c Client) Get(id string) (Log, Error.StarkErrors) {
+	//	Retrieve a specific CreditNote.Log
+	return c.GetCtx(context.Background(), id)
+}
+
+func (c Client) GetCtx(ctx context.Context, id string) (Log, Error.StarkErrors) {
+	//	Retrieve a specific CreditNote.Log
 	var creditNoteLog Log
-	get, err := utils.Get(resource, id, nil, user)
-	unmarshalError := json.Unmarshal(get, &creditNoteLog)
-	if unmarshalError != nil {
+	get, err := c.Api.Get(ctx, resource, id, nil)
+	if err.Errors != nil {
 		return creditNoteLog, err
 	}
+	err = c.Api.Unmarshal(get, &creditNoteLog)
 	return creditNoteLog, err
 }
 
@@ -71,29 +100,133 @@ func Query(params map[string]interface{}, user user.User) (chan Log, chan Error.
 	//
 	//	Return:
 	//	- Channel  of note.Log structs with updated attributes
-	var creditNoteLog Log
+	return Client{Api: utils.Default(user)}.Query(params)
+}
+
+func QueryCtx(ctx context.Context, params map[string]interface{}, user user.User) (chan Log, chan Error.StarkErrors) {
+	//	Retrieve CreditNote.Log structs
+	//
+	//	Same as Query, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.QueryCtx(ctx, params)
+}
+
+func (c Client) Query(params map[string]interface{}) (chan Log, chan Error.StarkErrors) {
+	//	Retrieve CreditNote.Log structs
+	return c.QueryCtx(context.Background(), params)
+}
+
+func (c Client) QueryCtx(ctx context.Context, params map[string]interface{}) (chan Log, chan Error.StarkErrors) {
+	//	Retrieve CreditNote.Log structs
 	logs := make(chan Log)
 	logsError := make(chan Error.StarkErrors)
-	query, errorChannel := utils.Query(resource, params, user)
+	query, errorChannel := c.Api.Stream(ctx, resource, params)
 	go func() {
+		defer close(logsError)
+		defer close(logs)
 		for content := range query {
-			contentByte, _ := json.Marshal(content)
-			err := json.Unmarshal(contentByte, &creditNoteLog)
-			if err != nil {
-				logsError <- Error.UnknownError(err.Error())
+			var creditNoteLog Log
+			err := c.Api.Unmarshal(content, &creditNoteLog)
+			if err.Errors != nil {
+				if !utils.SendError(ctx, logsError, err) {
+					return
+				}
 				continue
 			}
-			logs <- creditNoteLog
+			select {
+			case logs <- creditNoteLog:
+			case <-ctx.Done():
+				return
+			}
 		}
 		for err := range errorChannel {
-			logsError <- err
+			if !utils.SendError(ctx, logsError, err) {
+				return
+			}
 		}
-		close(logs)
-		close(logsError)
 	}()
 	return logs, logsError
 }
 
+//	CreditNote.Log Iterator struct
+//
+//	Returned by Iterate to walk through CreditNote.Log structs one at a time,
+//	requesting a new page only once the current one is exhausted. Check Err
+//	once Next returns false and Close it when leaving the loop early.
+
+type Iterator struct {
+	api   *utils.Client
+	pages *utils.Iterator
+	value Log
+	err   Error.StarkErrors
+}
+
+func Iterate(params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve CreditNote.Log structs
+	//
+	//	Same as Query, but returns an Iterator instead of a pair of channels
+	//
+	//	Example:
+	//	iterator := Iterate(params, nil)
+	//	defer iterator.Close()
+	//	for iterator.Next() {
+	//		fmt.Println(iterator.Value())
+	//	}
+	//	if err := iterator.Err(); err.Errors != nil {
+	//		...
+	//	}
+	return Client{Api: utils.Default(user)}.Iterate(params)
+}
+
+func IterateCtx(ctx context.Context, params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve CreditNote.Log structs
+	//
+	//	Same as Iterate, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.IterateCtx(ctx, params)
+}
+
+func (c Client) Iterate(params map[string]interface{}) *Iterator {
+	//	Retrieve CreditNote.Log structs
+	return c.IterateCtx(context.Background(), params)
+}
+
+func (c Client) IterateCtx(ctx context.Context, params map[string]interface{}) *Iterator {
+	//	Retrieve CreditNote.Log structs
+	return &Iterator{api: c.Api, pages: c.Api.Iterate(ctx, resource, params)}
+}
+
+func (i *Iterator) Next() bool {
+	//	Move to the next CreditNote.Log, returning false when there are no more or a request failed
+	var value Log
+	if i.err.Errors != nil || !i.pages.Next() {
+		return false
+	}
+	i.err = i.api.Unmarshal(i.pages.Value(), &value)
+	if i.err.Errors != nil {
+		i.pages.Close()
+		return false
+	}
+	i.value = value
+	return true
+}
+
+func (i *Iterator) Value() Log {
+	//	Current CreditNote.Log, set by the last call to Next
+	return i.value
+}
+
+func (i *Iterator) Err() Error.StarkErrors {
+	//	Error that stopped the iteration, if any
+	if i.err.Errors != nil {
+		return i.err
+	}
+	return i.pages.Err()
+}
+
+func (i *Iterator) Close() {
+	//	Stop the iteration, releasing the current page
+	i.pages.Close()
+}
+
 func Page(params map[string]interface{}, user user.User) ([]Log, string, Error.StarkErrors) {
 	//	Retrieve paged CreditNote.Log structs
 	//
@@ -113,11 +246,28 @@ func Page(params map[string]interface{}, user user.User) ([]Log, string, Error.S
 	//	Return:
 	//	- Slice of CreditNote.Log structs with updated attributes
 	//	- Cursor to retrieve the next page of CreditNote.Log structs
+	return Client{Api: utils.Default(user)}.Page(params)
+}
+
+func PageCtx(ctx context.Context, params map[string]interface{}, user user.User) ([]Log, string, Error.StarkErrors) {
+	//	Retrieve paged CreditNote.Log structs
+	//
+	//	Same as Page, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.PageCtx(ctx, params)
+}
+
+func (c Client) Page(params map[string]interface{}) ([]Log, string, Error.StarkErrors) {
+	//	Retrieve paged CreditNote.Log structs
+	return c.PageCtx(context.Background(), params)
+}
+
+func (c Client) PageCtx(ctx context.Context, params map[string]interface{}) ([]Log, string, Error.StarkErrors) {
+	//	Retrieve paged CreditNote.Log structs
 	var creditNoteLogs []Log
-	page, cursor, err := utils.Page(resource, params, user)
-	unmarshalError := json.Unmarshal(page, &creditNoteLogs)
-	if unmarshalError != nil {
+	page, cursor, err := c.Api.Page(ctx, resource, params)
+	if err.Errors != nil {
 		return creditNoteLogs, cursor, err
 	}
+	err = c.Api.Unmarshal(page, &creditNoteLogs)
 	return creditNoteLogs, cursor, err
 }
@@ -1,6 +1,7 @@
 package creditpreview
 
 import (
+	"context"
 	"encoding/json"
 	Error "github.com/starkinfra/core-go/starkcore/error"
 	"github.com/starkinfra/core-go/starkcore/user/user"
@@ -17,14 +18,27 @@ import (
 //
 //	Parameters (conditionally required):
 //	- Type [string]: Credit type. ex: "credit-note"
+//
+//	Attributes (return-only):
+//	- Extra [map[string]json.RawMessage]: Fields of the CreditPreview sent by the API but not declared in this SDK version yet, keyed by their JSON name. ex: map[string]json.RawMessage{"newField": json.RawMessage(`"value"`)}
 
 type CreditPreview struct {
-	Credit CreditNotePreview `json:",omitempty"`
-	Type   string            `json:",omitempty"`
+	Credit CreditNotePreview          `json:",omitempty"`
+	Type   string                     `json:",omitempty"`
+	Extra  map[string]json.RawMessage `json:"-"`
 }
 
 var subResource = map[string]string{"name": "CreditPreview"}
 
+//	CreditPreview Client struct
+//
+//	Runs the CreditPreview functions with the settings of its own utils.Client
+//	instead of starkinfra.User and the other package defaults.
+
+type Client struct {
+	Api *utils.Client
+}
+
 func Create(previews []CreditPreview, user user.User) ([]CreditPreview, Error.StarkErrors) {
 	//	Create CreditPreviews
 	//
@@ -38,10 +52,27 @@ func Create(previews []CreditPreview, user user.User) ([]CreditPreview, Error.St
 	//
 	//	Return:
 	//	- slice of CreditPreview structs with updated attributes
-	create, err := utils.Multi(subResource, previews, nil, user)
-	unmarshalError := json.Unmarshal(create, &previews)
-	if unmarshalError != nil {
+	return Client{Api: utils.Default(user)}.Create(previews)
+}
+
+func CreateCtx(ctx context.Context, previews []CreditPreview, user user.User) ([]CreditPreview, Error.StarkErrors) {
+	//	Create CreditPreviews
+	//
+	//	Same as Create, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.CreateCtx(ctx, previews)
+}
+
+func (c Client) Create(previews []CreditPreview) ([]CreditPreview, Error.StarkErrors) {
+	//	Create CreditPreviews
+	return c.CreateCtx(context.Background(), previews)
+}
+
+func (c Client) CreateCtx(ctx context.Context, previews []CreditPreview) ([]CreditPreview, Error.StarkErrors) {
+	//	Create CreditPreviews
+	create, err := c.Api.Multi(ctx, subResource, previews, nil)
+	if err.Errors != nil {
 		return previews, err
 	}
+	err = c.Api.Unmarshal(create, &previews)
 	return previews, err
 }
@@ -1,5 +1,9 @@
 package creditsigner
 
+import (
+	"encoding/json"
+)
+
 //	CreditSigner struct
 //
 //	CreditNote signer's information.
@@ -11,10 +15,12 @@ package creditsigner
 //
 //	Attributes (return-only):
 //	- Id [string]: Unique id returned when the CreditSigner is created. ex: "5656565656565656"
+//	- Extra [map[string]json.RawMessage]: Fields of the CreditSigner sent by the API but not declared in this SDK version yet, keyed by their JSON name. ex: map[string]json.RawMessage{"newField": json.RawMessage(`"value"`)}
 
 type CreditSigner struct {
-	Name    string `json:",omitempty"`
-	Contact string `json:",omitempty"`
-	Method  string `json:",omitempty"`
-	Id      string `json:",omitempty"`
+	Name    string                     `json:",omitempty"`
+	Contact string                     `json:",omitempty"`
+	Method  string                     `json:",omitempty"`
+	Id      string                     `json:",omitempty"`
+	Extra   map[string]json.RawMessage `json:"-"`
 }
@@ -1,6 +1,7 @@
 package dynamicbrcode
 
 import (
+	"context"
 	"encoding/json"
 	Error "github.com/starkinfra/core-go/starkcore/error"
 	"github.com/starkinfra/core-go/starkcore/user/user"
@@ -38,22 +39,33 @@ import (
 //  - Url [string]: URL link to the BR Code image. ex: "https://brcode-h.sandbox.starkinfra.com/dynamic-qrcode/901e71f2447c43c886f58366a5432c4b.png"
 //  - Updated [time.Time]: Latest update datetime for the DynamicBrcode. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC),
 //  - Created [time.Time]: Creation datetime for the DynamicBrcode. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC),
+//	- Extra [map[string]json.RawMessage]: Fields of the DynamicBrcode sent by the API but not declared in this SDK version yet, keyed by their JSON name. ex: map[string]json.RawMessage{"newField": json.RawMessage(`"value"`)}
 
 type DynamicBrcode struct {
-	Name       string     `json:",omitempty"`
-	City       string     `json:",omitempty"`
-	ExternalId string     `json:",omitempty"`
-	Type       string     `json:",omitempty"`
-	Tags       []string   `json:",omitempty"`
-	Id         string     `json:",omitempty"`
-	Uuid       string     `json:",omitempty"`
-	Url        string     `json:",omitempty"`
-	Updated    *time.Time `json:",omitempty"`
-	Created    *time.Time `json:",omitempty"`
+	Name       string                     `json:",omitempty"`
+	City       string                     `json:",omitempty"`
+	ExternalId string                     `json:",omitempty"`
+	Type       string                     `json:",omitempty"`
+	Tags       []string                   `json:",omitempty"`
+	Id         string                     `json:",omitempty"`
+	Uuid       string                     `json:",omitempty"`
+	Url        string                     `json:",omitempty"`
+	Updated    *time.Time                 `json:",omitempty"`
+	Created    *time.Time                 `json:",omitempty"`
+	Extra      map[string]json.RawMessage `json:"-"`
 }
 
 var resource = map[string]string{"name": "DynamicBrcode"}
 
+//	DynamicBrcode Client struct
+//
+//	Runs the DynamicBrcode functions with the settings of its own utils.Client
+//	instead of starkinfra.User and the other package defaults.
+
+type Client struct {
+	Api *utils.Client
+}
+
 func Create(brcodes []DynamicBrcode, user user.User) ([]DynamicBrcode, Error.StarkErrors) {
 	//	Create DynamicBrcodes
 	//
@@ -67,11 +79,28 @@ func Create(brcodes []DynamicBrcode, user user.User) ([]DynamicBrcode, Error.Sta
 	//
 	//	Return:
 	//	- slice of DynamicBrcode structs with updated attributes
-	create, err := utils.Multi(resource, brcodes, nil, user)
-	unmarshalError := json.Unmarshal(create, &brcodes)
-	if unmarshalError != nil {
+	return Client{Api: utils.Default(user)}.Create(brcodes)
+}
+
+func CreateCtx(ctx context.Context, brcodes []DynamicBrcode, user user.User) ([]DynamicBrcode, Error.StarkErrors) {
+	//	Create DynamicBrcodes
+	//
+	//	Same as Create, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.CreateCtx(ctx, brcodes)
+}
+
+func (c Client) Create(brcodes []DynamicBrcode) ([]DynamicBrcode, Error.StarkErrors) {
+	//	Create DynamicBrcodes
+	return c.CreateCtx(context.Background(), brcodes)
+}
+
+func (c Client) CreateCtx(ctx context.Context, brcodes []DynamicBrcode) ([]DynamicBrcode, Error.StarkErrors) {
+	//	Create DynamicBrcodes
+	create, err := c.Api.Multi(ctx, resource, brcodes, nil)
+	if err.Errors != nil {
 		return brcodes, err
 	}
+	err = c.Api.Unmarshal(create, &brcodes)
 	return brcodes, err
 }
 
@@ -88,12 +117,29 @@ func Get(uuid string, user user.User) (DynamicBrcode, Error.StarkErrors) {
 	//
 	//	Return:
 	//	- dynamicBrcode struct that corresponds to the given id.
+	return Client{Api: utils.Default(user)}.Get(uuid)
+}
+
+func GetCtx(ctx context.Context, uuid string, user user.User) (DynamicBrcode, Error.StarkErrors) {
+	//	Retrieve a specific DynamicBrcode
+	//
+	//	Same as Get, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.GetCtx(ctx, uuid)
+}
+
+func (c Client) Get(uuid string) (DynamicBrcode, Error.StarkErrors) {
+	//	Retrieve a specific DynamicBrcode
+	return c.GetCtx(context.Background(), uuid)
+}
+
+func (c Client) GetCtx(ctx context.Context, uuid string) (DynamicBrcode, Error.StarkErrors) {
+	//	Retrieve a specific DynamicBrcode
 	var dynamicBrcode DynamicBrcode
-	get, err := utils.Get(resource, uuid, nil, user)
-	unmarshalError := json.Unmarshal(get, &dynamicBrcode)
-	if unmarshalError != nil {
+	get, err := c.Api.Get(ctx, resource, uuid, nil)
+	if err.Errors != nil {
 		return dynamicBrcode, err
 	}
+	err = c.Api.Unmarshal(get, &dynamicBrcode)
 	return dynamicBrcode, err
 }
 
@@ -114,29 +160,133 @@ func Query(params map[string]interface{}, user user.User) (chan DynamicBrcode, c
 	//
 	//	Return:
 	//	- channel of DynamicBrcode structs with updated attributes
-	var dynamicBrcode DynamicBrcode
+	return Client{Api: utils.Default(user)}.Query(params)
+}
+
+func QueryCtx(ctx context.Context, params map[string]interface{}, user user.User) (chan DynamicBrcode, chan Error.StarkErrors) {
+	//	Retrieve DynamicBrcode structs
+	//
+	//	Same as Query, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.QueryCtx(ctx, params)
+}
+
+func (c Client) Query(params map[string]interface{}) (chan DynamicBrcode, chan Error.StarkErrors) {
+	//	Retrieve DynamicBrcode structs
+	return c.QueryCtx(context.Background(), params)
+}
+
+func (c Client) QueryCtx(ctx context.Context, params map[string]interface{}) (chan DynamicBrcode, chan Error.StarkErrors) {
+	//	Retrieve DynamicBrcode structs
 	brcodes := make(chan DynamicBrcode)
 	brcodesError := make(chan Error.StarkErrors)
-	query, errorChannel := utils.Query(resource, params, user)
+	query, errorChannel := c.Api.Stream(ctx, resource, params)
 	go func() {
+		defer close(brcodesError)
+		defer close(brcodes)
 		for content := range query {
-			contentByte, _ := json.Marshal(content)
-			err := json.Unmarshal(contentByte, &dynamicBrcode)
-			if err != nil {
-				brcodesError <- Error.UnknownError(err.Error())
+			var dynamicBrcode DynamicBrcode
+			err := c.Api.Unmarshal(content, &dynamicBrcode)
+			if err.Errors != nil {
+				if !utils.SendError(ctx, brcodesError, err) {
+					return
+				}
 				continue
 			}
-			brcodes <- dynamicBrcode
+			select {
+			case brcodes <- dynamicBrcode:
+			case <-ctx.Done():
+				return
+			}
 		}
 		for err := range errorChannel {
-			brcodesError <- err
+			if !utils.SendError(ctx, brcodesError, err) {
+				return
+			}
 		}
-		close(brcodes)
-		close(brcodesError)
 	}()
 	return brcodes, brcodesError
 }
 
+//	DynamicBrcode Iterator struct
+//
+//	Returned by Iterate to walk through DynamicBrcode structs one at a time,
+//	requesting a new page only once the current one is exhausted. Check Err
+//	once Next returns false and Close it when leaving the loop early.
+
+type Iterator struct {
+	api   *utils.Client
+	pages *utils.Iterator
+	value DynamicBrcode
+	err   Error.StarkErrors
+}
+
+func Iterate(params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve DynamicBrcode structs
+	//
+	//	Same as Query, but returns an Iterator instead of a pair of channels
+	//
+	//	Example:
+	//	iterator := Iterate(params, nil)
+	//	defer iterator.Close()
+	//	for iterator.Next() {
+	//		fmt.Println(iterator.Value())
+	//	}
+	//	if err := iterator.Err(); err.Errors != nil {
+	//		...
+	//	}
+	return Client{Api: utils.Default(user)}.Iterate(params)
+}
+
+func IterateCtx(ctx context.Context, params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve DynamicBrcode structs
+	//
+	//	Same as Iterate, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.IterateCtx(ctx, params)
+}
+
+func (c Client) Iterate(params map[string]interface{}) *Iterator {
+	//	Retrieve DynamicBrcode structs
+	return c.IterateCtx(context.Background(), params)
+}
+
+func (c Client) IterateCtx(ctx context.Context, params map[string]interface{}) *Iterator {
+	//	Retrieve DynamicBrcode structs
+	return &Iterator{api: c.Api, pages: c.Api.Iterate(ctx, resource, params)}
+}
+
+func (i *Iterator) Next() bool {
+	//	Move to the next DynamicBrcode, returning false when there are no more or a request failed
+	var value DynamicBrcode
+	if i.err.Errors != nil || !i.pages.Next() {
+		return false
+	}
+	i.err = i.api.Unmarshal(i.pages.Value(), &value)
+	if i.err.Errors != nil {
+		i.pages.Close()
+		return false
+	}
+	i.value = value
+	return true
+}
+
+func (i *Iterator) Value() DynamicBrcode {
+	//	Current DynamicBrcode, set by the last call to Next
+	return i.value
+}
+
+func (i *Iterator) Err() Error.StarkErrors {
+	//	Error that stopped the iteration, if any
+	if i.err.Errors != nil {
+		return i.err
+	}
+	return i.pages.Err()
+}
+
+func (i *Iterator) Close() {
+	//	Stop the iteration, releasing the current page
+	i.pages.Close()
+}
+
 func Page(params map[string]interface{}, user user.User) ([]DynamicBrcode, string, Error.StarkErrors) {
 	//	Retrieve paged DynamicBrcode structs
 	//
@@ -157,12 +307,29 @@ func Page(params map[string]interface{}, user user.User) ([]DynamicBrcode, strin
 	//	Return:
 	//	- slice of DynamicBrcode structs with updated attributes
 	//  - cursor to retrieve the next page of DynamicBrcode structs
+	return Client{Api: utils.Default(user)}.Page(params)
+}
+
+func PageCtx(ctx context.Context, params map[string]interface{}, user user.User) ([]DynamicBrcode, string, Error.StarkErrors) {
+	//	Retrieve paged DynamicBrcode structs
+	//
+	//	Same as Page, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.PageCtx(ctx, params)
+}
+
+func (c Client) Page(params map[string]interface{}) ([]DynamicBrcode, string, Error.StarkErrors) {
+	//	Retrieve paged DynamicBrcode structs
+	return c.PageCtx(context.Background(), params)
+}
+
+func (c Client) PageCtx(ctx context.Context, params map[string]interface{}) ([]DynamicBrcode, string, Error.StarkErrors) {
+	//	Retrieve paged DynamicBrcode structs
 	var dynamicBrcodes []DynamicBrcode
-	page, cursor, err := utils.Page(resource, params, user)
-	unmarshalError := json.Unmarshal(page, &dynamicBrcodes)
-	if unmarshalError != nil {
+	page, cursor, err := c.Api.Page(ctx, resource, params)
+	if err.Errors != nil {
 		return dynamicBrcodes, cursor, err
 	}
+	err = c.Api.Unmarshal(page, &dynamicBrcodes)
 	return dynamicBrcodes, cursor, err
 }
 
@@ -256,5 +423,22 @@ func Verify(uuid string, signature string, user user.User) (string, Error.StarkE
 	//
 	//	Return:
 	//	- verified Brcode's uuid.
-	return utils.Verify(uuid, signature, user)
+	return Client{Api: utils.Default(user)}.Verify(uuid, signature)
+}
+
+func VerifyCtx(ctx context.Context, uuid string, signature string, user user.User) (string, Error.StarkErrors) {
+	//	Verify a DynamicBrcode Read
+	//
+	//	Same as Verify, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.VerifyCtx(ctx, uuid, signature)
+}
+
+func (c Client) Verify(uuid string, signature string) (string, Error.StarkErrors) {
+	//	Verify a DynamicBrcode Read
+	return c.VerifyCtx(context.Background(), uuid, signature)
+}
+
+func (c Client) VerifyCtx(ctx context.Context, uuid string, signature string) (string, Error.StarkErrors) {
+	//	Verify a DynamicBrcode Read
+	return c.Api.Verify(ctx, uuid, signature)
 }
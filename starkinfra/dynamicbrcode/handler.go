@@ -0,0 +1,193 @@
+package dynamicbrcode
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/starkinfra/core-go/starkcore/user/user"
+	"github.com/starkinfra/sdk-go/starkinfra/utils"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+)
+
+//	ErrNotFound error
+//
+//	Returned, or wrapped, by a Handler resolver when no DynamicBrcode is known
+//	by the uuid it received. The Handler answers such reads with status 404.
+
+var ErrNotFound = errors.New("dynamicbrcode: not found")
+
+//	DynamicBrcode Handler struct
+//
+//	http.Handler that answers the GET requests Stark Infra sends to your
+//	registered url when a DynamicBrcode is read. The uuid of the BR Code is the
+//	last segment of the url path, so paths ending in "/", such as the root the
+//	Handler is mounted on, are answered with status 404. The uuid is checked
+//	against the "Digital-Signature" header before Resolve is called to look up
+//	the Read answer, such as an Instant, a Due or a SubscriptionRead, which is
+//	then validated and sent.
+//
+//	Stark Infra only waits 5 seconds for the answer, so the whole request runs
+//	under a Deadline. Reads with an invalid signature are refused with status
+//	401 and reads that cannot be verified at all, such as when the public key
+//	cannot be fetched, with status 500, or with status 504 if the Deadline is
+//	reached while they are being verified. If Resolve returns ErrNotFound, the
+//	read is answered with status 404. If it returns another error, panics or
+//	returns an answer that fails validation, the read is answered with status
+//	500, and if it does not return before the Deadline, with status 504.
+//
+//	Attributes:
+//	- Api [*utils.Client]: Client used to fetch the Stark Infra public key
+//	- Resolve [func(ctx context.Context, uuid string) (Read, error)]: Looks up the answer to the read of the DynamicBrcode with the given uuid. ctx is canceled when the Deadline is reached
+//	- Deadline [time.Duration, default 5s]: Time budget of each read, from its arrival to its answer. ex: 4 * time.Second
+//	- SlowThreshold [time.Duration, default 3s]: Resolve durations from which OnSlow is called. ex: time.Second
+//	- OnSlow [func(uuid string, elapsed time.Duration), default nil]: Called once Resolve returns after SlowThreshold, even if the Deadline was already reached, with the time it took
+//	- OnError [func(uuid string, cause error), default nil]: Called whenever a read with a uuid is not answered with status 200, with ErrNotFound, context.DeadlineExceeded, the error of Resolve or of its panic, or the validation error of the answer. Reads refused during the verification are reported with context.DeadlineExceeded, or with a *utils.Error wrapping ErrInvalidSignature or the error that prevented the verification
+//
+//	Example:
+//	handler := dynamicbrcode.NewHandler(func(ctx context.Context, uuid string) (dynamicbrcode.Read, error) {
+//		return getMyRead(ctx, uuid)
+//	}, nil)
+//	http.Handle("/dynamic-brcode/", handler)
+
+type Handler struct {
+	Api           *utils.Client
+	Resolve       func(ctx context.Context, uuid string) (Read, error)
+	Deadline      time.Duration
+	SlowThreshold time.Duration
+	OnSlow        func(uuid string, elapsed time.Duration)
+	OnError       func(uuid string, cause error)
+}
+
+func NewHandler(resolve func(ctx context.Context, uuid string) (Read, error), user user.User) *Handler {
+	//	Create a DynamicBrcode Handler
+	//
+	//	Parameters (required):
+	//	- resolve [func]: Callback returning the Read answer of the DynamicBrcode with the given uuid, or ErrNotFound. ex: func(ctx context.Context, uuid string) (dynamicbrcode.Read, error) { return dynamicbrcode.Instant{...}, nil }
+	//
+	//	Parameters (optional):
+	//	- user [Organization/Project struct, default nil]: Organization or Project struct. Not necessary if starkinfra.User was set before function call
+	//
+	//	Return:
+	//	- Handler serving the reads
+	return Client{Api: utils.Default(user)}.NewHandler(resolve)
+}
+
+func (c Client) NewHandler(resolve func(ctx context.Context, uuid string) (Read, error)) *Handler {
+	//	Create a DynamicBrcode Handler
+	return &Handler{Api: c.Api, Resolve: resolve}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		http.Error(w, "DynamicBrcode reads must be sent with GET", http.StatusMethodNotAllowed)
+		return
+	}
+	if strings.HasSuffix(r.URL.Path, "/") {
+		http.NotFound(w, r)
+		return
+	}
+	uuid := path.Base(r.URL.Path)
+	ctx, cancel := context.WithTimeout(r.Context(), h.deadline())
+	defer cancel()
+
+	_, err := Client{Api: h.Api}.VerifyCtx(ctx, uuid, r.Header.Get("Digital-Signature"))
+	if err.Errors != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		if h.OnError != nil {
+			h.OnError(uuid, ctx.Err())
+		}
+		http.Error(w, "The DynamicBrcode read was not verified in time", http.StatusGatewayTimeout)
+		return
+	}
+	if err.Errors != nil {
+		if h.OnError != nil {
+			h.OnError(uuid, utils.ToError(err))
+		}
+		status := http.StatusInternalServerError
+		if err.Errors[0].Code == "invalidSignatureError" {
+			status = http.StatusUnauthorized
+		}
+		http.Error(w, fmt.Sprintf("%v: %v", err.Errors[0].Code, err.Errors[0].Message), status)
+		return
+	}
+
+	response, cause := h.resolve(ctx, uuid)
+	if cause != nil {
+		if h.OnError != nil {
+			h.OnError(uuid, cause)
+		}
+		switch {
+		case errors.Is(cause, ErrNotFound):
+			http.NotFound(w, r)
+		case errors.Is(cause, context.DeadlineExceeded):
+			http.Error(w, "The DynamicBrcode read was not resolved in time", http.StatusGatewayTimeout)
+		default:
+			http.Error(w, "The DynamicBrcode read could not be resolved", http.StatusInternalServerError)
+		}
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	io.WriteString(w, response)
+}
+
+func (h *Handler) resolve(ctx context.Context, uuid string) (string, error) {
+	type result struct {
+		response string
+		cause    error
+	}
+	results := make(chan result, 1)
+	go func() {
+		start := time.Now()
+		defer func() {
+			if elapsed := time.Since(start); h.OnSlow != nil && elapsed >= h.slowThreshold() {
+				h.OnSlow(uuid, elapsed)
+			}
+		}()
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				results <- result{cause: fmt.Errorf("DynamicBrcode resolver panicked: %v", recovered)}
+			}
+		}()
+		read, cause := h.Resolve(ctx, uuid)
+		if cause != nil {
+			results <- result{cause: cause}
+			return
+		}
+		if read == nil {
+			results <- result{cause: ErrNotFound}
+			return
+		}
+		response, err := read.Response()
+		if err.Errors != nil {
+			results <- result{cause: fmt.Errorf("DynamicBrcode resolver returned an invalid answer: %v", err.Errors[0].Message)}
+			return
+		}
+		results <- result{response: response}
+	}()
+
+	select {
+	case result := <-results:
+		return result.response, result.cause
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+}
+
+func (h *Handler) deadline() time.Duration {
+	if h.Deadline <= 0 {
+		return 5 * time.Second
+	}
+	return h.Deadline
+}
+
+func (h *Handler) slowThreshold() time.Duration {
+	if h.SlowThreshold <= 0 {
+		return 3 * time.Second
+	}
+	return h.SlowThreshold
+}
@@ -0,0 +1,34 @@
+package dynamicbrcode
+
+import (
+	"github.com/starkinfra/sdk-go/starkinfra/utils"
+	"time"
+)
+
+//	DynamicBrcode QueryParams struct
+//
+//	Typed filters for Query, Iterate and Page. Fields left empty are not sent.
+//
+//	Attributes:
+//	- Limit [int, default nil]: Maximum number of structs to be retrieved. Unlimited if nil, except on Page, which returns up to 100. ex: 35
+//	- Cursor [string, default nil]: Cursor returned on the previous Page call. Only used by Page
+//	- After [time.Time, default nil]: Date filter for structs created only after specified date. ex: time.Date(2022, 11, 10, 0, 0, 0, 0, time.UTC)
+//	- Before [time.Time, default nil]: Date filter for structs created only before specified date. ex: time.Date(2022, 11, 10, 0, 0, 0, 0, time.UTC)
+//	- ExternalIds [slice of strings, default nil]: Slice of externalIds to filter retrieved structs. ex: []string{"my_external_id1", "my_external_id2"}
+//	- Uuids [slice of strings, default nil]: Slice of uuids to filter retrieved structs. ex: []string{"901e71f2447c43c886f58366a5432c4b", "4e2eab725ddd495f9c98ffd97440702d"}
+//	- Tags [slice of strings, default nil]: Slice of tags to filter retrieved structs. ex: []string{"travel", "food"}
+
+type QueryParams struct {
+	Limit       int       `json:"limit,omitempty"`
+	Cursor      string    `json:"cursor,omitempty"`
+	After       time.Time `json:"after,omitempty"`
+	Before      time.Time `json:"before,omitempty"`
+	ExternalIds []string  `json:"externalIds,omitempty"`
+	Uuids       []string  `json:"uuids,omitempty"`
+	Tags        []string  `json:"tags,omitempty"`
+}
+
+func (p QueryParams) Map() map[string]interface{} {
+	//	Convert the QueryParams into the params map taken by Query, Iterate and Page
+	return utils.ParamsMap(p)
+}
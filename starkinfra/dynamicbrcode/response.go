@@ -0,0 +1,609 @@
+package dynamicbrcode
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"github.com/starkbank/ecdsa-go/v2/ellipticcurve/ecdsa"
+	"github.com/starkbank/ecdsa-go/v2/ellipticcurve/privatekey"
+	Error "github.com/starkinfra/core-go/starkcore/error"
+	"github.com/starkinfra/sdk-go/starkinfra/utils"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+//	DynamicBrcode Read interface
+//
+//	Answer to the GET request Stark Infra sends to your registered url when a
+//	DynamicBrcode is read. Each DynamicBrcode Type has its own struct: Instant,
+//	Due, SubscriptionRead, SubscriptionAndInstant and DueAndOrSubscription.
+
+type Read interface {
+	Validate() Error.StarkErrors
+	Response() (string, Error.StarkErrors)
+}
+
+//	Instant struct
+//
+//	Answer to the read of an "instant" DynamicBrcode.
+//
+//	Attributes:
+//	- Version [int]: How many times the BR Code was updated, starting at 0. ex: 1
+//	- Created [time.Time]: Creation datetime of the DynamicBrcode. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC)
+//	- KeyId [string]: Receiver's PixKey id. ex: "+5511989898989"
+//	- Status [string]: BR Code status. Options: "created", "overdue", "paid", "canceled" or "expired"
+//	- ReconciliationId [string]: Id used to reconcile the resulting Pix transaction, with 26 to 35 alphanumeric characters. ex: "cd65c78aeb6543eaaa0170f68bd741ee"
+//	- Amount [int]: Positive amount in cents of the resulting Pix transaction. ex: 1234 (= R$ 12.34)
+//	- CashierType [string, default nil]: Cashier's type. Required if CashAmount is not 0. Options: "merchant", "participant" and "other"
+//	- CashierBankCode [string, default nil]: Cashier's bank code. Required if CashAmount is not 0. ex: "20018183"
+//	- CashAmount [int, default 0]: Amount to be withdrawn from the cashier in cents. ex: 1000 (= R$ 10.00)
+//	- Expiration [*int, default 86400 (1 day)]: Time in seconds counted from Created until the BR Code expires. ex: &expiration
+//	- SenderName [string, default nil]: Sender's full name. ex: "Anthony Edward Stark"
+//	- SenderTaxId [string, default nil]: Sender's CPF or CNPJ. ex: "01.001.001/0001-01"
+//	- AmountType [string, default "fixed"]: If "custom", the sender may change the amount at the moment of payment. Options: "fixed" or "custom"
+//	- Description [string, default nil]: Additional information shown to the sender at the moment of payment. ex: "Coffee"
+
+type Instant struct {
+	Version          int
+	Created          time.Time
+	KeyId            string
+	Status           string
+	ReconciliationId string
+	Amount           int
+	CashierType      string
+	CashierBankCode  string
+	CashAmount       int
+	Expiration       *int
+	SenderName       string
+	SenderTaxId      string
+	AmountType       string
+	Description      string
+}
+
+//	Due struct
+//
+//	Answer to the read of a "due" DynamicBrcode.
+//
+//	Attributes:
+//	- Version [int]: How many times the BR Code was updated, starting at 0. ex: 1
+//	- Created [time.Time]: Creation datetime of the DynamicBrcode. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC)
+//	- Due [time.Time]: Requested payment due datetime. ex: time.Date(2020, 3, 20, 0, 0, 0, 0, time.UTC)
+//	- KeyId [string]: Receiver's PixKey id. ex: "+5511989898989"
+//	- Status [string]: BR Code status. Options: "created", "overdue", "paid", "canceled" or "expired"
+//	- ReconciliationId [string]: Id used to reconcile the resulting Pix transaction, with 26 to 35 alphanumeric characters. ex: "cd65c78aeb6543eaaa0170f68bd741ee"
+//	- NominalAmount [int]: Positive amount in cents of the resulting Pix transaction, before fines, interest and discounts. ex: 1234 (= R$ 12.34)
+//	- SenderName [string]: Sender's full name. ex: "Anthony Edward Stark"
+//	- SenderTaxId [string]: Sender's CPF or CNPJ. ex: "01.001.001/0001-01"
+//	- ReceiverName [string]: Receiver's full name. ex: "Jamie Lannister"
+//	- ReceiverTaxId [string]: Receiver's CPF or CNPJ. ex: "012.345.678-90"
+//	- ReceiverStreetLine [string]: Receiver's main address. ex: "Av. Paulista, 200"
+//	- ReceiverCity [string]: Receiver's address city name. ex: "Sao Paulo"
+//	- ReceiverStateCode [string]: Receiver's address state code. ex: "SP"
+//	- ReceiverZipCode [string]: Receiver's address zip code. ex: "01234-567"
+//	- Expiration [*int, default 86400 (1 day)]: Time in seconds counted from Created until the BR Code expires. ex: &expiration
+//	- Fine [*float64, default 2.0]: Percentage charged if the sender pays after Due. Point it to 0 to charge no fine. ex: &fine
+//	- Interest [*float64, default 1.0]: Monthly interest percentage charged if the sender pays after Due. Point it to 0 to charge no interest. ex: &interest
+//	- Discounts [slice of Discount structs, default nil]: Discounts granted if the sender pays up to each of their dates. ex: []Discount{{Percentage: 10, Due: time.Date(2020, 3, 15, 0, 0, 0, 0, time.UTC)}}
+//	- Description [string, default nil]: Additional information shown to the sender at the moment of payment. ex: "Invoice #1234"
+
+type Due struct {
+	Version            int
+	Created            time.Time
+	Due                time.Time
+	KeyId              string
+	Status             string
+	ReconciliationId   string
+	NominalAmount      int
+	SenderName         string
+	SenderTaxId        string
+	ReceiverName       string
+	ReceiverTaxId      string
+	ReceiverStreetLine string
+	ReceiverCity       string
+	ReceiverStateCode  string
+	ReceiverZipCode    string
+	Expiration         *int
+	Fine               *float64
+	Interest           *float64
+	Discounts          []Discount
+	Description        string
+}
+
+//	Discount struct
+//
+//	Attributes:
+//	- Percentage [float64]: Percentage of the NominalAmount discounted. ex: 10.5
+//	- Due [time.Time]: Last datetime the discount is granted, up to the Due of the BR Code. ex: time.Date(2020, 3, 15, 0, 0, 0, 0, time.UTC)
+
+type Discount struct {
+	Percentage float64
+	Due        time.Time
+}
+
+//	Subscription struct
+//
+//	Recurring payment authorized when a "subscription", "subscriptionAndInstant"
+//	or "dueAndOrSubscription" DynamicBrcode is paid.
+//
+//	Attributes:
+//	- Interval [string]: Interval of the recurring charges. Options: "week", "month", "quarter", "semester" or "year"
+//	- InstallmentStart [time.Time]: Date of the first installment. ex: time.Date(2020, 4, 1, 0, 0, 0, 0, time.UTC)
+//	- InstallmentEnd [time.Time, default nil]: Date of the last installment, after InstallmentStart. The subscription has no end if zero. ex: time.Date(2021, 4, 1, 0, 0, 0, 0, time.UTC)
+//	- ReferenceCode [string]: Contract reference code, shown to the sender. ex: "contract-12345"
+//	- ReceiverName [string]: Receiver's full name. ex: "Jamie Lannister"
+//	- ReceiverTaxId [string]: Receiver's CPF or CNPJ. ex: "012.345.678-90"
+//	- ReceiverBankCode [string]: Receiver's bank code. ex: "20018183"
+//	- SenderFinalName [string]: Full name of the person the subscription is charged for. ex: "Anthony Edward Stark"
+//	- SenderFinalTaxId [string]: CPF or CNPJ of the person the subscription is charged for. ex: "01.001.001/0001-01"
+//	- Amount [int, default 0]: Fixed amount of each installment in cents. If 0, each installment may have a different amount. ex: 1000 (= R$ 10.00)
+//	- AmountMinLimit [int, default 0]: Minimum amount of each installment in cents, for variable amounts. ex: 500 (= R$ 5.00)
+//	- PullRetryLimit [int, default 0]: How many times a failed installment is retried. ex: 3
+//	- Description [string, default nil]: Additional information shown to the sender when authorizing the subscription. ex: "Gym membership"
+
+type Subscription struct {
+	Interval         string
+	InstallmentStart time.Time
+	InstallmentEnd   time.Time
+	ReferenceCode    string
+	ReceiverName     string
+	ReceiverTaxId    string
+	ReceiverBankCode string
+	SenderFinalName  string
+	SenderFinalTaxId string
+	Amount           int
+	AmountMinLimit   int
+	PullRetryLimit   int
+	Description      string
+}
+
+//	SubscriptionRead struct
+//
+//	Answer to the read of a "subscription" DynamicBrcode, which only authorizes a Subscription.
+//
+//	Attributes:
+//	- Version [int]: How many times the BR Code was updated, starting at 0. ex: 1
+//	- Created [time.Time]: Creation datetime of the DynamicBrcode. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC)
+//	- Status [string]: BR Code status. Options: "created", "overdue", "paid", "canceled" or "expired"
+//	- Subscription [Subscription struct]: Subscription authorized by the sender
+
+type SubscriptionRead struct {
+	Version      int
+	Created      time.Time
+	Status       string
+	Subscription Subscription
+}
+
+//	SubscriptionAndInstant struct
+//
+//	Answer to the read of a "subscriptionAndInstant" DynamicBrcode, whose payment
+//	also authorizes a Subscription. Its Instant attributes are validated as an Instant.
+//
+//	Attributes:
+//	- Instant [Instant struct]: Instant payment made when the BR Code is paid
+//	- Subscription [Subscription struct]: Subscription authorized by the payment
+
+type SubscriptionAndInstant struct {
+	Instant
+	Subscription Subscription
+}
+
+//	DueAndOrSubscription struct
+//
+//	Answer to the read of a "dueAndOrSubscription" DynamicBrcode, which the sender
+//	may pay as a due charge, authorize as a Subscription, or both.
+//
+//	Attributes:
+//	- Due [Due struct]: Due charge that may be paid
+//	- Subscription [Subscription struct]: Subscription that may be authorized
+
+type DueAndOrSubscription struct {
+	Due
+	Subscription Subscription
+}
+
+var statuses = map[string]bool{"created": true, "overdue": true, "paid": true, "canceled": true, "expired": true}
+var intervals = map[string]bool{"week": true, "month": true, "quarter": true, "semester": true, "year": true}
+var cashierTypes = map[string]bool{"merchant": true, "participant": true, "other": true}
+var reconciliationIdPattern = regexp.MustCompile(`^[a-zA-Z0-9]{26,35}$`)
+var stateCodePattern = regexp.MustCompile(`^[A-Z]{2}$`)
+var zipCodePattern = regexp.MustCompile(`^\d{5}-?\d{3}$`)
+
+func (i Instant) Validate() Error.StarkErrors {
+	//	Check the Instant before it is sent
+	//
+	//	Return:
+	//	- inputError naming the first missing or invalid attribute
+	if err := validateRead("Instant", i.Version, i.Created, i.Status); err.Errors != nil {
+		return err
+	}
+	if err := validatePayment("Instant", i.KeyId, i.ReconciliationId); err.Errors != nil {
+		return err
+	}
+	if i.Expiration != nil && *i.Expiration < 0 {
+		return utils.ValidationError(fmt.Sprintf("Instant Expiration cannot be negative, got %v", *i.Expiration))
+	}
+	if i.Amount <= 0 {
+		return utils.ValidationError(fmt.Sprintf("Instant Amount must be positive, got %v", i.Amount))
+	}
+	if i.AmountType != "" && i.AmountType != "fixed" && i.AmountType != "custom" {
+		return utils.ValidationError(fmt.Sprintf("Instant AmountType must be \"fixed\" or \"custom\", got %q", i.AmountType))
+	}
+	if i.CashAmount < 0 {
+		return utils.ValidationError(fmt.Sprintf("Instant CashAmount cannot be negative, got %v", i.CashAmount))
+	}
+	if i.CashAmount != 0 && (!cashierTypes[i.CashierType] || i.CashierBankCode == "") {
+		return utils.ValidationError("Instant CashierType and CashierBankCode are required when CashAmount is not 0")
+	}
+	return Error.StarkErrors{}
+}
+
+func (i Instant) Response() (string, Error.StarkErrors) {
+	//	Build the JSON string that must be returned to us on the instant DynamicBrcode read
+	//
+	//	Return:
+	//	- JSON string, or an inputError if Validate fails or the answer cannot be encoded as JSON
+	if err := i.Validate(); err.Errors != nil {
+		return "", err
+	}
+	return respond(i.fields())
+}
+
+func (d Due) Validate() Error.StarkErrors {
+	//	Check the Due before it is sent
+	//
+	//	Return:
+	//	- inputError naming the first missing or invalid attribute
+	if err := validateRead("Due", d.Version, d.Created, d.Status); err.Errors != nil {
+		return err
+	}
+	if err := validatePayment("Due", d.KeyId, d.ReconciliationId); err.Errors != nil {
+		return err
+	}
+	if d.Expiration != nil && *d.Expiration < 0 {
+		return utils.ValidationError(fmt.Sprintf("Due Expiration cannot be negative, got %v", *d.Expiration))
+	}
+	if d.Due.IsZero() {
+		return utils.ValidationError("Due Due is required")
+	}
+	if d.NominalAmount <= 0 {
+		return utils.ValidationError(fmt.Sprintf("Due NominalAmount must be positive, got %v", d.NominalAmount))
+	}
+	if err := required("Due", map[string]string{
+		"SenderName":         d.SenderName,
+		"SenderTaxId":        d.SenderTaxId,
+		"ReceiverName":       d.ReceiverName,
+		"ReceiverTaxId":      d.ReceiverTaxId,
+		"ReceiverStreetLine": d.ReceiverStreetLine,
+		"ReceiverCity":       d.ReceiverCity,
+	}); err.Errors != nil {
+		return err
+	}
+	if !stateCodePattern.MatchString(d.ReceiverStateCode) {
+		return utils.ValidationError(fmt.Sprintf("Due ReceiverStateCode must have 2 uppercase letters, got %q", d.ReceiverStateCode))
+	}
+	if !zipCodePattern.MatchString(d.ReceiverZipCode) {
+		return utils.ValidationError(fmt.Sprintf("Due ReceiverZipCode must have 8 digits, as in \"01234-567\", got %q", d.ReceiverZipCode))
+	}
+	if d.Fine != nil && *d.Fine < 0 {
+		return utils.ValidationError(fmt.Sprintf("Due Fine cannot be negative, got %v", *d.Fine))
+	}
+	if d.Interest != nil && *d.Interest < 0 {
+		return utils.ValidationError(fmt.Sprintf("Due Interest cannot be negative, got %v", *d.Interest))
+	}
+	for _, discount := range d.Discounts {
+		if discount.Percentage <= 0 || discount.Percentage > 100 {
+			return utils.ValidationError(fmt.Sprintf("Due Discounts Percentage must be above 0 and up to 100, got %v", discount.Percentage))
+		}
+		if discount.Due.IsZero() || discount.Due.After(d.Due) {
+			return utils.ValidationError(fmt.Sprintf("Due Discounts Due must be set and up to the Due %v, got %v", datetime(d.Due), datetime(discount.Due)))
+		}
+	}
+	return Error.StarkErrors{}
+}
+
+func (d Due) Response() (string, Error.StarkErrors) {
+	//	Build the JSON string that must be returned to us on the due DynamicBrcode read
+	//
+	//	Return:
+	//	- JSON string, or an inputError if Validate fails or the answer cannot be encoded as JSON
+	if err := d.Validate(); err.Errors != nil {
+		return "", err
+	}
+	return respond(d.fields())
+}
+
+func (s Subscription) Validate() Error.StarkErrors {
+	//	Check the Subscription before it is sent
+	//
+	//	Return:
+	//	- inputError naming the first missing or invalid attribute
+	if !intervals[s.Interval] {
+		return utils.ValidationError(fmt.Sprintf("Subscription Interval must be \"week\", \"month\", \"quarter\", \"semester\" or \"year\", got %q", s.Interval))
+	}
+	if s.InstallmentStart.IsZero() {
+		return utils.ValidationError("Subscription InstallmentStart is required")
+	}
+	if !s.InstallmentEnd.IsZero() && !s.InstallmentEnd.After(s.InstallmentStart) {
+		return utils.ValidationError(fmt.Sprintf("Subscription InstallmentEnd %v must be after InstallmentStart %v", date(s.InstallmentEnd), date(s.InstallmentStart)))
+	}
+	if err := required("Subscription", map[string]string{
+		"ReferenceCode":    s.ReferenceCode,
+		"ReceiverName":     s.ReceiverName,
+		"ReceiverTaxId":    s.ReceiverTaxId,
+		"ReceiverBankCode": s.ReceiverBankCode,
+		"SenderFinalName":  s.SenderFinalName,
+		"SenderFinalTaxId": s.SenderFinalTaxId,
+	}); err.Errors != nil {
+		return err
+	}
+	if s.Amount < 0 || s.AmountMinLimit < 0 || s.PullRetryLimit < 0 {
+		return utils.ValidationError("Subscription Amount, AmountMinLimit and PullRetryLimit cannot be negative")
+	}
+	if s.Amount != 0 && s.AmountMinLimit != 0 {
+		return utils.ValidationError("Subscription AmountMinLimit only applies to variable amounts, when Amount is 0")
+	}
+	return Error.StarkErrors{}
+}
+
+func (s SubscriptionRead) Validate() Error.StarkErrors {
+	//	Check the SubscriptionRead before it is sent
+	//
+	//	Return:
+	//	- inputError naming the first missing or invalid attribute
+	if err := validateRead("SubscriptionRead", s.Version, s.Created, s.Status); err.Errors != nil {
+		return err
+	}
+	return s.Subscription.Validate()
+}
+
+func (s SubscriptionRead) Response() (string, Error.StarkErrors) {
+	//	Build the JSON string that must be returned to us on the subscription DynamicBrcode read
+	//
+	//	Return:
+	//	- JSON string, or an inputError if Validate fails or the answer cannot be encoded as JSON
+	if err := s.Validate(); err.Errors != nil {
+		return "", err
+	}
+	return respond(map[string]interface{}{
+		"version":      s.Version,
+		"created":      datetime(s.Created),
+		"status":       s.Status,
+		"subscription": s.Subscription.fields(),
+	})
+}
+
+func (s SubscriptionAndInstant) Validate() Error.StarkErrors {
+	//	Check the SubscriptionAndInstant before it is sent
+	//
+	//	Return:
+	//	- inputError naming the first missing or invalid attribute
+	if err := s.Instant.Validate(); err.Errors != nil {
+		return err
+	}
+	return s.Subscription.Validate()
+}
+
+func (s SubscriptionAndInstant) Response() (string, Error.StarkErrors) {
+	//	Build the JSON string that must be returned to us on the subscriptionAndInstant DynamicBrcode read
+	//
+	//	Return:
+	//	- JSON string, or an inputError if Validate fails or the answer cannot be encoded as JSON
+	if err := s.Validate(); err.Errors != nil {
+		return "", err
+	}
+	fields := s.Instant.fields()
+	fields["subscription"] = s.Subscription.fields()
+	return respond(fields)
+}
+
+func (d DueAndOrSubscription) Validate() Error.StarkErrors {
+	//	Check the DueAndOrSubscription before it is sent
+	//
+	//	Return:
+	//	- inputError naming the first missing or invalid attribute
+	if err := d.Due.Validate(); err.Errors != nil {
+		return err
+	}
+	return d.Subscription.Validate()
+}
+
+func (d DueAndOrSubscription) Response() (string, Error.StarkErrors) {
+	//	Build the JSON string that must be returned to us on the dueAndOrSubscription DynamicBrcode read
+	//
+	//	Return:
+	//	- JSON string, or an inputError if Validate fails or the answer cannot be encoded as JSON
+	if err := d.Validate(); err.Errors != nil {
+		return "", err
+	}
+	fields := d.Due.fields()
+	fields["subscription"] = d.Subscription.fields()
+	return respond(fields)
+}
+
+func Jws(response string, privateKey *privatekey.PrivateKey, keyId string) (string, Error.StarkErrors) {
+	//	Sign a DynamicBrcode read response as a compact JWS
+	//
+	//	Parameters (required):
+	//	- response [string]: JSON string returned by the Response method of a Read. ex: "{\"version\": 1, ...}"
+	//	- privateKey [*privatekey.PrivateKey]: secp256k1 (ES256K) or prime256v1 (ES256) key the response is signed with. ex: user.GetPrivateKey()
+	//
+	//	Parameters (optional):
+	//	- keyId [string, default nil]: Id of the key, sent in the "kid" header so the reader can pick the key to verify it with. ex: "5656565656565656"
+	//
+	//	Return:
+	//	- compact JWS serialization, or an inputError if the key is nil or its curve has no JWS algorithm
+	if privateKey == nil {
+		return "", utils.ValidationError("A private key is needed to sign a JWS")
+	}
+	algorithms := map[string]string{"secp256k1": "ES256K", "prime256v1": "ES256"}
+	algorithm, ok := algorithms[privateKey.Curve.Name]
+	if !ok {
+		return "", utils.ValidationError(fmt.Sprintf("Keys of the %v curve cannot sign a JWS", privateKey.Curve.Name))
+	}
+	header := map[string]string{"alg": algorithm, "typ": "JOSE"}
+	if keyId != "" {
+		header["kid"] = keyId
+	}
+	headerJson, _ := json.Marshal(header) // a map of strings always encodes
+	content := base64.RawURLEncoding.EncodeToString(headerJson) + "." + base64.RawURLEncoding.EncodeToString([]byte(response))
+
+	signature := ecdsa.Sign(content, privateKey)
+	raw := make([]byte, 64)
+	signature.R.FillBytes(raw[:32])
+	signature.S.FillBytes(raw[32:])
+	return content + "." + base64.RawURLEncoding.EncodeToString(raw), Error.StarkErrors{}
+}
+
+func (i Instant) fields() map[string]interface{} {
+	fields := map[string]interface{}{
+		"version":          i.Version,
+		"created":          datetime(i.Created),
+		"keyId":            i.KeyId,
+		"status":           i.Status,
+		"reconciliationId": i.ReconciliationId,
+		"amount":           i.Amount,
+	}
+	optional(fields, "cashierType", i.CashierType)
+	optional(fields, "cashierBankCode", i.CashierBankCode)
+	optional(fields, "cashAmount", i.CashAmount)
+	if i.Expiration != nil {
+		fields["expiration"] = *i.Expiration
+	}
+	optional(fields, "senderName", i.SenderName)
+	optional(fields, "senderTaxId", i.SenderTaxId)
+	optional(fields, "amountType", i.AmountType)
+	optional(fields, "description", i.Description)
+	return fields
+}
+
+func (d Due) fields() map[string]interface{} {
+	fields := map[string]interface{}{
+		"version":            d.Version,
+		"created":            datetime(d.Created),
+		"due":                datetime(d.Due),
+		"keyId":              d.KeyId,
+		"status":             d.Status,
+		"reconciliationId":   d.ReconciliationId,
+		"nominalAmount":      d.NominalAmount,
+		"senderName":         d.SenderName,
+		"senderTaxId":        d.SenderTaxId,
+		"receiverName":       d.ReceiverName,
+		"receiverTaxId":      d.ReceiverTaxId,
+		"receiverStreetLine": d.ReceiverStreetLine,
+		"receiverCity":       d.ReceiverCity,
+		"receiverStateCode":  d.ReceiverStateCode,
+		"receiverZipCode":    d.ReceiverZipCode,
+	}
+	if d.Expiration != nil {
+		fields["expiration"] = *d.Expiration
+	}
+	if d.Fine != nil {
+		fields["fine"] = *d.Fine
+	}
+	if d.Interest != nil {
+		fields["interest"] = *d.Interest
+	}
+	optional(fields, "description", d.Description)
+	if len(d.Discounts) > 0 {
+		var discounts []map[string]interface{}
+		for _, discount := range d.Discounts {
+			discounts = append(discounts, map[string]interface{}{"percentage": discount.Percentage, "due": datetime(discount.Due)})
+		}
+		fields["discounts"] = discounts
+	}
+	return fields
+}
+
+func (s Subscription) fields() map[string]interface{} {
+	fields := map[string]interface{}{
+		"interval":         s.Interval,
+		"installmentStart": date(s.InstallmentStart),
+		"referenceCode":    s.ReferenceCode,
+		"receiverName":     s.ReceiverName,
+		"receiverTaxId":    s.ReceiverTaxId,
+		"receiverBankCode": s.ReceiverBankCode,
+		"senderFinalName":  s.SenderFinalName,
+		"senderFinalTaxId": s.SenderFinalTaxId,
+	}
+	if !s.InstallmentEnd.IsZero() {
+		fields["installmentEnd"] = date(s.InstallmentEnd)
+	}
+	optional(fields, "amount", s.Amount)
+	optional(fields, "amountMinLimit", s.AmountMinLimit)
+	optional(fields, "pullRetryLimit", s.PullRetryLimit)
+	optional(fields, "description", s.Description)
+	return fields
+}
+
+func validateRead(name string, version int, created time.Time, status string) Error.StarkErrors {
+	if version < 0 {
+		return utils.ValidationError(fmt.Sprintf("%v Version cannot be negative, got %v", name, version))
+	}
+	if created.IsZero() {
+		return utils.ValidationError(fmt.Sprintf("%v Created is required", name))
+	}
+	if !statuses[status] {
+		return utils.ValidationError(fmt.Sprintf("%v Status must be \"created\", \"overdue\", \"paid\", \"canceled\" or \"expired\", got %q", name, status))
+	}
+	return Error.StarkErrors{}
+}
+
+func validatePayment(name string, keyId string, reconciliationId string) Error.StarkErrors {
+	if keyId == "" {
+		return utils.ValidationError(fmt.Sprintf("%v KeyId is required", name))
+	}
+	if !reconciliationIdPattern.MatchString(reconciliationId) {
+		return utils.ValidationError(fmt.Sprintf("%v ReconciliationId must have 26 to 35 alphanumeric characters, got %q", name, reconciliationId))
+	}
+	return Error.StarkErrors{}
+}
+
+func required(name string, values map[string]string) Error.StarkErrors {
+	var missing []string
+	for attribute, value := range values {
+		if value == "" {
+			missing = append(missing, attribute)
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return utils.ValidationError(fmt.Sprintf("%v %v is required", name, strings.Join(missing, ", ")))
+	}
+	return Error.StarkErrors{}
+}
+
+func optional(fields map[string]interface{}, key string, value interface{}) {
+	switch value := value.(type) {
+	case string:
+		if value == "" {
+			return
+		}
+	case int:
+		if value == 0 {
+			return
+		}
+	case float64:
+		if value == 0 {
+			return
+		}
+	}
+	fields[key] = value
+}
+
+func respond(fields map[string]interface{}) (string, Error.StarkErrors) {
+	response, marshalError := json.MarshalIndent(fields, "", "  ")
+	if marshalError != nil {
+		return "", utils.ValidationError(fmt.Sprintf("The DynamicBrcode read cannot be encoded as JSON: %v", marshalError))
+	}
+	return string(response), Error.StarkErrors{}
+}
+
+func datetime(value time.Time) string {
+	return value.UTC().Format("2006-01-02T15:04:05.000000+00:00")
+}
+
+func date(value time.Time) string {
+	return value.UTC().Format("2006-01-02")
+}
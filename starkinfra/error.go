@@ -0,0 +1,65 @@
+package starkinfra
+
+import (
+	Errors "github.com/starkinfra/core-go/starkcore/error"
+	"github.com/starkinfra/sdk-go/starkinfra/utils"
+)
+
+//	Error kinds, to be matched with errors.Is on the error returned by ToError:
+//	- ErrInput: the API detected a mistake in the request (status code 400)
+//	- ErrInternalServer: the API ran into an internal error (status code 500)
+//	- ErrUnknown: the request failed for any other reason, such as connectivity problems
+//	- ErrInvalidSignature: content and signature given to a Parse function do not check out with the Stark Infra public key
+//	- ErrTimeout: the request did not complete before the Client Timeout or the context deadline
+//	- ErrRateLimited: the API refused the request for exceeding its rate limit (status code 429)
+//	- ErrDecode: the API response does not fit the SDK structs, or has fields unknown to them in Strict mode
+
+var ErrInput = utils.ErrInput
+var ErrInternalServer = utils.ErrInternalServer
+var ErrUnknown = utils.ErrUnknown
+var ErrInvalidSignature = utils.ErrInvalidSignature
+var ErrTimeout = utils.ErrTimeout
+var ErrRateLimited = utils.ErrRateLimited
+var ErrDecode = utils.ErrDecode
+
+//	Codes of the errors created by the SDK itself
+
+const CodeInputError = "inputError"
+const CodeInternalServerError = "internalServerError"
+const CodeUnknownError = "unknownError"
+const CodeInvalidSignatureError = "invalidSignatureError"
+const CodeTimeoutError = "timeoutError"
+const CodeRateLimitError = "rateLimitError"
+const CodeDecodeError = "decodeError"
+
+//	Codes of frequent errors returned by the API
+
+const CodeInvalidCredentials = "invalidCredentials"
+const CodeInvalidExternalId = "invalidExternalId"
+const CodeInsufficientBalance = "insufficientBalance"
+
+//	Error struct
+//
+//	Go error wrapping the Error.StarkErrors returned by the SDK functions.
+//	Its Kind is one of the Err* values, so errors.Is(err, starkinfra.ErrInput)
+//	tells what went wrong, while errors.As(err, &starkError) gives access to
+//	each of the individual errors, such as the one of every entity refused by
+//	a batch creation.
+//
+//	Attributes:
+//	- Kind [error]: One of ErrInput, ErrInternalServer, ErrUnknown, ErrInvalidSignature, ErrTimeout, ErrRateLimited or ErrDecode
+//	- Errors [slice of Error.StarkError structs]: Individual errors, each with its Code and Message. ex: []Error.StarkError{{Code: "invalidExternalId", Message: "..."}}
+
+type Error = utils.Error
+
+func ToError(err Errors.StarkErrors) error {
+
+	//	Convert the Error.StarkErrors returned by the SDK functions into a Go error
+	//
+	//	Parameters (required):
+	//	- err [Error.StarkErrors]: Errors returned by any SDK function
+	//
+	//	Return:
+	//	- nil if err holds no errors, a *starkinfra.Error otherwise
+	return utils.ToError(err)
+}
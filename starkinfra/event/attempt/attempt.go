@@ -1,6 +1,7 @@
 package attempt
 
 import (
+	"context"
 	"encoding/json"
 	Error "github.com/starkinfra/core-go/starkcore/error"
 	"github.com/starkinfra/core-go/starkcore/user/user"
@@ -20,18 +21,29 @@ import (
 //	- EventId [string]: ID of the Event whose delivery failed. ex: "4848484848484848"
 //	- WebhookId [string]: ID of the Webhook that triggered this event. ex: "5656565656565656"
 //	- Created [time.Time]: Datetime representing the moment when the attempt was made. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC),
+//	- Extra [map[string]json.RawMessage]: Fields of the Event.Attempt sent by the API but not declared in this SDK version yet, keyed by their JSON name. ex: map[string]json.RawMessage{"newField": json.RawMessage(`"value"`)}
 
 type Attempt struct {
-	Id        string     `json:",omitempty"`
-	Code      string     `json:",omitempty"`
-	Message   string     `json:",omitempty"`
-	EventId   string     `json:",omitempty"`
-	WebhookId string     `json:",omitempty"`
-	Created   *time.Time `json:",omitempty"`
+	Id        string                     `json:",omitempty"`
+	Code      string                     `json:",omitempty"`
+	Message   string                     `json:",omitempty"`
+	EventId   string                     `json:",omitempty"`
+	WebhookId string                     `json:",omitempty"`
+	Created   *time.Time                 `json:",omitempty"`
+	Extra     map[string]json.RawMessage `json:"-"`
 }
 
 var resource = map[string]string{"name": "EventAttempt"}
 
+//	Event.Attempt Client struct
+//
+//	Runs the Event.Attempt functions with the settings of its own utils.Client
+//	instead of starkinfra.User and the other package defaults.
+
+type Client struct {
+	Api *utils.Client
+}
+
 func Get(id string, user user.User) (Attempt, Error.StarkErrors) {
 	//	Retrieve a specific Event.Attempt
 	//
@@ -45,12 +57,29 @@ func Get(id string, user user.User) (Attempt, Error.StarkErrors) {
 	//
 	//	Return:
 	//	- event.Attempt struct that corresponds to the given id.
+	return Client{Api: utils.Default(user)}.Get(id)
+}
+
+func GetCtx(ctx context.Context, id string, user user.User) (Attempt, Error.StarkErrors) {
+	//	Retrieve a specific Event.Attempt
+	//
+	//	Same as Get, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.GetCtx(ctx, id)
+}
+
+func (c Client) Get(id string) (Attempt, Error.StarkErrors) {
+	//	Retrieve a specific Event.Attempt
+	return c.GetCtx(context.Background(), id)
+}
+
+func (c Client) GetCtx(ctx context.Context, id string) (Attempt, Error.StarkErrors) {
+	//	Retrieve a specific Event.Attempt
 	var attempt Attempt
-	get, err := utils.Get(resource, id, nil, user)
-	unmarshalError := json.Unmarshal(get, &attempt)
-	if unmarshalError != nil {
+	get, err := c.Api.Get(ctx, resource, id, nil)
+	if err.Errors != nil {
 		return attempt, err
 	}
+	err = c.Api.Unmarshal(get, &attempt)
 	return attempt, err
 }
 
@@ -70,29 +99,133 @@ func Query(params map[string]interface{}, user user.User) (chan Attempt, chan Er
 	//
 	//	Return:
 	//	- channel of Event.Attempt structs with updated attributes
-	var attempt Attempt
+	return Client{Api: utils.Default(user)}.Query(params)
+}
+
+func QueryCtx(ctx context.Context, params map[string]interface{}, user user.User) (chan Attempt, chan Error.StarkErrors) {
+	//	Retrieve event.Attempt structs
+	//
+	//	Same as Query, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.QueryCtx(ctx, params)
+}
+
+func (c Client) Query(params map[string]interface{}) (chan Attempt, chan Error.StarkErrors) {
+	//	Retrieve event.Attempt structs
+	return c.QueryCtx(context.Background(), params)
+}
+
+func (c Client) QueryCtx(ctx context.Context, params map[string]interface{}) (chan Attempt, chan Error.StarkErrors) {
+	//	Retrieve event.Attempt structs
 	attempts := make(chan Attempt)
 	attemptsError := make(chan Error.StarkErrors)
-	query, errorChannel := utils.Query(resource, params, user)
+	query, errorChannel := c.Api.Stream(ctx, resource, params)
 	go func() {
+		defer close(attemptsError)
+		defer close(attempts)
 		for content := range query {
-			contentByte, _ := json.Marshal(content)
-			err := json.Unmarshal(contentByte, &attempt)
-			if err != nil {
-				attemptsError <- Error.UnknownError(err.Error())
+			var attempt Attempt
+			err := c.Api.Unmarshal(content, &attempt)
+			if err.Errors != nil {
+				if !utils.SendError(ctx, attemptsError, err) {
+					return
+				}
 				continue
 			}
-			attempts <- attempt
+			select {
+			case attempts <- attempt:
+			case <-ctx.Done():
+				return
+			}
 		}
 		for err := range errorChannel {
-			attemptsError <- err
+			if !utils.SendError(ctx, attemptsError, err) {
+				return
+			}
 		}
-		close(attempts)
-		close(attemptsError)
 	}()
 	return attempts, attemptsError
 }
 
+//	Event.Attempt Iterator struct
+//
+//	Returned by Iterate to walk through Event.Attempt structs one at a time,
+//	requesting a new page only once the current one is exhausted. Check Err
+//	once Next returns false and Close it when leaving the loop early.
+
+type Iterator struct {
+	api   *utils.Client
+	pages *utils.Iterator
+	value Attempt
+	err   Error.StarkErrors
+}
+
+func Iterate(params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve event.Attempt structs
+	//
+	//	Same as Query, but returns an Iterator instead of a pair of channels
+	//
+	//	Example:
+	//	iterator := Iterate(params, nil)
+	//	defer iterator.Close()
+	//	for iterator.Next() {
+	//		fmt.Println(iterator.Value())
+	//	}
+	//	if err := iterator.Err(); err.Errors != nil {
+	//		...
+	//	}
+	return Client{Api: utils.Default(user)}.Iterate(params)
+}
+
+func IterateCtx(ctx context.Context, params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve event.Attempt structs
+	//
+	//	Same as Iterate, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.IterateCtx(ctx, params)
+}
+
+func (c Client) Iterate(params map[string]interface{}) *Iterator {
+	//	Retrieve event.Attempt structs
+	return c.IterateCtx(context.Background(), params)
+}
+
+func (c Client) IterateCtx(ctx context.Context, params map[string]interface{}) *Iterator {
+	//	Retrieve event.Attempt structs
+	return &Iterator{api: c.Api, pages: c.Api.Iterate(ctx, resource, params)}
+}
+
+func (i *Iterator) Next() bool {
+	//	Move to the next Event.Attempt, returning false when there are no more or a request failed
+	var value Attempt
+	if i.err.Errors != nil || !i.pages.Next() {
+		return false
+	}
+	i.err = i.api.Unmarshal(i.pages.Value(), &value)
+	if i.err.Errors != nil {
+		i.pages.Close()
+		return false
+	}
+	i.value = value
+	return true
+}
+
+func (i *Iterator) Value() Attempt {
+	//	Current Event.Attempt, set by the last call to Next
+	return i.value
+}
+
+func (i *Iterator) Err() Error.StarkErrors {
+	//	Error that stopped the iteration, if any
+	if i.err.Errors != nil {
+		return i.err
+	}
+	return i.pages.Err()
+}
+
+func (i *Iterator) Close() {
+	//	Stop the iteration, releasing the current page
+	i.pages.Close()
+}
+
 func Page(params map[string]interface{}, user user.User) ([]Attempt, string, Error.StarkErrors) {
 	//	Retrieve paged Event.Attempt structs
 	//
@@ -112,11 +245,28 @@ func Page(params map[string]interface{}, user user.User) ([]Attempt, string, Err
 	//	Return:
 	//	- slice of Event.Attempt structs with updated attributes
 	//	- cursor to retrieve the next page of Event.Attempt structs
+	return Client{Api: utils.Default(user)}.Page(params)
+}
+
+func PageCtx(ctx context.Context, params map[string]interface{}, user user.User) ([]Attempt, string, Error.StarkErrors) {
+	//	Retrieve paged Event.Attempt structs
+	//
+	//	Same as Page, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.PageCtx(ctx, params)
+}
+
+func (c Client) Page(params map[string]interface{}) ([]Attempt, string, Error.StarkErrors) {
+	//	Retrieve paged Event.Attempt structs
+	return c.PageCtx(context.Background(), params)
+}
+
+func (c Client) PageCtx(ctx context.Context, params map[string]interface{}) ([]Attempt, string, Error.StarkErrors) {
+	//	Retrieve paged Event.Attempt structs
 	var attempts []Attempt
-	page, cursor, err := utils.Page(resource, params, user)
-	unmarshalError := json.Unmarshal(page, &attempts)
-	if unmarshalError != nil {
+	page, cursor, err := c.Api.Page(ctx, resource, params)
+	if err.Errors != nil {
 		return attempts, cursor, err
 	}
+	err = c.Api.Unmarshal(page, &attempts)
 	return attempts, cursor, err
 }
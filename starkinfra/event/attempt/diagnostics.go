@@ -0,0 +1,299 @@
+package attempt
+
+import (
+	"context"
+	Error "github.com/starkinfra/core-go/starkcore/error"
+	"github.com/starkinfra/core-go/starkcore/user/user"
+	Event "github.com/starkinfra/sdk-go/starkinfra/event"
+	"github.com/starkinfra/sdk-go/starkinfra/utils"
+	"sort"
+	"time"
+)
+
+//	Event.Attempt Report struct
+//
+//	Summary of the Webhook deliveries of a time window, built by Diagnose.
+//	Each Attempt is a failed delivery, and each delivered Event counts as one
+//	successful delivery. Attempts of Events created outside the window count
+//	as failures of the subscription of their Event, or of UnknownSubscription
+//	if it cannot be found.
+//
+//	Attributes:
+//	- After [time.Time]: Start of the window. ex: time.Date(2022, 11, 10, 0, 0, 0, 0, time.UTC)
+//	- Before [time.Time]: End of the window. ex: time.Date(2022, 11, 12, 0, 0, 0, 0, time.UTC)
+//	- Webhooks [map[string]WebhookReport]: Failures of each Webhook, keyed by Webhook id
+//	- Subscriptions [map[string]SubscriptionReport]: Deliveries of each subscription, keyed by subscription. ex: "pix-request.in"
+
+type Report struct {
+	After         time.Time
+	Before        time.Time
+	Webhooks      map[string]WebhookReport
+	Subscriptions map[string]SubscriptionReport
+}
+
+//	Event.Attempt WebhookReport struct
+//
+//	Attributes:
+//	- Failures [int]: Number of failed Attempts. ex: 12
+//	- Codes [map[string]int]: Number of failed Attempts by error code. ex: map[string]int{"badHttpStatus": 10, "timeout": 2}
+
+type WebhookReport struct {
+	Failures int
+	Codes    map[string]int
+}
+
+//	Event.Attempt SubscriptionReport struct
+//
+//	Attributes:
+//	- Events [int]: Number of Events created in the window. ex: 40
+//	- Delivered [int]: Number of those Events that were delivered. ex: 38
+//	- Failures [int]: Number of failed Attempts. ex: 12
+//	- FailureRate [float64]: Failures over the sum of Failures and Delivered, from 0 to 1. ex: 0.24
+//	- DeliveredAfter [*time.Time]: Lower bound of the latest delivery, or nil if no Event was delivered. Successful deliveries have no Attempts, so each delivered Event is only known to be delivered after its last failed Attempt, or after its creation if it had none. ex: time.Date(2022, 11, 11, 10, 30, 10, 0, time.UTC)
+//	- LongestFailureStreak [int]: Most failed Attempts in a row, each delivered Event counting as a success right after its last failed Attempt. ex: 7
+
+type SubscriptionReport struct {
+	Events               int
+	Delivered            int
+	Failures             int
+	FailureRate          float64
+	DeliveredAfter       *time.Time
+	LongestFailureStreak int
+}
+
+//	Subscription under which Summarize and Diagnose report the Attempts of
+//	Events that are neither in the window nor found by their id
+
+const UnknownSubscription = "unknown"
+
+func Diagnose(after time.Time, before time.Time, user user.User) (Report, Error.StarkErrors) {
+	//	Summarize the Webhook deliveries of a time window
+	//
+	//	Pages through the Events and the Attempts created in the window and groups
+	//	the failures by Webhook, error code and subscription. Each page is added
+	//	to the Report as it arrives, keeping only the ids and datetimes it needs,
+	//	and the Events of Attempts made in the window to Events created before it
+	//	are then queried by their ids, up to 100 per request.
+	//
+	//	Parameters (required):
+	//	- after [time.Time]: Start of the window. Unbounded if zero. ex: time.Date(2022, 11, 10, 0, 0, 0, 0, time.UTC)
+	//	- before [time.Time]: End of the window. Unbounded if zero. ex: time.Date(2022, 11, 12, 0, 0, 0, 0, time.UTC)
+	//
+	//	Parameters (optional):
+	//	- user [Organization/Project struct, default nil]: Organization or Project struct. Not necessary if starkinfra.User was set before function call
+	//
+	//	Return:
+	//	- Report of the window
+	return Client{Api: utils.Default(user)}.Diagnose(after, before)
+}
+
+func DiagnoseCtx(ctx context.Context, after time.Time, before time.Time, user user.User) (Report, Error.StarkErrors) {
+	//	Summarize the Webhook deliveries of a time window
+	//
+	//	Same as Diagnose, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.DiagnoseCtx(ctx, after, before)
+}
+
+func (c Client) Diagnose(after time.Time, before time.Time) (Report, Error.StarkErrors) {
+	//	Summarize the Webhook deliveries of a time window
+	return c.DiagnoseCtx(context.Background(), after, before)
+}
+
+func (c Client) DiagnoseCtx(ctx context.Context, after time.Time, before time.Time) (Report, Error.StarkErrors) {
+	//	Summarize the Webhook deliveries of a time window
+	summary := newSummary()
+	eventIterator := Event.Client{Api: c.Api}.IterateCtx(ctx, Event.QueryParams{After: after, Before: before}.Map())
+	defer eventIterator.Close()
+	for eventIterator.Next() {
+		summary.addEvent(eventIterator.Value())
+	}
+	if err := eventIterator.Err(); err.Errors != nil {
+		return Report{}, err
+	}
+
+	attemptIterator := c.IterateCtx(ctx, QueryParams{After: after, Before: before}.Map())
+	defer attemptIterator.Close()
+	for attemptIterator.Next() {
+		summary.addAttempt(attemptIterator.Value())
+	}
+	if err := attemptIterator.Err(); err.Errors != nil {
+		return Report{}, err
+	}
+
+	missing := summary.missingEvents()
+	for start := 0; start < len(missing); start += 100 {
+		end := start + 100
+		if end > len(missing) {
+			end = len(missing)
+		}
+		if err := c.resolve(ctx, summary, missing[start:end]); err.Errors != nil {
+			return Report{}, err
+		}
+	}
+
+	report := summary.finish()
+	report.After = after
+	report.Before = before
+	return report, Error.StarkErrors{}
+}
+
+func (c Client) resolve(ctx context.Context, summary *summary, ids []string) Error.StarkErrors {
+	iterator := Event.Client{Api: c.Api}.IterateCtx(ctx, Event.QueryParams{Ids: ids}.Map())
+	defer iterator.Close()
+	for iterator.Next() {
+		event := iterator.Value()
+		summary.resolve(event.Id, event.Subscription)
+	}
+	return iterator.Err()
+}
+
+func Summarize(attempts []Attempt, events []Event.Event) Report {
+	//	Summarize already retrieved Attempts and Events
+	//
+	//	Parameters (required):
+	//	- attempts [slice of Attempt structs]: Failed deliveries to be summarized
+	//	- events [slice of Event structs]: Events the Attempts refer to. Attempts of other Events are reported under UnknownSubscription
+	//
+	//	Return:
+	//	- Report without After and Before
+	summary := newSummary()
+	for _, event := range events {
+		summary.addEvent(event)
+	}
+	for _, attempt := range attempts {
+		summary.addAttempt(attempt)
+	}
+	return summary.finish()
+}
+
+type delivery struct {
+	time    time.Time
+	success bool
+}
+
+type summary struct {
+	report        Report
+	subscriptions map[string]string
+	delivered     map[string]time.Time
+	lastAttempt   map[string]time.Time
+	deliveries    map[string][]delivery
+	missing       map[string][]time.Time
+}
+
+func newSummary() *summary {
+	return &summary{
+		report:        Report{Webhooks: map[string]WebhookReport{}, Subscriptions: map[string]SubscriptionReport{}},
+		subscriptions: map[string]string{},
+		delivered:     map[string]time.Time{},
+		lastAttempt:   map[string]time.Time{},
+		deliveries:    map[string][]delivery{},
+		missing:       map[string][]time.Time{},
+	}
+}
+
+func (s *summary) addEvent(event Event.Event) {
+	s.subscriptions[event.Id] = event.Subscription
+	report := s.report.Subscriptions[event.Subscription]
+	report.Events++
+	if event.IsDelivered {
+		report.Delivered++
+		s.delivered[event.Id] = timeOf(event.Created)
+	}
+	s.report.Subscriptions[event.Subscription] = report
+}
+
+func (s *summary) addAttempt(attempt Attempt) {
+	webhook := s.report.Webhooks[attempt.WebhookId]
+	if webhook.Codes == nil {
+		webhook.Codes = map[string]int{}
+	}
+	webhook.Failures++
+	webhook.Codes[attempt.Code]++
+	s.report.Webhooks[attempt.WebhookId] = webhook
+
+	created := timeOf(attempt.Created)
+	subscription, ok := s.subscriptions[attempt.EventId]
+	if !ok {
+		s.missing[attempt.EventId] = append(s.missing[attempt.EventId], created)
+		return
+	}
+	s.fail(subscription, created)
+	if created.After(s.lastAttempt[attempt.EventId]) {
+		s.lastAttempt[attempt.EventId] = created
+	}
+}
+
+func (s *summary) missingEvents() []string {
+	var ids []string
+	for id := range s.missing {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func (s *summary) resolve(eventId string, subscription string) {
+	for _, created := range s.missing[eventId] {
+		s.fail(subscription, created)
+	}
+	delete(s.missing, eventId)
+}
+
+func (s *summary) fail(subscription string, created time.Time) {
+	report := s.report.Subscriptions[subscription]
+	report.Failures++
+	s.report.Subscriptions[subscription] = report
+	s.deliveries[subscription] = append(s.deliveries[subscription], delivery{time: created})
+}
+
+func (s *summary) finish() Report {
+	for _, id := range s.missingEvents() {
+		s.resolve(id, UnknownSubscription)
+	}
+	for id, created := range s.delivered {
+		subscription := s.subscriptions[id]
+		succeeded := created
+		if last, ok := s.lastAttempt[id]; ok {
+			succeeded = last
+		}
+		s.deliveries[subscription] = append(s.deliveries[subscription], delivery{time: succeeded, success: true})
+		report := s.report.Subscriptions[subscription]
+		if report.DeliveredAfter == nil || succeeded.After(*report.DeliveredAfter) {
+			deliveredAfter := succeeded
+			report.DeliveredAfter = &deliveredAfter
+		}
+		s.report.Subscriptions[subscription] = report
+	}
+
+	for subscription, report := range s.report.Subscriptions {
+		if report.Failures+report.Delivered > 0 {
+			report.FailureRate = float64(report.Failures) / float64(report.Failures+report.Delivered)
+		}
+		timeline := s.deliveries[subscription]
+		sort.SliceStable(timeline, func(i, j int) bool {
+			if timeline[i].time.Equal(timeline[j].time) {
+				return !timeline[i].success && timeline[j].success
+			}
+			return timeline[i].time.Before(timeline[j].time)
+		})
+		streak := 0
+		for _, delivery := range timeline {
+			streak++
+			if delivery.success {
+				streak = 0
+			}
+			if streak > report.LongestFailureStreak {
+				report.LongestFailureStreak = streak
+			}
+		}
+		s.report.Subscriptions[subscription] = report
+	}
+	return s.report
+}
+
+func timeOf(datetime *time.Time) time.Time {
+	if datetime == nil {
+		return time.Time{}
+	}
+	return *datetime
+}
@@ -1,25 +1,13 @@
 package event
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	Error "github.com/starkinfra/core-go/starkcore/error"
 	"github.com/starkinfra/core-go/starkcore/user/user"
-	BusinessIdentityLog "github.com/starkinfra/sdk-go/starkinfra/businessidentity/log"
-	CreditNoteLog "github.com/starkinfra/sdk-go/starkinfra/creditnote/log"
-	IssuingCardLog "github.com/starkinfra/sdk-go/starkinfra/issuingcard/log"
-	IssuingInvoiceLog "github.com/starkinfra/sdk-go/starkinfra/issuinginvoice/log"
-	IssuingPurchaseLog "github.com/starkinfra/sdk-go/starkinfra/issuingpurchase/log"
-	IssuingTokenLog "github.com/starkinfra/sdk-go/starkinfra/issuingtoken/log"
-	PixChargebackLog "github.com/starkinfra/sdk-go/starkinfra/pixchargeback/log"
-	PixClaimLog "github.com/starkinfra/sdk-go/starkinfra/pixclaim/log"
-	PixDisputeLog "github.com/starkinfra/sdk-go/starkinfra/pixdispute/log"
-	PixInfractionLog "github.com/starkinfra/sdk-go/starkinfra/pixinfraction/log"
-	PixKeyLog "github.com/starkinfra/sdk-go/starkinfra/pixkey/log"
-	PixRequestLog "github.com/starkinfra/sdk-go/starkinfra/pixrequest/log"
-	PixReversalLog "github.com/starkinfra/sdk-go/starkinfra/pixreversal/log"
-	PixPullSubscriptionLog "github.com/starkinfra/sdk-go/starkinfra/pixpullsubscription/log"
-	PixPullRequestLog "github.com/starkinfra/sdk-go/starkinfra/pixpullrequest/log"
 	"github.com/starkinfra/sdk-go/starkinfra/utils"
+	"reflect"
 	"time"
 )
 
@@ -31,23 +19,34 @@ import (
 //
 //	Attributes:
 //	- Id [string]: Unique id returned when the Event is created. ex: "5656565656565656"
-//	- Log [Log]: A Log struct from one of the subscribed services (PixRequestLog, PixReversalLog)
+//	- Log [Log]: A Log struct from one of the subscribed services (PixRequestLog, PixReversalLog), once decoded by ParseLog
 //	- Created [time.Time]: Creation datetime for the notification Event. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC),
 //	- IsDelivered [bool]: True if the Event has been successfully delivered to the user url. ex: False
 //	- Subscription [string]: Service that triggered this Event. ex: "pix-request.in", "pix-request.out"
 //	- WorkspaceId [string]: ID of the Workspace that generated this Event. Mostly used when multiple Workspaces have Webhooks registered to the same endpoint. ex: "4545454545454545"
+//	- Extra [map[string]json.RawMessage]: Fields of the Webhook Event sent by the API but not declared in this SDK version yet, keyed by their JSON name. ex: map[string]json.RawMessage{"newField": json.RawMessage(`"value"`)}
 
 type Event struct {
-	Id           string      `json:",omitempty"`
-	Log          interface{} `json:",omitempty"`
-	Created      *time.Time  `json:",omitempty"`
-	IsDelivered  bool        `json:",omitempty"`
-	Subscription string      `json:",omitempty"`
-	WorkspaceId  string      `json:",omitempty"`
+	Id           string                     `json:",omitempty"`
+	Log          interface{}                `json:",omitempty"`
+	Created      *time.Time                 `json:",omitempty"`
+	IsDelivered  bool                       `json:",omitempty"`
+	Subscription string                     `json:",omitempty"`
+	WorkspaceId  string                     `json:",omitempty"`
+	Extra        map[string]json.RawMessage `json:"-"`
 }
 
 var resource = map[string]string{"name": "Event"}
 
+//	Event Client struct
+//
+//	Runs the Event functions with the settings of its own utils.Client
+//	instead of starkinfra.User and the other package defaults.
+
+type Client struct {
+	Api *utils.Client
+}
+
 func Get(id string, user user.User) (Event, Error.StarkErrors) {
 	//	Retrieve a specific notification Event
 	//
@@ -61,12 +60,29 @@ func Get(id string, user user.User) (Event, Error.StarkErrors) {
 	//
 	//	Return:
 	//	- event struct that corresponds to the given id.
+	return Client{Api: utils.Default(user)}.Get(id)
+}
+
+func GetCtx(ctx context.Context, id string, user user.User) (Event, Error.StarkErrors) {
+	//	Retrieve a specific notification Event
+	//
+	//	Same as Get, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.GetCtx(ctx, id)
+}
+
+func (c Client) Get(id string) (Event, Error.StarkErrors) {
+	//	Retrieve a specific notification Event
+	return c.GetCtx(context.Background(), id)
+}
+
+func (c Client) GetCtx(ctx context.Context, id string) (Event, Error.StarkErrors) {
+	//	Retrieve a specific notification Event
 	var event Event
-	get, err := utils.Get(resource, id, nil, user)
-	unmarshalError := json.Unmarshal(get, &event)
-	if unmarshalError != nil {
+	get, err := c.Api.Get(ctx, resource, id, nil)
+	if err.Errors != nil {
 		return event, err
 	}
+	err = c.Api.Unmarshal(get, &event)
 	return event, err
 }
 
@@ -85,29 +101,133 @@ func Query(params map[string]interface{}, user user.User) (chan Event, chan Erro
 	//
 	//	Return:
 	//	- channel of Event structs with updated attributes
-	var event Event
+	return Client{Api: utils.Default(user)}.Query(params)
+}
+
+func QueryCtx(ctx context.Context, params map[string]interface{}, user user.User) (chan Event, chan Error.StarkErrors) {
+	//	Retrieve notification Events
+	//
+	//	Same as Query, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.QueryCtx(ctx, params)
+}
+
+func (c Client) Query(params map[string]interface{}) (chan Event, chan Error.StarkErrors) {
+	//	Retrieve notification Events
+	return c.QueryCtx(context.Background(), params)
+}
+
+func (c Client) QueryCtx(ctx context.Context, params map[string]interface{}) (chan Event, chan Error.StarkErrors) {
+	//	Retrieve notification Events
 	events := make(chan Event)
 	eventsError := make(chan Error.StarkErrors)
-	query, errorChannel := utils.Query(resource, params, user)
+	query, errorChannel := c.Api.Stream(ctx, resource, params)
 	go func() {
+		defer close(eventsError)
+		defer close(events)
 		for content := range query {
-			contentByte, _ := json.Marshal(content)
-			err := json.Unmarshal(contentByte, &event)
-			if err != nil {
-				eventsError <- Error.UnknownError(err.Error())
+			var event Event
+			err := c.Api.Unmarshal(content, &event)
+			if err.Errors != nil {
+				if !utils.SendError(ctx, eventsError, err) {
+					return
+				}
 				continue
 			}
-			events <- event
+			select {
+			case events <- event:
+			case <-ctx.Done():
+				return
+			}
 		}
 		for err := range errorChannel {
-			eventsError <- err
+			if !utils.SendError(ctx, eventsError, err) {
+				return
+			}
 		}
-		close(events)
-		close(eventsError)
 	}()
 	return events, eventsError
 }
 
+//	Event Iterator struct
+//
+//	Returned by Iterate to walk through Event structs one at a time,
+//	requesting a new page only once the current one is exhausted. Check Err
+//	once Next returns false and Close it when leaving the loop early.
+
+type Iterator struct {
+	api   *utils.Client
+	pages *utils.Iterator
+	value Event
+	err   Error.StarkErrors
+}
+
+func Iterate(params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve notification Events
+	//
+	//	Same as Query, but returns an Iterator instead of a pair of channels
+	//
+	//	Example:
+	//	iterator := Iterate(params, nil)
+	//	defer iterator.Close()
+	//	for iterator.Next() {
+	//		fmt.Println(iterator.Value())
+	//	}
+	//	if err := iterator.Err(); err.Errors != nil {
+	//		...
+	//	}
+	return Client{Api: utils.Default(user)}.Iterate(params)
+}
+
+func IterateCtx(ctx context.Context, params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve notification Events
+	//
+	//	Same as Iterate, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.IterateCtx(ctx, params)
+}
+
+func (c Client) Iterate(params map[string]interface{}) *Iterator {
+	//	Retrieve notification Events
+	return c.IterateCtx(context.Background(), params)
+}
+
+func (c Client) IterateCtx(ctx context.Context, params map[string]interface{}) *Iterator {
+	//	Retrieve notification Events
+	return &Iterator{api: c.Api, pages: c.Api.Iterate(ctx, resource, params)}
+}
+
+func (i *Iterator) Next() bool {
+	//	Move to the next Event, returning false when there are no more or a request failed
+	var value Event
+	if i.err.Errors != nil || !i.pages.Next() {
+		return false
+	}
+	i.err = i.api.Unmarshal(i.pages.Value(), &value)
+	if i.err.Errors != nil {
+		i.pages.Close()
+		return false
+	}
+	i.value = value
+	return true
+}
+
+func (i *Iterator) Value() Event {
+	//	Current Event, set by the last call to Next
+	return i.value
+}
+
+func (i *Iterator) Err() Error.StarkErrors {
+	//	Error that stopped the iteration, if any
+	if i.err.Errors != nil {
+		return i.err
+	}
+	return i.pages.Err()
+}
+
+func (i *Iterator) Close() {
+	//	Stop the iteration, releasing the current page
+	i.pages.Close()
+}
+
 func Page(params map[string]interface{}, user user.User) ([]Event, string, Error.StarkErrors) {
 	//	Retrieve paged Events
 	//
@@ -126,21 +246,36 @@ func Page(params map[string]interface{}, user user.User) ([]Event, string, Error
 	//	Return:
 	//	- slice of Event structs with updated attributes
 	//	- cursor to retrieve the next page of Event structs
+	//	- errors of the Events whose Log could not be decoded, which are still returned with their Log as received
+	return Client{Api: utils.Default(user)}.Page(params)
+}
+
+func PageCtx(ctx context.Context, params map[string]interface{}, user user.User) ([]Event, string, Error.StarkErrors) {
+	//	Retrieve paged Events
+	//
+	//	Same as Page, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.PageCtx(ctx, params)
+}
+
+func (c Client) Page(params map[string]interface{}) ([]Event, string, Error.StarkErrors) {
+	//	Retrieve paged Events
+	return c.PageCtx(context.Background(), params)
+}
+
+func (c Client) PageCtx(ctx context.Context, params map[string]interface{}) ([]Event, string, Error.StarkErrors) {
+	//	Retrieve paged Events
 	var events []Event
-	page, cursor, err := utils.Page(resource, params, user)
+	page, cursor, err := c.Api.Page(ctx, resource, params)
 	if err.Errors != nil {
 		return nil, "", err
 	}
 
-	unmarshalError := json.Unmarshal(page, &events)
-	if unmarshalError != nil {
-		return nil, "", Error.UnknownError(unmarshalError.Error())
+	unmarshalError := c.Api.Unmarshal(page, &events)
+	if unmarshalError.Errors != nil {
+		return nil, "", unmarshalError
 	}
-	parsedEvents, err := ParseEvents(events)
-	if err.Errors != nil {
-		return nil, "", err
-	}
-	return parsedEvents, cursor, err
+	events, err = c.ParseEvents(events)
+	return events, cursor, err
 }
 
 func Delete(id string, user user.User) (Event, Error.StarkErrors) {
@@ -156,12 +291,29 @@ func Delete(id string, user user.User) (Event, Error.StarkErrors) {
 	//
 	//	Return:
 	//	- deleted Event struct
+	return Client{Api: utils.Default(user)}.Delete(id)
+}
+
+func DeleteCtx(ctx context.Context, id string, user user.User) (Event, Error.StarkErrors) {
+	//	Delete a Webhook Event entity
+	//
+	//	Same as Delete, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.DeleteCtx(ctx, id)
+}
+
+func (c Client) Delete(id string) (Event, Error.StarkErrors) {
+	//	Delete a Webhook Event entity
+	return c.DeleteCtx(context.Background(), id)
+}
+
+func (c Client) DeleteCtx(ctx context.Context, id string) (Event, Error.StarkErrors) {
+	//	Delete a Webhook Event entity
 	var event Event
-	deleted, err := utils.Delete(resource, id, user)
-	unmarshalError := json.Unmarshal(deleted, &event)
-	if unmarshalError != nil {
+	deleted, err := c.Api.Delete(ctx, resource, id)
+	if err.Errors != nil {
 		return event, err
 	}
+	err = c.Api.Unmarshal(deleted, &event)
 	return event, err
 }
 
@@ -180,14 +332,31 @@ func Update(id string, isDelivered bool, user user.User) (Event, Error.StarkErro
 	//
 	//	Return:
 	//	- target Event with updated attributes
+	return Client{Api: utils.Default(user)}.Update(id, isDelivered)
+}
+
+func UpdateCtx(ctx context.Context, id string, isDelivered bool, user user.User) (Event, Error.StarkErrors) {
+	//	Update notification Event entity
+	//
+	//	Same as Update, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.UpdateCtx(ctx, id, isDelivered)
+}
+
+func (c Client) Update(id string, isDelivered bool) (Event, Error.StarkErrors) {
+	//	Update notification Event entity
+	return c.UpdateCtx(context.Background(), id, isDelivered)
+}
+
+func (c Client) UpdateCtx(ctx context.Context, id string, isDelivered bool) (Event, Error.StarkErrors) {
+	//	Update notification Event entity
 	var event Event
 	patchData := make(map[string]interface{})
 	patchData["isDelivered"] = isDelivered
-	update, err := utils.Patch(resource, id, patchData, user)
-	unmarshalError := json.Unmarshal(update, &event)
-	if unmarshalError != nil {
+	update, err := c.Api.Patch(ctx, resource, id, patchData)
+	if err.Errors != nil {
 		return event, err
 	}
+	err = c.Api.Unmarshal(update, &event)
 	return event, err
 }
 
@@ -207,208 +376,94 @@ func Parse(content string, signature string, user user.User) (Event, Error.Stark
 	//
 	//	Return:
 	//	- parsed Event struct
+	return Client{Api: utils.Default(user)}.Parse(content, signature)
+}
+
+func ParseCtx(ctx context.Context, content string, signature string, user user.User) (Event, Error.StarkErrors) {
+	//	Create single notification Event from a content string
+	//
+	//	Same as Parse, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.ParseCtx(ctx, content, signature)
+}
+
+func (c Client) Parse(content string, signature string) (Event, Error.StarkErrors) {
+	//	Create single notification Event from a content string
+	return c.ParseCtx(context.Background(), content, signature)
+}
+
+func (c Client) ParseCtx(ctx context.Context, content string, signature string) (Event, Error.StarkErrors) {
+	//	Create single notification Event from a content string
 	var event Event
-	parsed, err := utils.ParseAndVerify(content, signature, "event", user)
+	parsed, err := c.Api.ParseAndVerify(ctx, content, signature, "event")
 	if err.Errors != nil {
 		return event, err
 	}
 
 	var raw map[string]interface{}
-	unmarshalError := json.Unmarshal([]byte(parsed), &raw)
-	if unmarshalError != nil {
-		return event, Error.UnknownError(unmarshalError.Error())
+	unmarshalError := c.Api.Unmarshal([]byte(parsed), &raw)
+	if unmarshalError.Errors != nil {
+		return event, unmarshalError
 	}
-	
+
 	eventData := raw["event"]
 	eventBytes, _ := json.Marshal(eventData)
-	unmarshalError = json.Unmarshal(eventBytes, &event)
-	if unmarshalError != nil {
-		return event, Error.UnknownError(unmarshalError.Error())
+	unmarshalError = c.Api.Unmarshal(eventBytes, &event)
+	if unmarshalError.Errors != nil {
+		return event, unmarshalError
 	}
 	return event, Error.StarkErrors{}
 }
 
 func (e Event) ParseLog() (Event, Error.StarkErrors) {
-	if e.Subscription == "pix-key" {
-		var log PixKeyLog.Log
-		marshal, _ := json.Marshal(e.Log)
-		err := json.Unmarshal(marshal, &log)
-		if err != nil {
-			return e, Error.UnknownError(err.Error())
-		}
-		e.Log = log
-		return e, Error.StarkErrors{}
-	}
-	if e.Subscription == "pix-claim" {
-		var log PixClaimLog.Log
-		marshal, _ := json.Marshal(e.Log)
-		err := json.Unmarshal(marshal, &log)
-		if err != nil {
-			return e, Error.UnknownError(err.Error())
-		}
-		e.Log = log
-		return e, Error.StarkErrors{}
-	}
-	if e.Subscription == "pix-chargeback" {
-		var log PixChargebackLog.Log
-		marshal, _ := json.Marshal(e.Log)
-		err := json.Unmarshal(marshal, &log)
-		if err != nil {
-			return e, Error.UnknownError(err.Error())
-		}
-		e.Log = log
-		return e, Error.StarkErrors{}
-	}
-	if e.Subscription == "pix-infraction" {
-		var log PixInfractionLog.Log
-		marshal, _ := json.Marshal(e.Log)
-		err := json.Unmarshal(marshal, &log)
-		if err != nil {
-			return e, Error.UnknownError(err.Error())
-		}
-		e.Log = log
-		return e, Error.StarkErrors{}
-	}
-	if e.Subscription == "pix-dispute" {
-		var log PixDisputeLog.Log
-		marshal, _ := json.Marshal(e.Log)
-		err := json.Unmarshal(marshal, &log)
-		if err != nil {
-			return e, Error.UnknownError(err.Error())
-		}
-		e.Log = log
-		return e, Error.StarkErrors{}
-	}
-	if e.Subscription == "pix-request.in" {
-		var log PixRequestLog.Log
-		marshal, _ := json.Marshal(e.Log)
-		err := json.Unmarshal(marshal, &log)
-		if err != nil {
-			return e, Error.UnknownError(err.Error())
-		}
-		e.Log = log
-		return e, Error.StarkErrors{}
-	}
-	if e.Subscription == "pix-request.out" {
-		var log PixRequestLog.Log
-		marshal, _ := json.Marshal(e.Log)
-		err := json.Unmarshal(marshal, &log)
-		if err != nil {
-			return e, Error.UnknownError(err.Error())
-		}
-		e.Log = log
-		return e, Error.StarkErrors{}
-	}
-	if e.Subscription == "pix-reversal.in" {
-		var log PixReversalLog.Log
-		marshal, _ := json.Marshal(e.Log)
-		err := json.Unmarshal(marshal, &log)
-		if err != nil {
-			return e, Error.UnknownError(err.Error())
-		}
-		e.Log = log
-		return e, Error.StarkErrors{}
-	}
-	if e.Subscription == "pix-reversal.out" {
-		var log PixReversalLog.Log
-		marshal, _ := json.Marshal(e.Log)
-		err := json.Unmarshal(marshal, &log)
-		if err != nil {
-			return e, Error.UnknownError(err.Error())
-		}
-		e.Log = log
-		return e, Error.StarkErrors{}
-	}
-	if e.Subscription == "issuing-card" {
-		var log IssuingCardLog.Log
-		marshal, _ := json.Marshal(e.Log)
-		err := json.Unmarshal(marshal, &log)
-		if err != nil {
-			return e, Error.UnknownError(err.Error())
-		}
-		e.Log = log
-		return e, Error.StarkErrors{}
-	}
-	if e.Subscription == "issuing-invoice" {
-		var log IssuingInvoiceLog.Log
-		marshal, _ := json.Marshal(e.Log)
-		err := json.Unmarshal(marshal, &log)
-		if err != nil {
-			return e, Error.UnknownError(err.Error())
-		}
-		e.Log = log
-		return e, Error.StarkErrors{}
-	}
-	if e.Subscription == "issuing-purchase" {
-		var log IssuingPurchaseLog.Log
-		marshal, _ := json.Marshal(e.Log)
-		err := json.Unmarshal(marshal, &log)
-		if err != nil {
-			return e, Error.UnknownError(err.Error())
-		}
-		e.Log = log
-		return e, Error.StarkErrors{}
-	}
-	if e.Subscription == "issuing-token" {
-		var log IssuingTokenLog.Log
-		marshal, _ := json.Marshal(e.Log)
-		err := json.Unmarshal(marshal, &log)
-		if err != nil {
-			return e, Error.UnknownError(err.Error())
-		}
-		e.Log = log
-		return e, Error.StarkErrors{}
-	}
-	if e.Subscription == "credit-note" {
-		var log CreditNoteLog.Log
-		marshal, _ := json.Marshal(e.Log)
-		err := json.Unmarshal(marshal, &log)
-		if err != nil {
-			return e, Error.UnknownError(err.Error())
-		}
-		e.Log = log
-		return e, Error.StarkErrors{}
-	}
-	if e.Subscription == "business-identity" {
-		var log BusinessIdentityLog.Log
-		marshal, _ := json.Marshal(e.Log)
-		err := json.Unmarshal(marshal, &log)
-		if err != nil {
-			return e, Error.UnknownError(err.Error())
-		}
-		e.Log = log
-		return e, Error.StarkErrors{}
-	}
-	if e.Subscription == "pix-pull-subscription" {
-		var log PixPullSubscriptionLog.Log
-		marshal, _ := json.Marshal(e.Log)
-		err := json.Unmarshal(marshal, &log)
-		if err != nil {
-			return e, Error.UnknownError(err.Error())
-		}
-		e.Log = log
-		return e, Error.StarkErrors{}
+	//	Decode the Log of an Event into the struct registered for its subscription
+	//
+	//	Events of subscriptions with no registered decoder and Events whose Log
+	//	is already decoded are returned unchanged.
+	//
+	//	Return:
+	//	- Event with its Log decoded, such as a PixRequestLog.Log for "pix-request.in" Events
+	return Client{Api: utils.Default(nil)}.ParseLog(e)
+}
+
+func (c Client) ParseLog(event Event) (Event, Error.StarkErrors) {
+	//	Decode the Log of an Event, following the Strict setting of the Client
+	decoder, ok := decoderOf(event.Subscription)
+	if !ok || reflect.ValueOf(event.Log).Kind() == reflect.Struct {
+		return event, Error.StarkErrors{}
 	}
-	if e.Subscription == "pix-pull-request" {
-		var log PixPullRequestLog.Log
-		marshal, _ := json.Marshal(e.Log)
-		err := json.Unmarshal(marshal, &log)
-		if err != nil {
-			return e, Error.UnknownError(err.Error())
-		}
-		e.Log = log
-		return e, Error.StarkErrors{}
+	marshal, _ := json.Marshal(event.Log)
+	log, err := decoder(c.Api, marshal)
+	if err.Errors != nil {
+		return event, err
 	}
-	return e, Error.StarkErrors{}
+	event.Log = log
+	return event, Error.StarkErrors{}
 }
 
 func ParseEvents(events []Event) ([]Event, Error.StarkErrors) {
-	var err Error.StarkErrors
+	//	Decode the Log of each Event with ParseLog
+	//
+	//	A Log that cannot be decoded does not stop the others from being decoded.
+	//	Its Event is kept with the Log as it was received.
+	//
+	//	Parameters (required):
+	//	- events [slice of Event structs]: Events to be decoded
+	//
+	//	Return:
+	//	- slice with every Event, in the same order
+	//	- errors of the Events whose Log could not be decoded, one per Event, each message starting with the Event id
+	return Client{Api: utils.Default(nil)}.ParseEvents(events)
+}
+
+func (c Client) ParseEvents(events []Event) ([]Event, Error.StarkErrors) {
+	//	Decode the Log of each Event, following the Strict setting of the Client
+	var errors []Error.StarkError
 	for i := 0; i < len(events); i++ {
-		events[i], err = events[i].ParseLog()
-		if err.Errors != nil {
-			return nil, err
+		parsed, err := c.ParseLog(events[i])
+		for _, e := range err.Errors {
+			errors = append(errors, Error.StarkError{Code: e.Code, Message: fmt.Sprintf("Event %v: %v", events[i].Id, e.Message)})
 		}
+		events[i] = parsed
 	}
-	return events, Error.StarkErrors{}
+	return events, Error.StarkErrors{Errors: errors}
 }
@@ -0,0 +1,505 @@
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	Error "github.com/starkinfra/core-go/starkcore/error"
+	"github.com/starkinfra/core-go/starkcore/user/user"
+	BusinessAttachmentLog "github.com/starkinfra/sdk-go/starkinfra/businessattachment/log"
+	BusinessIdentityLog "github.com/starkinfra/sdk-go/starkinfra/businessidentity/log"
+	CreditHolmesLog "github.com/starkinfra/sdk-go/starkinfra/creditholmes/log"
+	CreditNoteLog "github.com/starkinfra/sdk-go/starkinfra/creditnote/log"
+	IndividualDocumentLog "github.com/starkinfra/sdk-go/starkinfra/individualdocument/log"
+	IndividualIdentityLog "github.com/starkinfra/sdk-go/starkinfra/individualidentity/log"
+	IssuingCardLog "github.com/starkinfra/sdk-go/starkinfra/issuingcard/log"
+	IssuingEmbossingRequestLog "github.com/starkinfra/sdk-go/starkinfra/issuingembossingrequest/log"
+	IssuingHolderLog "github.com/starkinfra/sdk-go/starkinfra/issuingholder/log"
+	IssuingInvoiceLog "github.com/starkinfra/sdk-go/starkinfra/issuinginvoice/log"
+	IssuingPurchaseLog "github.com/starkinfra/sdk-go/starkinfra/issuingpurchase/log"
+	IssuingRestockLog "github.com/starkinfra/sdk-go/starkinfra/issuingrestock/log"
+	IssuingStockLog "github.com/starkinfra/sdk-go/starkinfra/issuingstock/log"
+	IssuingTokenLog "github.com/starkinfra/sdk-go/starkinfra/issuingtoken/log"
+	LedgerLog "github.com/starkinfra/sdk-go/starkinfra/ledger/log"
+	PixChargebackLog "github.com/starkinfra/sdk-go/starkinfra/pixchargeback/log"
+	PixClaimLog "github.com/starkinfra/sdk-go/starkinfra/pixclaim/log"
+	PixDisputeLog "github.com/starkinfra/sdk-go/starkinfra/pixdispute/log"
+	PixFraudLog "github.com/starkinfra/sdk-go/starkinfra/pixfraud/log"
+	PixInfractionLog "github.com/starkinfra/sdk-go/starkinfra/pixinfraction/log"
+	PixInternalTransactionReportLog "github.com/starkinfra/sdk-go/starkinfra/pixinternaltransactionreport/log"
+	PixKeyLog "github.com/starkinfra/sdk-go/starkinfra/pixkey/log"
+	PixPullRequestLog "github.com/starkinfra/sdk-go/starkinfra/pixpullrequest/log"
+	PixPullSubscriptionLog "github.com/starkinfra/sdk-go/starkinfra/pixpullsubscription/log"
+	PixRequestLog "github.com/starkinfra/sdk-go/starkinfra/pixrequest/log"
+	PixReversalLog "github.com/starkinfra/sdk-go/starkinfra/pixreversal/log"
+	"github.com/starkinfra/sdk-go/starkinfra/utils"
+	"io"
+	"net/http"
+)
+
+//	Webhook Handler struct
+//
+//	http.Handler that receives the Events sent by Stark Infra to a Webhook url.
+//	The "Digital-Signature" header of each request is checked against the
+//	Stark Infra public key, the Event is parsed, its Log is decoded with
+//	ParseLog and the Event is handed to the callback registered for its
+//	subscription. The response status tells Stark Infra whether the Event
+//	must be delivered again:
+//	- 200: the Event was handled, or no callback is registered for its subscription
+//	- 400: the body is not a JSON Event
+//	- 401: the signature does not check out with the Stark Infra public key
+//	- 405: the request method is not POST
+//	- 500: the callback returned an error, or the Event could not be verified or decoded
+//
+//	Callbacks must be registered before the Handler starts serving requests.
+//	Call Deduplicate to skip Events that were already handled.
+//
+//	Attributes:
+//	- Api [*utils.Client]: Client used to fetch the Stark Infra public key
+//
+//	Example:
+//	handler := event.NewHandler(nil)
+//	handler.OnPixRequestIn(func(log PixRequestLog.Log) error {
+//		return credit(log.Request)
+//	})
+//	http.Handle("/webhook", handler)
+
+type Handler struct {
+	Api       *utils.Client
+	callbacks map[string]func(Event) error
+	processor *Processor
+}
+
+//	LogTypeError struct
+//
+//	Error returned by the typed callbacks of a Handler, such as the one
+//	registered with OnPixKey, when the Log of an Event is not of the type they
+//	take, as happens when Register replaces the decoder of their subscription.
+//	The Event is refused with status 500 instead of panicking.
+//
+//	Attributes:
+//	- Subscription [string]: Subscription of the Event. ex: "pix-key"
+//	- Log [interface{}]: Log of the Event, as decoded by ParseLog
+
+type LogTypeError struct {
+	Subscription string
+	Log          interface{}
+}
+
+func (e LogTypeError) Error() string {
+	return fmt.Sprintf("the log of the %v Event has the unexpected type %T", e.Subscription, e.Log)
+}
+
+func NewHandler(user user.User) *Handler {
+	//	Create a webhook Handler
+	//
+	//	Parameters (optional):
+	//	- user [Organization/Project struct, default nil]: Organization or Project struct. Not necessary if starkinfra.User was set before function call
+	//
+	//	Return:
+	//	- Handler with no callbacks registered
+	return Client{Api: utils.Default(user)}.NewHandler()
+}
+
+func (c Client) NewHandler() *Handler {
+	//	Create a webhook Handler
+	return &Handler{Api: c.Api, callbacks: map[string]func(Event) error{}}
+}
+
+func (h *Handler) On(subscription string, callback func(Event) error) {
+	//	Register the callback run for the Events of a subscription
+	//
+	//	The Event is given with its Log already decoded by ParseLog. A callback
+	//	registered for a subscription replaces the previous one.
+	//
+	//	Parameters (required):
+	//	- subscription [string]: Service that triggers the Events. ex: "pix-request.in"
+	//	- callback [func(Event) error]: Function run for each Event. Returning an error makes Stark Infra deliver the Event again later
+	if h.callbacks == nil {
+		h.callbacks = map[string]func(Event) error{}
+	}
+	h.callbacks[subscription] = callback
+}
+
+func (h *Handler) OnPixKey(callback func(PixKeyLog.Log) error) {
+	//	Register the callback run for the "pix-key" Events
+	h.On("pix-key", func(event Event) error {
+		log, ok := event.Log.(PixKeyLog.Log)
+		if !ok {
+			return LogTypeError{Subscription: event.Subscription, Log: event.Log}
+		}
+		return callback(log)
+	})
+}
+
+func (h *Handler) OnPixClaim(callback func(PixClaimLog.Log) error) {
+	//	Register the callback run for the "pix-claim" Events
+	h.On("pix-claim", func(event Event) error {
+		log, ok := event.Log.(PixClaimLog.Log)
+		if !ok {
+			return LogTypeError{Subscription: event.Subscription, Log: event.Log}
+		}
+		return callback(log)
+	})
+}
+
+func (h *Handler) OnPixChargeback(callback func(PixChargebackLog.Log) error) {
+	//	Register the callback run for the "pix-chargeback" Events
+	h.On("pix-chargeback", func(event Event) error {
+		log, ok := event.Log.(PixChargebackLog.Log)
+		if !ok {
+			return LogTypeError{Subscription: event.Subscription, Log: event.Log}
+		}
+		return callback(log)
+	})
+}
+
+func (h *Handler) OnPixInfraction(callback func(PixInfractionLog.Log) error) {
+	//	Register the callback run for the "pix-infraction" Events
+	h.On("pix-infraction", func(event Event) error {
+		log, ok := event.Log.(PixInfractionLog.Log)
+		if !ok {
+			return LogTypeError{Subscription: event.Subscription, Log: event.Log}
+		}
+		return callback(log)
+	})
+}
+
+func (h *Handler) OnPixDispute(callback func(PixDisputeLog.Log) error) {
+	//	Register the callback run for the "pix-dispute" Events
+	h.On("pix-dispute", func(event Event) error {
+		log, ok := event.Log.(PixDisputeLog.Log)
+		if !ok {
+			return LogTypeError{Subscription: event.Subscription, Log: event.Log}
+		}
+		return callback(log)
+	})
+}
+
+func (h *Handler) OnPixRequestIn(callback func(PixRequestLog.Log) error) {
+	//	Register the callback run for the "pix-request.in" Events
+	h.On("pix-request.in", func(event Event) error {
+		log, ok := event.Log.(PixRequestLog.Log)
+		if !ok {
+			return LogTypeError{Subscription: event.Subscription, Log: event.Log}
+		}
+		return callback(log)
+	})
+}
+
+func (h *Handler) OnPixRequestOut(callback func(PixRequestLog.Log) error) {
+	//	Register the callback run for the "pix-request.out" Events
+	h.On("pix-request.out", func(event Event) error {
+		log, ok := event.Log.(PixRequestLog.Log)
+		if !ok {
+			return LogTypeError{Subscription: event.Subscription, Log: event.Log}
+		}
+		return callback(log)
+	})
+}
+
+func (h *Handler) OnPixReversalIn(callback func(PixReversalLog.Log) error) {
+	//	Register the callback run for the "pix-reversal.in" Events
+	h.On("pix-reversal.in", func(event Event) error {
+		log, ok := event.Log.(PixReversalLog.Log)
+		if !ok {
+			return LogTypeError{Subscription: event.Subscription, Log: event.Log}
+		}
+		return callback(log)
+	})
+}
+
+func (h *Handler) OnPixReversalOut(callback func(PixReversalLog.Log) error) {
+	//	Register the callback run for the "pix-reversal.out" Events
+	h.On("pix-reversal.out", func(event Event) error {
+		log, ok := event.Log.(PixReversalLog.Log)
+		if !ok {
+			return LogTypeError{Subscription: event.Subscription, Log: event.Log}
+		}
+		return callback(log)
+	})
+}
+
+func (h *Handler) OnIssuingCard(callback func(IssuingCardLog.Log) error) {
+	//	Register the callback run for the "issuing-card" Events
+	h.On("issuing-card", func(event Event) error {
+		log, ok := event.Log.(IssuingCardLog.Log)
+		if !ok {
+			return LogTypeError{Subscription: event.Subscription, Log: event.Log}
+		}
+		return callback(log)
+	})
+}
+
+func (h *Handler) OnIssuingInvoice(callback func(IssuingInvoiceLog.Log) error) {
+	//	Register the callback run for the "issuing-invoice" Events
+	h.On("issuing-invoice", func(event Event) error {
+		log, ok := event.Log.(IssuingInvoiceLog.Log)
+		if !ok {
+			return LogTypeError{Subscription: event.Subscription, Log: event.Log}
+		}
+		return callback(log)
+	})
+}
+
+func (h *Handler) OnIssuingPurchase(callback func(IssuingPurchaseLog.Log) error) {
+	//	Register the callback run for the "issuing-purchase" Events
+	h.On("issuing-purchase", func(event Event) error {
+		log, ok := event.Log.(IssuingPurchaseLog.Log)
+		if !ok {
+			return LogTypeError{Subscription: event.Subscription, Log: event.Log}
+		}
+		return callback(log)
+	})
+}
+
+func (h *Handler) OnIssuingToken(callback func(IssuingTokenLog.Log) error) {
+	//	Register the callback run for the "issuing-token" Events
+	h.On("issuing-token", func(event Event) error {
+		log, ok := event.Log.(IssuingTokenLog.Log)
+		if !ok {
+			return LogTypeError{Subscription: event.Subscription, Log: event.Log}
+		}
+		return callback(log)
+	})
+}
+
+func (h *Handler) OnCreditNote(callback func(CreditNoteLog.Log) error) {
+	//	Register the callback run for the "credit-note" Events
+	h.On("credit-note", func(event Event) error {
+		log, ok := event.Log.(CreditNoteLog.Log)
+		if !ok {
+			return LogTypeError{Subscription: event.Subscription, Log: event.Log}
+		}
+		return callback(log)
+	})
+}
+
+func (h *Handler) OnBusinessIdentity(callback func(BusinessIdentityLog.Log) error) {
+	//	Register the callback run for the "business-identity" Events
+	h.On("business-identity", func(event Event) error {
+		log, ok := event.Log.(BusinessIdentityLog.Log)
+		if !ok {
+			return LogTypeError{Subscription: event.Subscription, Log: event.Log}
+		}
+		return callback(log)
+	})
+}
+
+func (h *Handler) OnPixPullSubscription(callback func(PixPullSubscriptionLog.Log) error) {
+	//	Register the callback run for the "pix-pull-subscription" Events
+	h.On("pix-pull-subscription", func(event Event) error {
+		log, ok := event.Log.(PixPullSubscriptionLog.Log)
+		if !ok {
+			return LogTypeError{Subscription: event.Subscription, Log: event.Log}
+		}
+		return callback(log)
+	})
+}
+
+func (h *Handler) OnPixPullRequest(callback func(PixPullRequestLog.Log) error) {
+	//	Register the callback run for the "pix-pull-request" Events
+	h.On("pix-pull-request", func(event Event) error {
+		log, ok := event.Log.(PixPullRequestLog.Log)
+		if !ok {
+			return LogTypeError{Subscription: event.Subscription, Log: event.Log}
+		}
+		return callback(log)
+	})
+}
+
+func (h *Handler) OnIndividualIdentity(callback func(IndividualIdentityLog.Log) error) {
+	//	Register the callback run for the "individual-identity" Events
+	h.On("individual-identity", func(event Event) error {
+		log, ok := event.Log.(IndividualIdentityLog.Log)
+		if !ok {
+			return LogTypeError{Subscription: event.Subscription, Log: event.Log}
+		}
+		return callback(log)
+	})
+}
+
+func (h *Handler) OnIndividualDocument(callback func(IndividualDocumentLog.Log) error) {
+	//	Register the callback run for the "individual-document" Events
+	h.On("individual-document", func(event Event) error {
+		log, ok := event.Log.(IndividualDocumentLog.Log)
+		if !ok {
+			return LogTypeError{Subscription: event.Subscription, Log: event.Log}
+		}
+		return callback(log)
+	})
+}
+
+func (h *Handler) OnBusinessAttachment(callback func(BusinessAttachmentLog.Log) error) {
+	//	Register the callback run for the "business-attachment" Events
+	h.On("business-attachment", func(event Event) error {
+		log, ok := event.Log.(BusinessAttachmentLog.Log)
+		if !ok {
+			return LogTypeError{Subscription: event.Subscription, Log: event.Log}
+		}
+		return callback(log)
+	})
+}
+
+func (h *Handler) OnIssuingHolder(callback func(IssuingHolderLog.Log) error) {
+	//	Register the callback run for the "issuing-holder" Events
+	h.On("issuing-holder", func(event Event) error {
+		log, ok := event.Log.(IssuingHolderLog.Log)
+		if !ok {
+			return LogTypeError{Subscription: event.Subscription, Log: event.Log}
+		}
+		return callback(log)
+	})
+}
+
+func (h *Handler) OnIssuingEmbossingRequest(callback func(IssuingEmbossingRequestLog.Log) error) {
+	//	Register the callback run for the "issuing-embossing-request" Events
+	h.On("issuing-embossing-request", func(event Event) error {
+		log, ok := event.Log.(IssuingEmbossingRequestLog.Log)
+		if !ok {
+			return LogTypeError{Subscription: event.Subscription, Log: event.Log}
+		}
+		return callback(log)
+	})
+}
+
+func (h *Handler) OnIssuingStock(callback func(IssuingStockLog.Log) error) {
+	//	Register the callback run for the "issuing-stock" Events
+	h.On("issuing-stock", func(event Event) error {
+		log, ok := event.Log.(IssuingStockLog.Log)
+		if !ok {
+			return LogTypeError{Subscription: event.Subscription, Log: event.Log}
+		}
+		return callback(log)
+	})
+}
+
+func (h *Handler) OnIssuingRestock(callback func(IssuingRestockLog.Log) error) {
+	//	Register the callback run for the "issuing-restock" Events
+	h.On("issuing-restock", func(event Event) error {
+		log, ok := event.Log.(IssuingRestockLog.Log)
+		if !ok {
+			return LogTypeError{Subscription: event.Subscription, Log: event.Log}
+		}
+		return callback(log)
+	})
+}
+
+func (h *Handler) OnPixFraud(callback func(PixFraudLog.Log) error) {
+	//	Register the callback run for the "pix-fraud" Events
+	h.On("pix-fraud", func(event Event) error {
+		log, ok := event.Log.(PixFraudLog.Log)
+		if !ok {
+			return LogTypeError{Subscription: event.Subscription, Log: event.Log}
+		}
+		return callback(log)
+	})
+}
+
+func (h *Handler) OnCreditHolmes(callback func(CreditHolmesLog.Log) error) {
+	//	Register the callback run for the "credit-holmes" Events
+	h.On("credit-holmes", func(event Event) error {
+		log, ok := event.Log.(CreditHolmesLog.Log)
+		if !ok {
+			return LogTypeError{Subscription: event.Subscription, Log: event.Log}
+		}
+		return callback(log)
+	})
+}
+
+func (h *Handler) OnLedger(callback func(LedgerLog.Log) error) {
+	//	Register the callback run for the "ledger" Events
+	h.On("ledger", func(event Event) error {
+		log, ok := event.Log.(LedgerLog.Log)
+		if !ok {
+			return LogTypeError{Subscription: event.Subscription, Log: event.Log}
+		}
+		return callback(log)
+	})
+}
+
+func (h *Handler) OnPixInternalTransactionReport(callback func(PixInternalTransactionReportLog.Log) error) {
+	//	Register the callback run for the "pix-internal-transaction-report" Events
+	h.On("pix-internal-transaction-report", func(event Event) error {
+		log, ok := event.Log.(PixInternalTransactionReportLog.Log)
+		if !ok {
+			return LogTypeError{Subscription: event.Subscription, Log: event.Log}
+		}
+		return callback(log)
+	})
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "Webhook Events must be sent with POST", http.StatusMethodNotAllowed)
+		return
+	}
+	body, readError := io.ReadAll(r.Body)
+	if readError != nil || !json.Valid(body) {
+		http.Error(w, "The request body is not a JSON Event", http.StatusBadRequest)
+		return
+	}
+
+	event, err := h.parse(r.Context(), string(body), r.Header.Get("Digital-Signature"))
+	if err.Errors != nil {
+		status := http.StatusInternalServerError
+		if err.Errors[0].Code == "invalidSignatureError" {
+			status = http.StatusUnauthorized
+		}
+		http.Error(w, fmt.Sprintf("%v: %v", err.Errors[0].Code, err.Errors[0].Message), status)
+		return
+	}
+
+	handle := h.Dispatch
+	if h.processor != nil {
+		handle = h.processor.Process
+	}
+	if handleError := handle(event); handleError != nil {
+		http.Error(w, fmt.Sprintf("Event %v was not handled", event.Id), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *Handler) Dispatch(event Event) error {
+	//	Run the callback registered for the subscription of an Event
+	//
+	//	Lets Events obtained elsewhere, such as from Query, go through the same
+	//	callbacks as the ones received by ServeHTTP.
+	//
+	//	Parameters (required):
+	//	- event [Event struct]: Event to be handled. Its Log is decoded with ParseLog if needed
+	//
+	//	Return:
+	//	- error returned by the callback, a *starkinfra.Error if the Log cannot be decoded, or nil if no callback is registered for the subscription
+	callback, ok := h.callbacks[event.Subscription]
+	if !ok {
+		return nil
+	}
+	event, err := Client{Api: h.Api}.ParseLog(event)
+	if err.Errors != nil {
+		return utils.ToError(err)
+	}
+	return callback(event)
+}
+
+func (h *Handler) Deduplicate(store Store) {
+	//	Skip the Events already handled
+	//
+	//	Makes ServeHTTP run the callbacks through a Processor, so an Event
+	//	delivered more than once is only handled once. Must be called before
+	//	the Handler starts serving requests.
+	//
+	//	Parameters (required):
+	//	- store [Store]: Store keeping the ids of the handled Events. ex: event.NewMemoryStore()
+	h.processor = NewProcessor(store, h.Dispatch)
+}
+
+func (h *Handler) parse(ctx context.Context, content string, signature string) (Event, Error.StarkErrors) {
+	event, err := Client{Api: h.Api}.ParseCtx(ctx, content, signature)
+	if err.Errors != nil {
+		return event, err
+	}
+	return Client{Api: h.Api}.ParseLog(event)
+}
@@ -0,0 +1,368 @@
+package event
+
+import (
+	Error "github.com/starkinfra/core-go/starkcore/error"
+	BusinessAttachmentLog "github.com/starkinfra/sdk-go/starkinfra/businessattachment/log"
+	BusinessIdentityLog "github.com/starkinfra/sdk-go/starkinfra/businessidentity/log"
+	CreditHolmesLog "github.com/starkinfra/sdk-go/starkinfra/creditholmes/log"
+	CreditNoteLog "github.com/starkinfra/sdk-go/starkinfra/creditnote/log"
+	IndividualDocumentLog "github.com/starkinfra/sdk-go/starkinfra/individualdocument/log"
+	IndividualIdentityLog "github.com/starkinfra/sdk-go/starkinfra/individualidentity/log"
+	IssuingCardLog "github.com/starkinfra/sdk-go/starkinfra/issuingcard/log"
+	IssuingEmbossingRequestLog "github.com/starkinfra/sdk-go/starkinfra/issuingembossingrequest/log"
+	IssuingHolderLog "github.com/starkinfra/sdk-go/starkinfra/issuingholder/log"
+	IssuingInvoiceLog "github.com/starkinfra/sdk-go/starkinfra/issuinginvoice/log"
+	IssuingPurchaseLog "github.com/starkinfra/sdk-go/starkinfra/issuingpurchase/log"
+	IssuingRestockLog "github.com/starkinfra/sdk-go/starkinfra/issuingrestock/log"
+	IssuingStockLog "github.com/starkinfra/sdk-go/starkinfra/issuingstock/log"
+	IssuingTokenLog "github.com/starkinfra/sdk-go/starkinfra/issuingtoken/log"
+	LedgerLog "github.com/starkinfra/sdk-go/starkinfra/ledger/log"
+	PixChargebackLog "github.com/starkinfra/sdk-go/starkinfra/pixchargeback/log"
+	PixClaimLog "github.com/starkinfra/sdk-go/starkinfra/pixclaim/log"
+	PixDisputeLog "github.com/starkinfra/sdk-go/starkinfra/pixdispute/log"
+	PixFraudLog "github.com/starkinfra/sdk-go/starkinfra/pixfraud/log"
+	PixInfractionLog "github.com/starkinfra/sdk-go/starkinfra/pixinfraction/log"
+	PixInternalTransactionReportLog "github.com/starkinfra/sdk-go/starkinfra/pixinternaltransactionreport/log"
+	PixKeyLog "github.com/starkinfra/sdk-go/starkinfra/pixkey/log"
+	PixPullRequestLog "github.com/starkinfra/sdk-go/starkinfra/pixpullrequest/log"
+	PixPullSubscriptionLog "github.com/starkinfra/sdk-go/starkinfra/pixpullsubscription/log"
+	PixRequestLog "github.com/starkinfra/sdk-go/starkinfra/pixrequest/log"
+	PixReversalLog "github.com/starkinfra/sdk-go/starkinfra/pixreversal/log"
+	"github.com/starkinfra/sdk-go/starkinfra/utils"
+)
+
+//	Typed Event Log accessors
+//
+//	Each As<Service>Log method returns the Log of the Event as the struct of
+//	that service, decoding it first if ParseLog has not been called yet, so the
+//	subscription need not be checked beforehand. The bool is false if the Event
+//	belongs to another service or its Log could not be decoded, in which case
+//	the decoding error is returned too. The Event methods decode with the
+//	package defaults, while the Client methods follow the Strict setting of
+//	their Client.
+//
+//	Example:
+//	log, ok, err := event.AsPixRequestLog()
+//	if err.Errors != nil {
+//		panic(err.Errors[0].Message)
+//	}
+//	if ok {
+//		fmt.Println(log.Request.Amount)
+//	}
+
+func (c Client) decodedLog(event Event) (interface{}, Error.StarkErrors) {
+	parsed, err := c.ParseLog(event)
+	return parsed.Log, err
+}
+
+func (e Event) AsBusinessAttachmentLog() (BusinessAttachmentLog.Log, bool, Error.StarkErrors) {
+	//	Return the Log of a BusinessAttachment Event
+	return Client{Api: utils.Default(nil)}.AsBusinessAttachmentLog(e)
+}
+
+func (c Client) AsBusinessAttachmentLog(event Event) (BusinessAttachmentLog.Log, bool, Error.StarkErrors) {
+	//	Return the Log of a BusinessAttachment Event, decoded following the Strict setting of the Client
+	log, err := c.decodedLog(event)
+	decoded, ok := log.(BusinessAttachmentLog.Log)
+	return decoded, ok, err
+}
+
+func (e Event) AsBusinessIdentityLog() (BusinessIdentityLog.Log, bool, Error.StarkErrors) {
+	//	Return the Log of a BusinessIdentity Event
+	return Client{Api: utils.Default(nil)}.AsBusinessIdentityLog(e)
+}
+
+func (c Client) AsBusinessIdentityLog(event Event) (BusinessIdentityLog.Log, bool, Error.StarkErrors) {
+	//	Return the Log of a BusinessIdentity Event, decoded following the Strict setting of the Client
+	log, err := c.decodedLog(event)
+	decoded, ok := log.(BusinessIdentityLog.Log)
+	return decoded, ok, err
+}
+
+func (e Event) AsCreditHolmesLog() (CreditHolmesLog.Log, bool, Error.StarkErrors) {
+	//	Return the Log of a CreditHolmes Event
+	return Client{Api: utils.Default(nil)}.AsCreditHolmesLog(e)
+}
+
+func (c Client) AsCreditHolmesLog(event Event) (CreditHolmesLog.Log, bool, Error.StarkErrors) {
+	//	Return the Log of a CreditHolmes Event, decoded following the Strict setting of the Client
+	log, err := c.decodedLog(event)
+	decoded, ok := log.(CreditHolmesLog.Log)
+	return decoded, ok, err
+}
+
+func (e Event) AsCreditNoteLog() (CreditNoteLog.Log, bool, Error.StarkErrors) {
+	//	Return the Log of a CreditNote Event
+	return Client{Api: utils.Default(nil)}.AsCreditNoteLog(e)
+}
+
+func (c Client) AsCreditNoteLog(event Event) (CreditNoteLog.Log, bool, Error.StarkErrors) {
+	//	Return the Log of a CreditNote Event, decoded following the Strict setting of the Client
+	log, err := c.decodedLog(event)
+	decoded, ok := log.(CreditNoteLog.Log)
+	return decoded, ok, err
+}
+
+func (e Event) AsIndividualDocumentLog() (IndividualDocumentLog.Log, bool, Error.StarkErrors) {
+	//	Return the Log of an IndividualDocument Event
+	return Client{Api: utils.Default(nil)}.AsIndividualDocumentLog(e)
+}
+
+func (c Client) AsIndividualDocumentLog(event Event) (IndividualDocumentLog.Log, bool, Error.StarkErrors) {
+	//	Return the Log of an IndividualDocument Event, decoded following the Strict setting of the Client
+	log, err := c.decodedLog(event)
+	decoded, ok := log.(IndividualDocumentLog.Log)
+	return decoded, ok, err
+}
+
+func (e Event) AsIndividualIdentityLog() (IndividualIdentityLog.Log, bool, Error.StarkErrors) {
+	//	Return the Log of an IndividualIdentity Event
+	return Client{Api: utils.Default(nil)}.AsIndividualIdentityLog(e)
+}
+
+func (c Client) AsIndividualIdentityLog(event Event) (IndividualIdentityLog.Log, bool, Error.StarkErrors) {
+	//	Return the Log of an IndividualIdentity Event, decoded following the Strict setting of the Client
+	log, err := c.decodedLog(event)
+	decoded, ok := log.(IndividualIdentityLog.Log)
+	return decoded, ok, err
+}
+
+func (e Event) AsIssuingCardLog() (IssuingCardLog.Log, bool, Error.StarkErrors) {
+	//	Return the Log of an IssuingCard Event
+	return Client{Api: utils.Default(nil)}.AsIssuingCardLog(e)
+}
+
+func (c Client) AsIssuingCardLog(event Event) (IssuingCardLog.Log, bool, Error.StarkErrors) {
+	//	Return the Log of an IssuingCard Event, decoded following the Strict setting of the Client
+	log, err := c.decodedLog(event)
+	decoded, ok := log.(IssuingCardLog.Log)
+	return decoded, ok, err
+}
+
+func (e Event) AsIssuingEmbossingRequestLog() (IssuingEmbossingRequestLog.Log, bool, Error.StarkErrors) {
+	//	Return the Log of an IssuingEmbossingRequest Event
+	return Client{Api: utils.Default(nil)}.AsIssuingEmbossingRequestLog(e)
+}
+
+func (c Client) AsIssuingEmbossingRequestLog(event Event) (IssuingEmbossingRequestLog.Log, bool, Error.StarkErrors) {
+	//	Return the Log of an IssuingEmbossingRequest Event, decoded following the Strict setting of the Client
+	log, err := c.decodedLog(event)
+	decoded, ok := log.(IssuingEmbossingRequestLog.Log)
+	return decoded, ok, err
+}
+
+func (e Event) AsIssuingHolderLog() (IssuingHolderLog.Log, bool, Error.StarkErrors) {
+	//	Return the Log of an IssuingHolder Event
+	return Client{Api: utils.Default(nil)}.AsIssuingHolderLog(e)
+}
+
+func (c Client) AsIssuingHolderLog(event Event) (IssuingHolderLog.Log, bool, Error.StarkErrors) {
+	//	Return the Log of an IssuingHolder Event, decoded following the Strict setting of the Client
+	log, err := c.decodedLog(event)
+	decoded, ok := log.(IssuingHolderLog.Log)
+	return decoded, ok, err
+}
+
+func (e Event) AsIssuingInvoiceLog() (IssuingInvoiceLog.Log, bool, Error.StarkErrors) {
+	//	Return the Log of an IssuingInvoice Event
+	return Client{Api: utils.Default(nil)}.AsIssuingInvoiceLog(e)
+}
+
+func (c Client) AsIssuingInvoiceLog(event Event) (IssuingInvoiceLog.Log, bool, Error.StarkErrors) {
+	//	Return the Log of an IssuingInvoice Event, decoded following the Strict setting of the Client
+	log, err := c.decodedLog(event)
+	decoded, ok := log.(IssuingInvoiceLog.Log)
+	return decoded, ok, err
+}
+
+func (e Event) AsIssuingPurchaseLog() (IssuingPurchaseLog.Log, bool, Error.StarkErrors) {
+	//	Return the Log of an IssuingPurchase Event
+	return Client{Api: utils.Default(nil)}.AsIssuingPurchaseLog(e)
+}
+
+func (c Client) AsIssuingPurchaseLog(event Event) (IssuingPurchaseLog.Log, bool, Error.StarkErrors) {
+	//	Return the Log of an IssuingPurchase Event, decoded following the Strict setting of the Client
+	log, err := c.decodedLog(event)
+	decoded, ok := log.(IssuingPurchaseLog.Log)
+	return decoded, ok, err
+}
+
+func (e Event) AsIssuingRestockLog() (IssuingRestockLog.Log, bool, Error.StarkErrors) {
+	//	Return the Log of an IssuingRestock Event
+	return Client{Api: utils.Default(nil)}.AsIssuingRestockLog(e)
+}
+
+func (c Client) AsIssuingRestockLog(event Event) (IssuingRestockLog.Log, bool, Error.StarkErrors) {
+	//	Return the Log of an IssuingRestock Event, decoded following the Strict setting of the Client
+	log, err := c.decodedLog(event)
+	decoded, ok := log.(IssuingRestockLog.Log)
+	return decoded, ok, err
+}
+
+func (e Event) AsIssuingStockLog() (IssuingStockLog.Log, bool, Error.StarkErrors) {
+	//	Return the Log of an IssuingStock Event
+	return Client{Api: utils.Default(nil)}.AsIssuingStockLog(e)
+}
+
+func (c Client) AsIssuingStockLog(event Event) (IssuingStockLog.Log, bool, Error.StarkErrors) {
+	//	Return the Log of an IssuingStock Event, decoded following the Strict setting of the Client
+	log, err := c.decodedLog(event)
+	decoded, ok := log.(IssuingStockLog.Log)
+	return decoded, ok, err
+}
+
+func (e Event) AsIssuingTokenLog() (IssuingTokenLog.Log, bool, Error.StarkErrors) {
+	//	Return the Log of an IssuingToken Event
+	return Client{Api: utils.Default(nil)}.AsIssuingTokenLog(e)
+}
+
+func (c Client) AsIssuingTokenLog(event Event) (IssuingTokenLog.Log, bool, Error.StarkErrors) {
+	//	Return the Log of an IssuingToken Event, decoded following the Strict setting of the Client
+	log, err := c.decodedLog(event)
+	decoded, ok := log.(IssuingTokenLog.Log)
+	return decoded, ok, err
+}
+
+func (e Event) AsLedgerLog() (LedgerLog.Log, bool, Error.StarkErrors) {
+	//	Return the Log of a Ledger Event
+	return Client{Api: utils.Default(nil)}.AsLedgerLog(e)
+}
+
+func (c Client) AsLedgerLog(event Event) (LedgerLog.Log, bool, Error.StarkErrors) {
+	//	Return the Log of a Ledger Event, decoded following the Strict setting of the Client
+	log, err := c.decodedLog(event)
+	decoded, ok := log.(LedgerLog.Log)
+	return decoded, ok, err
+}
+
+func (e Event) AsPixChargebackLog() (PixChargebackLog.Log, bool, Error.StarkErrors) {
+	//	Return the Log of a PixChargeback Event
+	return Client{Api: utils.Default(nil)}.AsPixChargebackLog(e)
+}
+
+func (c Client) AsPixChargebackLog(event Event) (PixChargebackLog.Log, bool, Error.StarkErrors) {
+	//	Return the Log of a PixChargeback Event, decoded following the Strict setting of the Client
+	log, err := c.decodedLog(event)
+	decoded, ok := log.(PixChargebackLog.Log)
+	return decoded, ok, err
+}
+
+func (e Event) AsPixClaimLog() (PixClaimLog.Log, bool, Error.StarkErrors) {
+	//	Return the Log of a PixClaim Event
+	return Client{Api: utils.Default(nil)}.AsPixClaimLog(e)
+}
+
+func (c Client) AsPixClaimLog(event Event) (PixClaimLog.Log, bool, Error.StarkErrors) {
+	//	Return the Log of a PixClaim Event, decoded following the Strict setting of the Client
+	log, err := c.decodedLog(event)
+	decoded, ok := log.(PixClaimLog.Log)
+	return decoded, ok, err
+}
+
+func (e Event) AsPixDisputeLog() (PixDisputeLog.Log, bool, Error.StarkErrors) {
+	//	Return the Log of a PixDispute Event
+	return Client{Api: utils.Default(nil)}.AsPixDisputeLog(e)
+}
+
+func (c Client) AsPixDisputeLog(event Event) (PixDisputeLog.Log, bool, Error.StarkErrors) {
+	//	Return the Log of a PixDispute Event, decoded following the Strict setting of the Client
+	log, err := c.decodedLog(event)
+	decoded, ok := log.(PixDisputeLog.Log)
+	return decoded, ok, err
+}
+
+func (e Event) AsPixFraudLog() (PixFraudLog.Log, bool, Error.StarkErrors) {
+	//	Return the Log of a PixFraud Event
+	return Client{Api: utils.Default(nil)}.AsPixFraudLog(e)
+}
+
+func (c Client) AsPixFraudLog(event Event) (PixFraudLog.Log, bool, Error.StarkErrors) {
+	//	Return the Log of a PixFraud Event, decoded following the Strict setting of the Client
+	log, err := c.decodedLog(event)
+	decoded, ok := log.(PixFraudLog.Log)
+	return decoded, ok, err
+}
+
+func (e Event) AsPixInfractionLog() (PixInfractionLog.Log, bool, Error.StarkErrors) {
+	//	Return the Log of a PixInfraction Event
+	return Client{Api: utils.Default(nil)}.AsPixInfractionLog(e)
+}
+
+func (c Client) AsPixInfractionLog(event Event) (PixInfractionLog.Log, bool, Error.StarkErrors) {
+	//	Return the Log of a PixInfraction Event, decoded following the Strict setting of the Client
+	log, err := c.decodedLog(event)
+	decoded, ok := log.(PixInfractionLog.Log)
+	return decoded, ok, err
+}
+
+func (e Event) AsPixInternalTransactionReportLog() (PixInternalTransactionReportLog.Log, bool, Error.StarkErrors) {
+	//	Return the Log of a PixInternalTransactionReport Event
+	return Client{Api: utils.Default(nil)}.AsPixInternalTransactionReportLog(e)
+}
+
+func (c Client) AsPixInternalTransactionReportLog(event Event) (PixInternalTransactionReportLog.Log, bool, Error.StarkErrors) {
+	//	Return the Log of a PixInternalTransactionReport Event, decoded following the Strict setting of the Client
+	log, err := c.decodedLog(event)
+	decoded, ok := log.(PixInternalTransactionReportLog.Log)
+	return decoded, ok, err
+}
+
+func (e Event) AsPixKeyLog() (PixKeyLog.Log, bool, Error.StarkErrors) {
+	//	Return the Log of a PixKey Event
+	return Client{Api: utils.Default(nil)}.AsPixKeyLog(e)
+}
+
+func (c Client) AsPixKeyLog(event Event) (PixKeyLog.Log, bool, Error.StarkErrors) {
+	//	Return the Log of a PixKey Event, decoded following the Strict setting of the Client
+	log, err := c.decodedLog(event)
+	decoded, ok := log.(PixKeyLog.Log)
+	return decoded, ok, err
+}
+
+func (e Event) AsPixPullRequestLog() (PixPullRequestLog.Log, bool, Error.StarkErrors) {
+	//	Return the Log of a PixPullRequest Event
+	return Client{Api: utils.Default(nil)}.AsPixPullRequestLog(e)
+}
+
+func (c Client) AsPixPullRequestLog(event Event) (PixPullRequestLog.Log, bool, Error.StarkErrors) {
+	//	Return the Log of a PixPullRequest Event, decoded following the Strict setting of the Client
+	log, err := c.decodedLog(event)
+	decoded, ok := log.(PixPullRequestLog.Log)
+	return decoded, ok, err
+}
+
+func (e Event) AsPixPullSubscriptionLog() (PixPullSubscriptionLog.Log, bool, Error.StarkErrors) {
+	//	Return the Log of a PixPullSubscription Event
+	return Client{Api: utils.Default(nil)}.AsPixPullSubscriptionLog(e)
+}
+
+func (c Client) AsPixPullSubscriptionLog(event Event) (PixPullSubscriptionLog.Log, bool, Error.StarkErrors) {
+	//	Return the Log of a PixPullSubscription Event, decoded following the Strict setting of the Client
+	log, err := c.decodedLog(event)
+	decoded, ok := log.(PixPullSubscriptionLog.Log)
+	return decoded, ok, err
+}
+
+func (e Event) AsPixRequestLog() (PixRequestLog.Log, bool, Error.StarkErrors) {
+	//	Return the Log of a PixRequest Event
+	return Client{Api: utils.Default(nil)}.AsPixRequestLog(e)
+}
+
+func (c Client) AsPixRequestLog(event Event) (PixRequestLog.Log, bool, Error.StarkErrors) {
+	//	Return the Log of a PixRequest Event, decoded following the Strict setting of the Client
+	log, err := c.decodedLog(event)
+	decoded, ok := log.(PixRequestLog.Log)
+	return decoded, ok, err
+}
+
+func (e Event) AsPixReversalLog() (PixReversalLog.Log, bool, Error.StarkErrors) {
+	//	Return the Log of a PixReversal Event
+	return Client{Api: utils.Default(nil)}.AsPixReversalLog(e)
+}
+
+func (c Client) AsPixReversalLog(event Event) (PixReversalLog.Log, bool, Error.StarkErrors) {
+	//	Return the Log of a PixReversal Event, decoded following the Strict setting of the Client
+	log, err := c.decodedLog(event)
+	decoded, ok := log.(PixReversalLog.Log)
+	return decoded, ok, err
+}
@@ -0,0 +1,88 @@
+package event
+
+import (
+	"sync"
+)
+
+//	Event Processor struct
+//
+//	Handles each Event at most once, no matter how many times it is received.
+//	Stark Infra delivers an Event again until the Webhook answers it with a
+//	2xx status, and Query with isDelivered false may return Events that were
+//	already received by the Webhook. The Processor keeps the ids of the handled
+//	Events in a Store and skips the ones it finds there. An Event id is only
+//	added to the Store once Handle succeeds, so failed Events are tried again
+//	on their next delivery. Concurrent calls for the same Event id wait for
+//	each other.
+//
+//	Attributes:
+//	- Store [Store]: Store keeping the ids of the handled Events. ex: event.NewMemoryStore()
+//	- Handle [func(Event) error]: Function run for each new Event. ex: handler.Dispatch
+
+type Processor struct {
+	Store   Store
+	Handle  func(Event) error
+	mutex   sync.Mutex
+	running map[string]chan struct{}
+}
+
+func NewProcessor(store Store, handle func(Event) error) *Processor {
+	//	Create an Event Processor
+	//
+	//	Parameters (required):
+	//	- store [Store]: Store keeping the ids of the handled Events. ex: event.NewMemoryStore()
+	//	- handle [func(Event) error]: Function run for each new Event. ex: handler.Dispatch
+	return &Processor{Store: store, Handle: handle}
+}
+
+func (p *Processor) Process(event Event) error {
+	//	Handle an Event unless it was already handled
+	//
+	//	Parameters (required):
+	//	- event [Event struct]: Event to be handled
+	//
+	//	Return:
+	//	- nil if the Event was handled now or before, the error of Handle or of the Store otherwise.
+	//	  An error from Store.Add means Handle succeeded but the Event may be handled again
+	if event.Id == "" {
+		return p.Handle(event)
+	}
+	release := p.lock(event.Id)
+	defer release()
+
+	handled, err := p.Store.Has(event.Id)
+	if err != nil {
+		return err
+	}
+	if handled {
+		return nil
+	}
+	err = p.Handle(event)
+	if err != nil {
+		return err
+	}
+	return p.Store.Add(event.Id)
+}
+
+func (p *Processor) lock(id string) func() {
+	for {
+		p.mutex.Lock()
+		if p.running == nil {
+			p.running = map[string]chan struct{}{}
+		}
+		running, ok := p.running[id]
+		if !ok {
+			done := make(chan struct{})
+			p.running[id] = done
+			p.mutex.Unlock()
+			return func() {
+				p.mutex.Lock()
+				delete(p.running, id)
+				p.mutex.Unlock()
+				close(done)
+			}
+		}
+		p.mutex.Unlock()
+		<-running
+	}
+}
@@ -0,0 +1,238 @@
+package event
+
+import (
+	"context"
+	"errors"
+	Error "github.com/starkinfra/core-go/starkcore/error"
+	"github.com/starkinfra/core-go/starkcore/user/user"
+	"github.com/starkinfra/sdk-go/starkinfra/utils"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+)
+
+//	Event Recovery struct
+//
+//	Catches up on the Events that could not be delivered to the Webhook, such
+//	as while the endpoint was down. Run pages through every Event created
+//	between After and Before and skips the delivered ones, so marking Events
+//	as delivered does not move the pages under the cursor. The others have
+//	their Logs decoded with ParseLog, are handed to Handle and are marked as
+//	delivered once Handle succeeds. Events that fail are left undelivered, to
+//	be tried again by the next Run, and the Checkpoint is not moved past the
+//	first page with a failed Event. Wrap Handle with a Processor to skip the
+//	Events received by the Webhook in the meantime.
+//
+//	Attributes:
+//	- Api [*utils.Client]: Client used to query and update the Events
+//	- Handle [func(Event) error]: Function run for each undelivered Event. ex: handler.Dispatch
+//	- After [time.Time, default nil]: Only Events created after this date are recovered. ex: time.Date(2022, 11, 10, 0, 0, 0, 0, time.UTC)
+//	- Before [time.Time, default nil]: Only Events created before this date are recovered. ex: time.Date(2022, 11, 12, 0, 0, 0, 0, time.UTC)
+//	- Concurrency [int, default 1]: Maximum number of Events handled at the same time. ex: 8
+//	- Checkpoint [Checkpoint, default nil]: Where the cursor after the last page finished without failures is saved, so an interrupted Run resumes from it. ex: &event.FileCheckpoint{Path: "recovery.checkpoint"}
+
+type Recovery struct {
+	Api         *utils.Client
+	Handle      func(Event) error
+	After       time.Time
+	Before      time.Time
+	Concurrency int
+	Checkpoint  Checkpoint
+}
+
+//	Event RecoveryReport struct
+//
+//	Outcome of a Recovery Run.
+//
+//	Attributes:
+//	- Delivered [slice of strings]: Ids of the Events handled and marked as delivered. ex: []string{"5656565656565656"}
+//	- Failed [map[string]error]: Error of each Event that could not be decoded, handled or marked as delivered, keyed by Event id. Decoding and delivery errors are *starkinfra.Error values
+
+type RecoveryReport struct {
+	Delivered []string
+	Failed    map[string]error
+}
+
+//	Event Checkpoint interface
+//
+//	Keeps the cursor a Recovery resumes from. A Checkpoint belongs to a single
+//	After and Before window.
+//
+//	Methods:
+//	- Load() (string, error): Return the saved cursor, or "" if there is none
+//	- Save(cursor string) error: Replace the saved cursor. "" means the Recovery finished
+
+type Checkpoint interface {
+	Load() (string, error)
+	Save(cursor string) error
+}
+
+//	Event FileCheckpoint struct
+//
+//	Checkpoint keeping the cursor in a file, replaced atomically on every Save.
+//
+//	Attributes:
+//	- Path [string]: Path of the checkpoint file. ex: "recovery.checkpoint"
+
+type FileCheckpoint struct {
+	Path string
+}
+
+func NewRecovery(handle func(Event) error, user user.User) *Recovery {
+	//	Create an Event Recovery
+	//
+	//	Parameters (required):
+	//	- handle [func(Event) error]: Function run for each undelivered Event. ex: handler.Dispatch
+	//
+	//	Parameters (optional):
+	//	- user [Organization/Project struct, default nil]: Organization or Project struct. Not necessary if starkinfra.User was set before function call
+	//
+	//	Return:
+	//	- Recovery of every undelivered Event, handling one at a time
+	return Client{Api: utils.Default(user)}.NewRecovery(handle)
+}
+
+func (c Client) NewRecovery(handle func(Event) error) *Recovery {
+	//	Create an Event Recovery
+	return &Recovery{Api: c.Api, Handle: handle}
+}
+
+func (r *Recovery) Run(ctx context.Context) (RecoveryReport, Error.StarkErrors) {
+	//	Recover the undelivered Events
+	//
+	//	Stops at the first request that fails to list the Events, or when ctx
+	//	is done, after the Events already started are finished. The Checkpoint
+	//	is cleared at the end only if no Event failed.
+	//
+	//	Parameters (required):
+	//	- ctx [context.Context]: Context bounding the whole Run
+	//
+	//	Return:
+	//	- report with the delivered and failed Events
+	report := RecoveryReport{Failed: map[string]error{}}
+	cursor, err := r.load()
+	if err.Errors != nil {
+		return report, err
+	}
+	for {
+		params := QueryParams{Limit: 100, Cursor: cursor, After: r.After, Before: r.Before}
+		page, next, err := r.Api.Page(ctx, resource, params.Map())
+		if err.Errors != nil {
+			return report, err
+		}
+		var events []Event
+		err = r.Api.Unmarshal(page, &events)
+		if err.Errors != nil {
+			return report, err
+		}
+
+		r.recoverAll(ctx, undelivered(events), &report)
+		if ctx.Err() != nil {
+			return report, utils.ContextError(ctx)
+		}
+		if len(report.Failed) == 0 {
+			err = r.save(next)
+			if err.Errors != nil {
+				return report, err
+			}
+		}
+		if next == "" {
+			return report, Error.StarkErrors{}
+		}
+		cursor = next
+	}
+}
+
+func undelivered(events []Event) []Event {
+	var pending []Event
+	for _, event := range events {
+		if !event.IsDelivered {
+			pending = append(pending, event)
+		}
+	}
+	return pending
+}
+
+func (r *Recovery) recoverAll(ctx context.Context, events []Event, report *RecoveryReport) {
+	concurrency := r.Concurrency
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+	slots := make(chan struct{}, concurrency)
+	var mutex sync.Mutex
+	var wait sync.WaitGroup
+	for _, event := range events {
+		select {
+		case slots <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		wait.Add(1)
+		go func(event Event) {
+			defer wait.Done()
+			defer func() { <-slots }()
+			err := r.recover(ctx, event)
+			mutex.Lock()
+			defer mutex.Unlock()
+			if err != nil {
+				report.Failed[event.Id] = err
+				return
+			}
+			report.Delivered = append(report.Delivered, event.Id)
+		}(event)
+	}
+	wait.Wait()
+}
+
+func (r *Recovery) recover(ctx context.Context, event Event) error {
+	event, err := Client{Api: r.Api}.ParseLog(event)
+	if err.Errors != nil {
+		return utils.ToError(err)
+	}
+	handleError := r.Handle(event)
+	if handleError != nil {
+		return handleError
+	}
+	_, err = Client{Api: r.Api}.UpdateCtx(ctx, event.Id, true)
+	return utils.ToError(err)
+}
+
+func (r *Recovery) load() (string, Error.StarkErrors) {
+	if r.Checkpoint == nil {
+		return "", Error.StarkErrors{}
+	}
+	cursor, err := r.Checkpoint.Load()
+	if err != nil {
+		return "", Error.UnknownError(err.Error())
+	}
+	return cursor, Error.StarkErrors{}
+}
+
+func (r *Recovery) save(cursor string) Error.StarkErrors {
+	if r.Checkpoint == nil {
+		return Error.StarkErrors{}
+	}
+	if err := r.Checkpoint.Save(cursor); err != nil {
+		return Error.UnknownError(err.Error())
+	}
+	return Error.StarkErrors{}
+}
+
+func (f *FileCheckpoint) Load() (string, error) {
+	content, err := ioutil.ReadFile(f.Path)
+	if errors.Is(err, os.ErrNotExist) {
+		return "", nil
+	}
+	return string(content), err
+}
+
+func (f *FileCheckpoint) Save(cursor string) error {
+	temporary := f.Path + ".tmp"
+	if err := ioutil.WriteFile(temporary, []byte(cursor), 0644); err != nil {
+		return err
+	}
+	return os.Rename(temporary, f.Path)
+}
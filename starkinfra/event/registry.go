@@ -0,0 +1,135 @@
+package event
+
+import (
+	Error "github.com/starkinfra/core-go/starkcore/error"
+	BusinessAttachmentLog "github.com/starkinfra/sdk-go/starkinfra/businessattachment/log"
+	BusinessIdentityLog "github.com/starkinfra/sdk-go/starkinfra/businessidentity/log"
+	CreditHolmesLog "github.com/starkinfra/sdk-go/starkinfra/creditholmes/log"
+	CreditNoteLog "github.com/starkinfra/sdk-go/starkinfra/creditnote/log"
+	IndividualDocumentLog "github.com/starkinfra/sdk-go/starkinfra/individualdocument/log"
+	IndividualIdentityLog "github.com/starkinfra/sdk-go/starkinfra/individualidentity/log"
+	IssuingCardLog "github.com/starkinfra/sdk-go/starkinfra/issuingcard/log"
+	IssuingEmbossingRequestLog "github.com/starkinfra/sdk-go/starkinfra/issuingembossingrequest/log"
+	IssuingHolderLog "github.com/starkinfra/sdk-go/starkinfra/issuingholder/log"
+	IssuingInvoiceLog "github.com/starkinfra/sdk-go/starkinfra/issuinginvoice/log"
+	IssuingPurchaseLog "github.com/starkinfra/sdk-go/starkinfra/issuingpurchase/log"
+	IssuingRestockLog "github.com/starkinfra/sdk-go/starkinfra/issuingrestock/log"
+	IssuingStockLog "github.com/starkinfra/sdk-go/starkinfra/issuingstock/log"
+	IssuingTokenLog "github.com/starkinfra/sdk-go/starkinfra/issuingtoken/log"
+	LedgerLog "github.com/starkinfra/sdk-go/starkinfra/ledger/log"
+	PixChargebackLog "github.com/starkinfra/sdk-go/starkinfra/pixchargeback/log"
+	PixClaimLog "github.com/starkinfra/sdk-go/starkinfra/pixclaim/log"
+	PixDisputeLog "github.com/starkinfra/sdk-go/starkinfra/pixdispute/log"
+	PixFraudLog "github.com/starkinfra/sdk-go/starkinfra/pixfraud/log"
+	PixInfractionLog "github.com/starkinfra/sdk-go/starkinfra/pixinfraction/log"
+	PixInternalTransactionReportLog "github.com/starkinfra/sdk-go/starkinfra/pixinternaltransactionreport/log"
+	PixKeyLog "github.com/starkinfra/sdk-go/starkinfra/pixkey/log"
+	PixPullRequestLog "github.com/starkinfra/sdk-go/starkinfra/pixpullrequest/log"
+	PixPullSubscriptionLog "github.com/starkinfra/sdk-go/starkinfra/pixpullsubscription/log"
+	PixRequestLog "github.com/starkinfra/sdk-go/starkinfra/pixrequest/log"
+	PixReversalLog "github.com/starkinfra/sdk-go/starkinfra/pixreversal/log"
+	"github.com/starkinfra/sdk-go/starkinfra/utils"
+	"reflect"
+	"sort"
+	"sync"
+)
+
+//	Log Decoder func
+//
+//	Decodes the JSON Log of an Event into the struct of its subscription,
+//	following the Strict setting of the utils.Client it is given. LogDecoder
+//	builds one from a struct value.
+
+type Decoder func(api *utils.Client, content []byte) (interface{}, Error.StarkErrors)
+
+var decoders = struct {
+	sync.RWMutex
+	bySubscription map[string]Decoder
+}{bySubscription: map[string]Decoder{
+	"business-attachment":             LogDecoder(BusinessAttachmentLog.Log{}),
+	"business-identity":               LogDecoder(BusinessIdentityLog.Log{}),
+	"credit-holmes":                   LogDecoder(CreditHolmesLog.Log{}),
+	"credit-note":                     LogDecoder(CreditNoteLog.Log{}),
+	"individual-document":             LogDecoder(IndividualDocumentLog.Log{}),
+	"individual-identity":             LogDecoder(IndividualIdentityLog.Log{}),
+	"issuing-card":                    LogDecoder(IssuingCardLog.Log{}),
+	"issuing-embossing-request":       LogDecoder(IssuingEmbossingRequestLog.Log{}),
+	"issuing-holder":                  LogDecoder(IssuingHolderLog.Log{}),
+	"issuing-invoice":                 LogDecoder(IssuingInvoiceLog.Log{}),
+	"issuing-purchase":                LogDecoder(IssuingPurchaseLog.Log{}),
+	"issuing-restock":                 LogDecoder(IssuingRestockLog.Log{}),
+	"issuing-stock":                   LogDecoder(IssuingStockLog.Log{}),
+	"issuing-token":                   LogDecoder(IssuingTokenLog.Log{}),
+	"ledger":                          LogDecoder(LedgerLog.Log{}),
+	"pix-chargeback":                  LogDecoder(PixChargebackLog.Log{}),
+	"pix-claim":                       LogDecoder(PixClaimLog.Log{}),
+	"pix-dispute":                     LogDecoder(PixDisputeLog.Log{}),
+	"pix-fraud":                       LogDecoder(PixFraudLog.Log{}),
+	"pix-infraction":                  LogDecoder(PixInfractionLog.Log{}),
+	"pix-internal-transaction-report": LogDecoder(PixInternalTransactionReportLog.Log{}),
+	"pix-key":                         LogDecoder(PixKeyLog.Log{}),
+	"pix-pull-request":                LogDecoder(PixPullRequestLog.Log{}),
+	"pix-pull-subscription":           LogDecoder(PixPullSubscriptionLog.Log{}),
+	"pix-request.in":                  LogDecoder(PixRequestLog.Log{}),
+	"pix-request.out":                 LogDecoder(PixRequestLog.Log{}),
+	"pix-reversal.in":                 LogDecoder(PixReversalLog.Log{}),
+	"pix-reversal.out":                LogDecoder(PixReversalLog.Log{}),
+}}
+
+func Register(subscription string, decoder Decoder) {
+	//	Register the Decoder used by ParseLog for the Events of a subscription
+	//
+	//	Lets applications decode the logs of subscriptions this SDK version does
+	//	not know yet, or replace the decoder of a known one. A replacing decoder
+	//	must return the same log type, since the typed Handler callbacks refuse
+	//	other types with a LogTypeError. Safe to call from multiple goroutines,
+	//	but usually done once at startup.
+	//
+	//	Parameters (required):
+	//	- subscription [string]: Service that triggers the Events. ex: "pix-request.in"
+	//	- decoder [Decoder]: Function that decodes the Event logs. ex: event.LogDecoder(MyLog{})
+	decoders.Lock()
+	defer decoders.Unlock()
+	decoders.bySubscription[subscription] = decoder
+}
+
+func Subscriptions() []string {
+	//	List the subscriptions with a registered Decoder
+	//
+	//	Return:
+	//	- sorted slice of subscriptions. ex: []string{"business-attachment", "business-identity", ...}
+	decoders.RLock()
+	defer decoders.RUnlock()
+	var subscriptions []string
+	for subscription := range decoders.bySubscription {
+		subscriptions = append(subscriptions, subscription)
+	}
+	sort.Strings(subscriptions)
+	return subscriptions
+}
+
+func LogDecoder(log interface{}) Decoder {
+	//	Create a Decoder that decodes Event logs into structs of the same type as log
+	//
+	//	Parameters (required):
+	//	- log [struct]: Zero value of the log struct. ex: PixRequestLog.Log{}
+	//
+	//	Return:
+	//	- Decoder returning values of the same type as log, not pointers to them
+	logType := reflect.TypeOf(log)
+	return func(api *utils.Client, content []byte) (interface{}, Error.StarkErrors) {
+		decoded := reflect.New(logType)
+		err := api.Unmarshal(content, decoded.Interface())
+		if err.Errors != nil {
+			return nil, err
+		}
+		return decoded.Elem().Interface(), Error.StarkErrors{}
+	}
+}
+
+func decoderOf(subscription string) (Decoder, bool) {
+	decoders.RLock()
+	defer decoders.RUnlock()
+	decoder, ok := decoders.bySubscription[subscription]
+	return decoder, ok
+}
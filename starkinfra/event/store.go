@@ -0,0 +1,129 @@
+package event
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"strings"
+	"sync"
+)
+
+//	Event Store interface
+//
+//	Keeps the ids of the Events handled by a Processor. Implementations must
+//	be safe for concurrent use. Back it with your database to share it between
+//	instances of your application.
+//
+//	Methods:
+//	- Has(id string) (bool, error): Tell whether the Event was handled
+//	- Add(id string) error: Record the Event as handled
+
+type Store interface {
+	Has(id string) (bool, error)
+	Add(id string) error
+}
+
+//	Event MemoryStore struct
+//
+//	Store keeping the Event ids in memory. The ids are lost when the
+//	application stops and are never removed while it runs.
+
+type MemoryStore struct {
+	mutex sync.Mutex
+	ids   map[string]bool
+}
+
+func NewMemoryStore() *MemoryStore {
+	//	Create an empty MemoryStore
+	return &MemoryStore{ids: map[string]bool{}}
+}
+
+func (s *MemoryStore) Has(id string) (bool, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.ids[id], nil
+}
+
+func (s *MemoryStore) Add(id string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.ids[id] = true
+	return nil
+}
+
+//	Event FileStore struct
+//
+//	Store keeping the Event ids in a file, one per line, so they survive
+//	restarts. Each id is written to disk before Add returns. The file is
+//	read once by NewFileStore and must not be shared between processes.
+//	Delivery is at least once: an Event whose id was not fully written when
+//	the application stopped is handled again, so handlers must be idempotent.
+
+type FileStore struct {
+	mutex sync.Mutex
+	file  *os.File
+	ids   map[string]bool
+}
+
+func NewFileStore(path string) (*FileStore, error) {
+	//	Open a FileStore, creating its file if needed
+	//
+	//	Parameters (required):
+	//	- path [string]: Path of the file keeping the Event ids. ex: "handled-events.txt"
+	//
+	//	Return:
+	//	- FileStore with the ids already in the file. Close it when done
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, err
+	}
+	store := &FileStore{file: file, ids: map[string]bool{}}
+	reader := bufio.NewReader(file)
+	var size int64
+	for {
+		line, err := reader.ReadString('\n')
+		if err == io.EOF {
+			// a line with no newline is a write interrupted by a crash after its Event was handled, so it is dropped and the Event is handled again
+			err = file.Truncate(size)
+			if err == nil {
+				return store, nil
+			}
+		}
+		if err != nil {
+			file.Close()
+			return nil, err
+		}
+		size += int64(len(line))
+		if id := strings.TrimSpace(line); id != "" {
+			store.ids[id] = true
+		}
+	}
+}
+
+func (s *FileStore) Has(id string) (bool, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.ids[id], nil
+}
+
+func (s *FileStore) Add(id string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.ids[id] {
+		return nil
+	}
+	_, err := s.file.WriteString(id + "\n")
+	if err == nil {
+		err = s.file.Sync()
+	}
+	if err != nil {
+		return err
+	}
+	s.ids[id] = true
+	return nil
+}
+
+func (s *FileStore) Close() error {
+	//	Close the file of the FileStore
+	return s.file.Close()
+}
@@ -1,6 +1,7 @@
 package individualdocument
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	Error "github.com/starkinfra/core-go/starkcore/error"
@@ -24,20 +25,31 @@ import (
 //	- Id [string]: Unique id returned when the document is created. ex: "5656565656565656"
 //	- Status [string]: current status of the IndividualDocument. Options: "created", "canceled", "processing", "failed", "success"
 //	- Created [time.Time]: creation datetime for the IndividualDocument. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC),
+//	- Extra [map[string]json.RawMessage]: Fields of the IndividualDocument sent by the API but not declared in this SDK version yet, keyed by their JSON name. ex: map[string]json.RawMessage{"newField": json.RawMessage(`"value"`)}
 
 type IndividualDocument struct {
-	Type        string     `json:",omitempty"`
-	Content     string     `json:",omitempty"`
-	ContentType string     `json:",omitempty"`
-	IdentityId  string     `json:",omitempty"`
-	Tags        []string   `json:",omitempty"`
-	Id          string     `json:",omitempty"`
-	Status      string     `json:",omitempty"`
-	Created     *time.Time `json:",omitempty"`
+	Type        string                     `json:",omitempty"`
+	Content     string                     `json:",omitempty"`
+	ContentType string                     `json:",omitempty"`
+	IdentityId  string                     `json:",omitempty"`
+	Tags        []string                   `json:",omitempty"`
+	Id          string                     `json:",omitempty"`
+	Status      string                     `json:",omitempty"`
+	Created     *time.Time                 `json:",omitempty"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 var resource = map[string]string{"name": "IndividualDocument"}
 
+//	IndividualDocument Client struct
+//
+//	Runs the IndividualDocument functions with the settings of its own utils.Client
+//	instead of starkinfra.User and the other package defaults.
+
+type Client struct {
+	Api *utils.Client
+}
+
 func Create(documents []IndividualDocument, user user.User) ([]IndividualDocument, Error.StarkErrors) {
 	//	Create IndividualDocuments
 	//
@@ -51,17 +63,34 @@ func Create(documents []IndividualDocument, user user.User) ([]IndividualDocumen
 	//
 	//	Return:
 	//	- slice of IndividualDocument struct with updated attributes
+	return Client{Api: utils.Default(user)}.Create(documents)
+}
+
+func CreateCtx(ctx context.Context, documents []IndividualDocument, user user.User) ([]IndividualDocument, Error.StarkErrors) {
+	//	Create IndividualDocuments
+	//
+	//	Same as Create, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.CreateCtx(ctx, documents)
+}
+
+func (c Client) Create(documents []IndividualDocument) ([]IndividualDocument, Error.StarkErrors) {
+	//	Create IndividualDocuments
+	return c.CreateCtx(context.Background(), documents)
+}
+
+func (c Client) CreateCtx(ctx context.Context, documents []IndividualDocument) ([]IndividualDocument, Error.StarkErrors) {
+	//	Create IndividualDocuments
 	for i := 0; i < len(documents); i++ {
 		if documents[i].ContentType != "" {
 			documents[i].Content = fmt.Sprintf("data:%s;base64,%s", documents[i].ContentType, documents[i].Content)
 			documents[i].ContentType = ""
 		}
 	}
-	create, err := utils.Multi(resource, documents, nil, user)
-	unmarshalError := json.Unmarshal(create, &documents)
-	if unmarshalError != nil {
+	create, err := c.Api.Multi(ctx, resource, documents, nil)
+	if err.Errors != nil {
 		return documents, err
 	}
+	err = c.Api.Unmarshal(create, &documents)
 	return documents, err
 }
 
@@ -78,12 +107,29 @@ func Get(id string, user user.User) (IndividualDocument, Error.StarkErrors) {
 	//
 	//	Return:
 	//	- individualDocument struct that corresponds to the given id.
+	return Client{Api: utils.Default(user)}.Get(id)
+}
+
+func GetCtx(ctx context.Context, id string, user user.User) (IndividualDocument, Error.StarkErrors) {
+	//	Retrieve a specific IndividualDocument by its id
+	//
+	//	Same as Get, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.GetCtx(ctx, id)
+}
+
+func (c Client) Get(id string) (IndividualDocument, Error.StarkErrors) {
+	//	Retrieve a specific IndividualDocument by its id
+	return c.GetCtx(context.Background(), id)
+}
+
+func (c Client) GetCtx(ctx context.Context, id string) (IndividualDocument, Error.StarkErrors) {
+	//	Retrieve a specific IndividualDocument by its id
 	var individualDocument IndividualDocument
-	get, err := utils.Get(resource, id, nil, user)
-	unmarshalError := json.Unmarshal(get, &individualDocument)
-	if unmarshalError != nil {
+	get, err := c.Api.Get(ctx, resource, id, nil)
+	if err.Errors != nil {
 		return individualDocument, err
 	}
+	err = c.Api.Unmarshal(get, &individualDocument)
 	return individualDocument, err
 }
 
@@ -104,29 +150,133 @@ func Query(params map[string]interface{}, user user.User) (chan IndividualDocume
 	//
 	//	Return:
 	//	- channel of IndividualDocument structs with updated attributes
-	var individualDocument IndividualDocument
+	return Client{Api: utils.Default(user)}.Query(params)
+}
+
+func QueryCtx(ctx context.Context, params map[string]interface{}, user user.User) (chan IndividualDocument, chan Error.StarkErrors) {
+	//	Retrieve IndividualDocuments
+	//
+	//	Same as Query, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.QueryCtx(ctx, params)
+}
+
+func (c Client) Query(params map[string]interface{}) (chan IndividualDocument, chan Error.StarkErrors) {
+	//	Retrieve IndividualDocuments
+	return c.QueryCtx(context.Background(), params)
+}
+
+func (c Client) QueryCtx(ctx context.Context, params map[string]interface{}) (chan IndividualDocument, chan Error.StarkErrors) {
+	//	Retrieve IndividualDocuments
 	documents := make(chan IndividualDocument)
 	documentsError := make(chan Error.StarkErrors)
-	query, errorChannel := utils.Query(resource, params, user)
+	query, errorChannel := c.Api.Stream(ctx, resource, params)
 	go func() {
+		defer close(documentsError)
+		defer close(documents)
 		for content := range query {
-			contentByte, _ := json.Marshal(content)
-			err := json.Unmarshal(contentByte, &individualDocument)
-			if err != nil {
-				documentsError <- Error.UnknownError(err.Error())
+			var individualDocument IndividualDocument
+			err := c.Api.Unmarshal(content, &individualDocument)
+			if err.Errors != nil {
+				if !utils.SendError(ctx, documentsError, err) {
+					return
+				}
 				continue
 			}
-			documents <- individualDocument
+			select {
+			case documents <- individualDocument:
+			case <-ctx.Done():
+				return
+			}
 		}
 		for err := range errorChannel {
-			documentsError <- err
+			if !utils.SendError(ctx, documentsError, err) {
+				return
+			}
 		}
-		close(documents)
-		close(documentsError)
 	}()
 	return documents, documentsError
 }
 
+//	IndividualDocument Iterator struct
+//
+//	Returned by Iterate to walk through IndividualDocument structs one at a time,
+//	requesting a new page only once the current one is exhausted. Check Err
+//	once Next returns false and Close it when leaving the loop early.
+
+type Iterator struct {
+	api   *utils.Client
+	pages *utils.Iterator
+	value IndividualDocument
+	err   Error.StarkErrors
+}
+
+func Iterate(params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve IndividualDocuments
+	//
+	//	Same as Query, but returns an Iterator instead of a pair of channels
+	//
+	//	Example:
+	//	iterator := Iterate(params, nil)
+	//	defer iterator.Close()
+	//	for iterator.Next() {
+	//		fmt.Println(iterator.Value())
+	//	}
+	//	if err := iterator.Err(); err.Errors != nil {
+	//		...
+	//	}
+	return Client{Api: utils.Default(user)}.Iterate(params)
+}
+
+func IterateCtx(ctx context.Context, params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve IndividualDocuments
+	//
+	//	Same as Iterate, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.IterateCtx(ctx, params)
+}
+
+func (c Client) Iterate(params map[string]interface{}) *Iterator {
+	//	Retrieve IndividualDocuments
+	return c.IterateCtx(context.Background(), params)
+}
+
+func (c Client) IterateCtx(ctx context.Context, params map[string]interface{}) *Iterator {
+	//	Retrieve IndividualDocuments
+	return &Iterator{api: c.Api, pages: c.Api.Iterate(ctx, resource, params)}
+}
+
+func (i *Iterator) Next() bool {
+	//	Move to the next IndividualDocument, returning false when there are no more or a request failed
+	var value IndividualDocument
+	if i.err.Errors != nil || !i.pages.Next() {
+		return false
+	}
+	i.err = i.api.Unmarshal(i.pages.Value(), &value)
+	if i.err.Errors != nil {
+		i.pages.Close()
+		return false
+	}
+	i.value = value
+	return true
+}
+
+func (i *Iterator) Value() IndividualDocument {
+	//	Current IndividualDocument, set by the last call to Next
+	return i.value
+}
+
+func (i *Iterator) Err() Error.StarkErrors {
+	//	Error that stopped the iteration, if any
+	if i.err.Errors != nil {
+		return i.err
+	}
+	return i.pages.Err()
+}
+
+func (i *Iterator) Close() {
+	//	Stop the iteration, releasing the current page
+	i.pages.Close()
+}
+
 func Page(params map[string]interface{}, user user.User) ([]IndividualDocument, string, Error.StarkErrors) {
 	//	Retrieve paged IndividualDocument structs
 	//
@@ -147,11 +297,28 @@ func Page(params map[string]interface{}, user user.User) ([]IndividualDocument,
 	//	Return:
 	//	- slice of IndividualDocument structs with updated attributes
 	//	- cursor to retrieve the next page of IndividualDocument structs
+	return Client{Api: utils.Default(user)}.Page(params)
+}
+
+func PageCtx(ctx context.Context, params map[string]interface{}, user user.User) ([]IndividualDocument, string, Error.StarkErrors) {
+	//	Retrieve paged IndividualDocument structs
+	//
+	//	Same as Page, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.PageCtx(ctx, params)
+}
+
+func (c Client) Page(params map[string]interface{}) ([]IndividualDocument, string, Error.StarkErrors) {
+	//	Retrieve paged IndividualDocument structs
+	return c.PageCtx(context.Background(), params)
+}
+
+func (c Client) PageCtx(ctx context.Context, params map[string]interface{}) ([]IndividualDocument, string, Error.StarkErrors) {
+	//	Retrieve paged IndividualDocument structs
 	var individualDocuments []IndividualDocument
-	page, cursor, err := utils.Page(resource, params, user)
-	unmarshalError := json.Unmarshal(page, &individualDocuments)
-	if unmarshalError != nil {
+	page, cursor, err := c.Api.Page(ctx, resource, params)
+	if err.Errors != nil {
 		return individualDocuments, cursor, err
 	}
+	err = c.Api.Unmarshal(page, &individualDocuments)
 	return individualDocuments, cursor, err
 }
@@ -1,6 +1,7 @@
 package individualidentity
 
 import (
+	"context"
 	"encoding/json"
 	Error "github.com/starkinfra/core-go/starkcore/error"
 	"github.com/starkinfra/core-go/starkcore/user/user"
@@ -31,18 +32,29 @@ import (
 //	- Id [string]: Unique id returned when the identity is created. ex: "5656565656565656"
 //	- Status [string]: current status of the IndividualIdentity. Options: "created", "canceled", "processing", "failed", "success"
 //	- Created [time.Time]: creation datetime for the IndividualIdentity. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC),
+//	- Extra [map[string]json.RawMessage]: Fields of the IndividualIdentity sent by the API but not declared in this SDK version yet, keyed by their JSON name. ex: map[string]json.RawMessage{"newField": json.RawMessage(`"value"`)}
 
 type IndividualIdentity struct {
-	Created *time.Time `json:",omitempty"`
-	Id      string     `json:",omitempty"`
-	Name    string     `json:",omitempty"`
-	Status  string     `json:",omitempty"`
-	Tags    []string   `json:",omitempty"`
-	TaxId   string     `json:",omitempty"`
+	Created *time.Time                 `json:",omitempty"`
+	Id      string                     `json:",omitempty"`
+	Name    string                     `json:",omitempty"`
+	Status  string                     `json:",omitempty"`
+	Tags    []string                   `json:",omitempty"`
+	TaxId   string                     `json:",omitempty"`
+	Extra   map[string]json.RawMessage `json:"-"`
 }
 
 var resource = map[string]string{"name": "IndividualIdentity"}
 
+//	IndividualIdentity Client struct
+//
+//	Runs the IndividualIdentity functions with the settings of its own utils.Client
+//	instead of starkinfra.User and the other package defaults.
+
+type Client struct {
+	Api *utils.Client
+}
+
 func Create(identity []IndividualIdentity, user user.User) ([]IndividualIdentity, Error.StarkErrors) {
 	//	Create IndividualIdentities
 	//
@@ -56,11 +68,28 @@ func Create(identity []IndividualIdentity, user user.User) ([]IndividualIdentity
 	//
 	//	Return:
 	//	- slice of IndividualIdentity struct with updated attributes
-	create, err := utils.Multi(resource, identity, nil, user)
-	unmarshalError := json.Unmarshal(create, &identity)
-	if unmarshalError != nil {
+	return Client{Api: utils.Default(user)}.Create(identity)
+}
+
+func CreateCtx(ctx context.Context, identity []IndividualIdentity, user user.User) ([]IndividualIdentity, Error.StarkErrors) {
+	//	Create IndividualIdentities
+	//
+	//	Same as Create, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.CreateCtx(ctx, identity)
+}
+
+func (c Client) Create(identity []IndividualIdentity) ([]IndividualIdentity, Error.StarkErrors) {
+	//	Create IndividualIdentities
+	return c.CreateCtx(context.Background(), identity)
+}
+
+func (c Client) CreateCtx(ctx context.Context, identity []IndividualIdentity) ([]IndividualIdentity, Error.StarkErrors) {
+	//	Create IndividualIdentities
+	create, err := c.Api.Multi(ctx, resource, identity, nil)
+	if err.Errors != nil {
 		return identity, err
 	}
+	err = c.Api.Unmarshal(create, &identity)
 	return identity, err
 }
 
@@ -77,12 +106,29 @@ func Get(id string, user user.User) (IndividualIdentity, Error.StarkErrors) {
 	//
 	//	Return:
 	//	- IndividualIdentity struct that corresponds to the given id.
+	return Client{Api: utils.Default(user)}.Get(id)
+}
+
+func GetCtx(ctx context.Context, id string, user user.User) (IndividualIdentity, Error.StarkErrors) {
+	//	Retrieve a specific IndividualIdentity by its id
+	//
+	//	Same as Get, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.GetCtx(ctx, id)
+}
+
+func (c Client) Get(id string) (IndividualIdentity, Error.StarkErrors) {
+	//	Retrieve a specific IndividualIdentity by its id
+	return c.GetCtx(context.Background(), id)
+}
+
+func (c Client) GetCtx(ctx context.Context, id string) (IndividualIdentity, Error.StarkErrors) {
+	//	Retrieve a specific IndividualIdentity by its id
 	var individualIdentity IndividualIdentity
-	get, err := utils.Get(resource, id, nil, user)
-	unmarshalError := json.Unmarshal(get, &individualIdentity)
-	if unmarshalError != nil {
+	get, err := c.Api.Get(ctx, resource, id, nil)
+	if err.Errors != nil {
 		return individualIdentity, err
 	}
+	err = c.Api.Unmarshal(get, &individualIdentity)
 	return individualIdentity, err
 }
 
@@ -103,28 +149,132 @@ func Query(params map[string]interface{}, user user.User) (chan IndividualIdenti
 	//
 	//	Return:
 	//	- channel of IndividualIdentity structs with updated attributes
-	var individualIdentity IndividualIdentity
+	return Client{Api: utils.Default(user)}.Query(params)
+}
+
+func QueryCtx(ctx context.Context, params map[string]interface{}, user user.User) (chan IndividualIdentity, chan Error.StarkErrors) {
+	//	Retrieve IndividualIdentitys
+	//
+	//	Same as Query, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.QueryCtx(ctx, params)
+}
+
+func (c Client) Query(params map[string]interface{}) (chan IndividualIdentity, chan Error.StarkErrors) {
+	//	Retrieve IndividualIdentitys
+	return c.QueryCtx(context.Background(), params)
+}
+
+func (c Client) QueryCtx(ctx context.Context, params map[string]interface{}) (chan IndividualIdentity, chan Error.StarkErrors) {
+	//	Retrieve IndividualIdentitys
 	identities := make(chan IndividualIdentity)
 	identitiesError := make(chan Error.StarkErrors)
-	query, errorChannel := utils.Query(resource, params, user)
+	query, errorChannel := c.Api.Stream(ctx, resource, params)
 	go func() {
+		defer close(identitiesError)
+		defer close(identities)
 		for content := range query {
-			contentByte, _ := json.Marshal(content)
-			err := json.Unmarshal(contentByte, &individualIdentity)
-			if err != nil {
-				identitiesError <- Error.UnknownError(err.Error())
+			var individualIdentity IndividualIdentity
+			err := c.Api.Unmarshal(content, &individualIdentity)
+			if err.Errors != nil {
+				if !utils.SendError(ctx, identitiesError, err) {
+					return
+				}
+			}
+			select {
+			case identities <- individualIdentity:
+			case <-ctx.Done():
+				return
 			}
-			identities <- individualIdentity
 		}
 		for err := range errorChannel {
-			identitiesError <- err
+			if !utils.SendError(ctx, identitiesError, err) {
+				return
+			}
 		}
-		close(identities)
-		close(identitiesError)
 	}()
 	return identities, identitiesError
 }
 
+//	IndividualIdentity Iterator struct
+//
+//	Returned by Iterate to walk through IndividualIdentity structs one at a time,
+//	requesting a new page only once the current one is exhausted. Check Err
+//	once Next returns false and Close it when leaving the loop early.
+
+type Iterator struct {
+	api   *utils.Client
+	pages *utils.Iterator
+	value IndividualIdentity
+	err   Error.StarkErrors
+}
+
+func Iterate(params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve IndividualIdentitys
+	//
+	//	Same as Query, but returns an Iterator instead of a pair of channels
+	//
+	//	Example:
+	//	iterator := Iterate(params, nil)
+	//	defer iterator.Close()
+	//	for iterator.Next() {
+	//		fmt.Println(iterator.Value())
+	//	}
+	//	if err := iterator.Err(); err.Errors != nil {
+	//		...
+	//	}
+	return Client{Api: utils.Default(user)}.Iterate(params)
+}
+
+func IterateCtx(ctx context.Context, params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve IndividualIdentitys
+	//
+	//	Same as Iterate, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.IterateCtx(ctx, params)
+}
+
+func (c Client) Iterate(params map[string]interface{}) *Iterator {
+	//	Retrieve IndividualIdentitys
+	return c.IterateCtx(context.Background(), params)
+}
+
+func (c Client) IterateCtx(ctx context.Context, params map[string]interface{}) *Iterator {
+	//	Retrieve IndividualIdentitys
+	return &Iterator{api: c.Api, pages: c.Api.Iterate(ctx, resource, params)}
+}
+
+func (i *Iterator) Next() bool {
+	//	Move to the next IndividualIdentity, returning false when there are no more or a request failed
+	var value IndividualIdentity
+	if i.err.Errors != nil || !i.pages.Next() {
+		return false
+	}
+	i.err = i.api.Unmarshal(i.pages.Value(), &value)
+	if i.err.Errors != nil {
+		i.pages.Close()
+		return false
+	}
+	i.value = value
+	return true
+}
+
+func (i *Iterator) Value() IndividualIdentity {
+	//	Current IndividualIdentity, set by the last call to Next
+	return i.value
+}
+
+func (i *Iterator) Err() Error.StarkErrors {
+	//	Error that stopped the iteration, if any
+	if i.err.Errors != nil {
+		return i.err
+	}
+	return i.pages.Err()
+}
+
+func (i *Iterator) Close() {
+	//	Stop the iteration, releasing the current page
+	i.pages.Close()
+}
+
 func Page(params map[string]interface{}, user user.User) ([]IndividualIdentity, string, Error.StarkErrors) {
 	//	Retrieve paged IndividualIdentity structs
 	//
@@ -145,12 +295,29 @@ func Page(params map[string]interface{}, user user.User) ([]IndividualIdentity,
 	//	Return:
 	//	- slice of IndividualIdentity structs with updated attributes
 	//	- cursor to retrieve the next page of IndividualIdentity structs
+	return Client{Api: utils.Default(user)}.Page(params)
+}
+
+func PageCtx(ctx context.Context, params map[string]interface{}, user user.User) ([]IndividualIdentity, string, Error.StarkErrors) {
+	//	Retrieve paged IndividualIdentity structs
+	//
+	//	Same as Page, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.PageCtx(ctx, params)
+}
+
+func (c Client) Page(params map[string]interface{}) ([]IndividualIdentity, string, Error.StarkErrors) {
+	//	Retrieve paged IndividualIdentity structs
+	return c.PageCtx(context.Background(), params)
+}
+
+func (c Client) PageCtx(ctx context.Context, params map[string]interface{}) ([]IndividualIdentity, string, Error.StarkErrors) {
+	//	Retrieve paged IndividualIdentity structs
 	var individualIdentities []IndividualIdentity
-	page, cursor, err := utils.Page(resource, params, user)
-	unmarshalError := json.Unmarshal(page, &individualIdentities)
-	if unmarshalError != nil {
+	page, cursor, err := c.Api.Page(ctx, resource, params)
+	if err.Errors != nil {
 		return individualIdentities, cursor, err
 	}
+	err = c.Api.Unmarshal(page, &individualIdentities)
 	return individualIdentities, cursor, err
 }
 
@@ -168,14 +335,31 @@ func Update(id string, status string, user user.User) (IndividualIdentity, Error
 	//
 	//	Return:
 	//	- target IndividualIdentity with updated attributes
+	return Client{Api: utils.Default(user)}.Update(id, status)
+}
+
+func UpdateCtx(ctx context.Context, id string, status string, user user.User) (IndividualIdentity, Error.StarkErrors) {
+	//	Update an IndividualIdentity entity
+	//
+	//	Same as Update, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.UpdateCtx(ctx, id, status)
+}
+
+func (c Client) Update(id string, status string) (IndividualIdentity, Error.StarkErrors) {
+	//	Update an IndividualIdentity entity
+	return c.UpdateCtx(context.Background(), id, status)
+}
+
+func (c Client) UpdateCtx(ctx context.Context, id string, status string) (IndividualIdentity, Error.StarkErrors) {
+	//	Update an IndividualIdentity entity
 	var individualIdentity IndividualIdentity
 	patchData := map[string]interface{}{}
 	patchData["status"] = status
-	update, err := utils.Patch(resource, id, patchData, user)
-	unmarshalError := json.Unmarshal(update, &individualIdentity)
-	if unmarshalError != nil {
+	update, err := c.Api.Patch(ctx, resource, id, patchData)
+	if err.Errors != nil {
 		return individualIdentity, err
 	}
+	err = c.Api.Unmarshal(update, &individualIdentity)
 	return individualIdentity, err
 }
 
@@ -192,11 +376,28 @@ func Cancel(id string, user user.User) (IndividualIdentity, Error.StarkErrors) {
 	//
 	//	Return:
 	//	- canceled IndividualIdentity struct
+	return Client{Api: utils.Default(user)}.Cancel(id)
+}
+
+func CancelCtx(ctx context.Context, id string, user user.User) (IndividualIdentity, Error.StarkErrors) {
+	//	Cancel an IndividualIdentity entity
+	//
+	//	Same as Cancel, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.CancelCtx(ctx, id)
+}
+
+func (c Client) Cancel(id string) (IndividualIdentity, Error.StarkErrors) {
+	//	Cancel an IndividualIdentity entity
+	return c.CancelCtx(context.Background(), id)
+}
+
+func (c Client) CancelCtx(ctx context.Context, id string) (IndividualIdentity, Error.StarkErrors) {
+	//	Cancel an IndividualIdentity entity
 	var individualIdentity IndividualIdentity
-	cancel, err := utils.Delete(resource, id, user)
-	unmarshalError := json.Unmarshal(cancel, &individualIdentity)
-	if unmarshalError != nil {
+	cancel, err := c.Api.Delete(ctx, resource, id)
+	if err.Errors != nil {
 		return individualIdentity, err
 	}
+	err = c.Api.Unmarshal(cancel, &individualIdentity)
 	return individualIdentity, err
 }
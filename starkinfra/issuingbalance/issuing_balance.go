@@ -1,6 +1,7 @@
 package issuingbalance
 
 import (
+	"context"
 	"encoding/json"
 	Error "github.com/starkinfra/core-go/starkcore/error"
 	"github.com/starkinfra/core-go/starkcore/user/user"
@@ -22,18 +23,29 @@ import (
 //	- MaxLimit [int]: Maximum spending limit. This field is currently always equal to limit. ex: 1000 (= R$ 10.00)
 //	- Currency [string]: Currency of the current Workspace. Expect others to be added eventually. ex: "BRL"
 //	- Updated [string]: Latest update datetime for the IssuingBalance. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC),
+//	- Extra [map[string]json.RawMessage]: Fields of the IssuingBalance sent by the API but not declared in this SDK version yet, keyed by their JSON name. ex: map[string]json.RawMessage{"newField": json.RawMessage(`"value"`)}
 
 type IssuingBalance struct {
-	Id       string     `json:",omitempty"`
-	Amount   int        `json:",omitempty"`
-	Limit    int        `json:",omitempty"`
-	MaxLimit int        `json:",omitempty"`
-	Currency string     `json:",omitempty"`
-	Updated  *time.Time `json:",omitempty"`
+	Id       string                     `json:",omitempty"`
+	Amount   int                        `json:",omitempty"`
+	Limit    int                        `json:",omitempty"`
+	MaxLimit int                        `json:",omitempty"`
+	Currency string                     `json:",omitempty"`
+	Updated  *time.Time                 `json:",omitempty"`
+	Extra    map[string]json.RawMessage `json:"-"`
 }
 
 var resource = map[string]string{"name": "IssuingBalance"}
 
+//	IssuingBalance Client struct
+//
+//	Runs the IssuingBalance functions with the settings of its own utils.Client
+//	instead of starkinfra.User and the other package defaults.
+
+type Client struct {
+	Api *utils.Client
+}
+
 func Get(user user.User) (IssuingBalance, Error.StarkErrors) {
 	//	Retrieve the IssuingBalance struct
 	//
@@ -44,25 +56,29 @@ func Get(user user.User) (IssuingBalance, Error.StarkErrors) {
 	//
 	//	Return:
 	//	- IssuingBalance struct with updated attributes
+	return Client{Api: utils.Default(user)}.Get()
+}
+
+func GetCtx(ctx context.Context, user user.User) (IssuingBalance, Error.StarkErrors) {
+	//	Retrieve the IssuingBalance struct
+	//
+	//	Same as Get, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.GetCtx(ctx)
+}
+
+func (c Client) Get() (IssuingBalance, Error.StarkErrors) {
+	//	Retrieve the IssuingBalance struct
+	return c.GetCtx(context.Background())
+}
+
+func (c Client) GetCtx(ctx context.Context) (IssuingBalance, Error.StarkErrors) {
+	//	Retrieve the IssuingBalance struct
 	var issuingBalance IssuingBalance
-	balance := make(chan IssuingBalance)
-	balanceError := make(chan Error.StarkErrors)
-	query, errorChannel := utils.Query(resource, nil, user)
-	go func() {
-		for content := range query {
-			contentByte, _ := json.Marshal(content)
-			err := json.Unmarshal(contentByte, &issuingBalance)
-			if err != nil {
-				balanceError <- Error.UnknownError(err.Error())
-				continue
-			}
-			balance <- issuingBalance
-		}
-		for err := range errorChannel {
-			balanceError <- err
-		}
-		close(balance)
-		close(balanceError)
-	}()
-	return <-balance, <-balanceError
+	balances := c.Api.Iterate(ctx, resource, nil)
+	defer balances.Close()
+	if !balances.Next() {
+		return issuingBalance, balances.Err()
+	}
+	err := c.Api.Unmarshal(balances.Value(), &issuingBalance)
+	return issuingBalance, err
 }
@@ -1,6 +1,7 @@
 package issuingbillinginvoice
 
 import (
+	"context"
 	"encoding/json"
 	Error "github.com/starkinfra/core-go/starkcore/error"
 	"github.com/starkinfra/core-go/starkcore/user/user"
@@ -28,27 +29,38 @@ import (
 //	- End [time.Time]: billing cycle end datetime. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC)
 //	- Created [time.Time]: creation datetime for the IssuingBillingInvoice. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC)
 //	- Updated [time.Time]: latest update datetime for the IssuingBillingInvoice. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC)
+//	- Extra [map[string]json.RawMessage]: Fields of the IssuingBillingInvoice sent by the API but not declared in this SDK version yet, keyed by their JSON name. ex: map[string]json.RawMessage{"newField": json.RawMessage(`"value"`)}
 
 type IssuingBillingInvoice struct {
-	Id            string     `json:",omitempty"`
-	TaxId         string     `json:",omitempty"`
-	Name          string     `json:",omitempty"`
-	Fine          float64    `json:",omitempty"`
-	Interest      float64    `json:",omitempty"`
-	Status        string     `json:",omitempty"`
-	Amount        int        `json:",omitempty"`
-	NominalAmount int        `json:",omitempty"`
-	Brcode        string     `json:",omitempty"`
-	Link          string     `json:",omitempty"`
-	Due           *time.Time `json:",omitempty"`
-	Start         *time.Time `json:",omitempty"`
-	End           *time.Time `json:",omitempty"`
-	Created       *time.Time `json:",omitempty"`
-	Updated       *time.Time `json:",omitempty"`
+	Id            string                     `json:",omitempty"`
+	TaxId         string                     `json:",omitempty"`
+	Name          string                     `json:",omitempty"`
+	Fine          float64                    `json:",omitempty"`
+	Interest      float64                    `json:",omitempty"`
+	Status        string                     `json:",omitempty"`
+	Amount        int                        `json:",omitempty"`
+	NominalAmount int                        `json:",omitempty"`
+	Brcode        string                     `json:",omitempty"`
+	Link          string                     `json:",omitempty"`
+	Due           *time.Time                 `json:",omitempty"`
+	Start         *time.Time                 `json:",omitempty"`
+	End           *time.Time                 `json:",omitempty"`
+	Created       *time.Time                 `json:",omitempty"`
+	Updated       *time.Time                 `json:",omitempty"`
+	Extra         map[string]json.RawMessage `json:"-"`
 }
 
 var resource = map[string]string{"name": "IssuingBillingInvoice"}
 
+//	IssuingBillingInvoice Client struct
+//
+//	Runs the IssuingBillingInvoice functions with the settings of its own utils.Client
+//	instead of starkinfra.User and the other package defaults.
+
+type Client struct {
+	Api *utils.Client
+}
+
 func Get(id string, user user.User) (IssuingBillingInvoice, Error.StarkErrors) {
 	//	Retrieve a specific IssuingBillingInvoice by its id
 	//
@@ -62,12 +74,29 @@ func Get(id string, user user.User) (IssuingBillingInvoice, Error.StarkErrors) {
 	//
 	//	Return:
 	//	- issuingBillingInvoice struct that corresponds to the given id.
+	return Client{Api: utils.Default(user)}.Get(id)
+}
+
+func GetCtx(ctx context.Context, id string, user user.User) (IssuingBillingInvoice, Error.StarkErrors) {
+	//	Retrieve a specific IssuingBillingInvoice by its id
+	//
+	//	Same as Get, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.GetCtx(ctx, id)
+}
+
+func (c Client) Get(id string) (IssuingBillingInvoice, Error.StarkErrors) {
+	//	Retrieve a specific IssuingBillingInvoice by its id
+	return c.GetCtx(context.Background(), id)
+}
+
+func (c Client) GetCtx(ctx context.Context, id string) (IssuingBillingInvoice, Error.StarkErrors) {
+	//	Retrieve a specific IssuingBillingInvoice by its id
 	var issuingBillingInvoice IssuingBillingInvoice
-	get, err := utils.Get(resource, id, nil, user)
-	unmarshalError := json.Unmarshal(get, &issuingBillingInvoice)
-	if unmarshalError != nil {
+	get, err := c.Api.Get(ctx, resource, id, nil)
+	if err.Errors != nil {
 		return issuingBillingInvoice, err
 	}
+	err = c.Api.Unmarshal(get, &issuingBillingInvoice)
 	return issuingBillingInvoice, err
 }
 
@@ -88,29 +117,133 @@ func Query(params map[string]interface{}, user user.User) (chan IssuingBillingIn
 	//
 	//	Return:
 	//	- channel of IssuingBillingInvoice structs with updated attributes
-	var issuingBillingInvoice IssuingBillingInvoice
+	return Client{Api: utils.Default(user)}.Query(params)
+}
+
+func QueryCtx(ctx context.Context, params map[string]interface{}, user user.User) (chan IssuingBillingInvoice, chan Error.StarkErrors) {
+	//	Retrieve IssuingBillingInvoices
+	//
+	//	Same as Query, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.QueryCtx(ctx, params)
+}
+
+func (c Client) Query(params map[string]interface{}) (chan IssuingBillingInvoice, chan Error.StarkErrors) {
+	//	Retrieve IssuingBillingInvoices
+	return c.QueryCtx(context.Background(), params)
+}
+
+func (c Client) QueryCtx(ctx context.Context, params map[string]interface{}) (chan IssuingBillingInvoice, chan Error.StarkErrors) {
+	//	Retrieve IssuingBillingInvoices
 	invoices := make(chan IssuingBillingInvoice)
 	invoicesError := make(chan Error.StarkErrors)
-	query, errorChannel := utils.Query(resource, params, user)
+	query, errorChannel := c.Api.Stream(ctx, resource, params)
 	go func() {
+		defer close(invoicesError)
+		defer close(invoices)
 		for content := range query {
-			contentByte, _ := json.Marshal(content)
-			err := json.Unmarshal(contentByte, &issuingBillingInvoice)
-			if err != nil {
-				invoicesError <- Error.UnknownError(err.Error())
+			var issuingBillingInvoice IssuingBillingInvoice
+			err := c.Api.Unmarshal(content, &issuingBillingInvoice)
+			if err.Errors != nil {
+				if !utils.SendError(ctx, invoicesError, err) {
+					return
+				}
 				continue
 			}
-			invoices <- issuingBillingInvoice
+			select {
+			case invoices <- issuingBillingInvoice:
+			case <-ctx.Done():
+				return
+			}
 		}
 		for err := range errorChannel {
-			invoicesError <- err
+			if !utils.SendError(ctx, invoicesError, err) {
+				return
+			}
 		}
-		close(invoices)
-		close(invoicesError)
 	}()
 	return invoices, invoicesError
 }
 
+//	IssuingBillingInvoice Iterator struct
+//
+//	Returned by Iterate to walk through IssuingBillingInvoice structs one at a time,
+//	requesting a new page only once the current one is exhausted. Check Err
+//	once Next returns false and Close it when leaving the loop early.
+
+type Iterator struct {
+	api   *utils.Client
+	pages *utils.Iterator
+	value IssuingBillingInvoice
+	err   Error.StarkErrors
+}
+
+func Iterate(params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve IssuingBillingInvoices
+	//
+	//	Same as Query, but returns an Iterator instead of a pair of channels
+	//
+	//	Example:
+	//	iterator := Iterate(params, nil)
+	//	defer iterator.Close()
+	//	for iterator.Next() {
+	//		fmt.Println(iterator.Value())
+	//	}
+	//	if err := iterator.Err(); err.Errors != nil {
+	//		...
+	//	}
+	return Client{Api: utils.Default(user)}.Iterate(params)
+}
+
+func IterateCtx(ctx context.Context, params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve IssuingBillingInvoices
+	//
+	//	Same as Iterate, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.IterateCtx(ctx, params)
+}
+
+func (c Client) Iterate(params map[string]interface{}) *Iterator {
+	//	Retrieve IssuingBillingInvoices
+	return c.IterateCtx(context.Background(), params)
+}
+
+func (c Client) IterateCtx(ctx context.Context, params map[string]interface{}) *Iterator {
+	//	Retrieve IssuingBillingInvoices
+	return &Iterator{api: c.Api, pages: c.Api.Iterate(ctx, resource, params)}
+}
+
+func (i *Iterator) Next() bool {
+	//	Move to the next IssuingBillingInvoice, returning false when there are no more or a request failed
+	var value IssuingBillingInvoice
+	if i.err.Errors != nil || !i.pages.Next() {
+		return false
+	}
+	i.err = i.api.Unmarshal(i.pages.Value(), &value)
+	if i.err.Errors != nil {
+		i.pages.Close()
+		return false
+	}
+	i.value = value
+	return true
+}
+
+func (i *Iterator) Value() IssuingBillingInvoice {
+	//	Current IssuingBillingInvoice, set by the last call to Next
+	return i.value
+}
+
+func (i *Iterator) Err() Error.StarkErrors {
+	//	Error that stopped the iteration, if any
+	if i.err.Errors != nil {
+		return i.err
+	}
+	return i.pages.Err()
+}
+
+func (i *Iterator) Close() {
+	//	Stop the iteration, releasing the current page
+	i.pages.Close()
+}
+
 func Page(params map[string]interface{}, user user.User) ([]IssuingBillingInvoice, string, Error.StarkErrors) {
 	//	Retrieve IssuingBillingInvoices
 	//
@@ -131,11 +264,28 @@ func Page(params map[string]interface{}, user user.User) ([]IssuingBillingInvoic
 	//	Return:
 	//	- slice of IssuingBillingInvoice structs with updated attributes
 	//	- cursor to retrieve the next page of IssuingBillingInvoice structs
+	return Client{Api: utils.Default(user)}.Page(params)
+}
+
+func PageCtx(ctx context.Context, params map[string]interface{}, user user.User) ([]IssuingBillingInvoice, string, Error.StarkErrors) {
+	//	Retrieve IssuingBillingInvoices
+	//
+	//	Same as Page, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.PageCtx(ctx, params)
+}
+
+func (c Client) Page(params map[string]interface{}) ([]IssuingBillingInvoice, string, Error.StarkErrors) {
+	//	Retrieve IssuingBillingInvoices
+	return c.PageCtx(context.Background(), params)
+}
+
+func (c Client) PageCtx(ctx context.Context, params map[string]interface{}) ([]IssuingBillingInvoice, string, Error.StarkErrors) {
+	//	Retrieve IssuingBillingInvoices
 	var issuingBillingInvoices []IssuingBillingInvoice
-	page, cursor, err := utils.Page(resource, params, user)
-	unmarshalError := json.Unmarshal(page, &issuingBillingInvoices)
-	if unmarshalError != nil {
+	page, cursor, err := c.Api.Page(ctx, resource, params)
+	if err.Errors != nil {
 		return issuingBillingInvoices, cursor, err
 	}
+	err = c.Api.Unmarshal(page, &issuingBillingInvoices)
 	return issuingBillingInvoices, cursor, err
 }
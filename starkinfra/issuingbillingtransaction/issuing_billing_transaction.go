@@ -1,6 +1,7 @@
 package issuingbillingtransaction
 
 import (
+	"context"
 	"encoding/json"
 	Error "github.com/starkinfra/core-go/starkcore/error"
 	"github.com/starkinfra/core-go/starkcore/user/user"
@@ -29,28 +30,39 @@ import (
 //	- MerchantAmount [int]: merchant amount in cents. ex: 1234 (= R$ 12.34)
 //	- MerchantCurrencyCode [string]: merchant currency code in ISO 4217 format. ex: "USD"
 //	- Created [time.Time]: creation datetime for the IssuingBillingTransaction. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC)
+//	- Extra [map[string]json.RawMessage]: Fields of the IssuingBillingTransaction sent by the API but not declared in this SDK version yet, keyed by their JSON name. ex: map[string]json.RawMessage{"newField": json.RawMessage(`"value"`)}
 
 type IssuingBillingTransaction struct {
-	Id                   string     `json:",omitempty"`
-	Amount               int        `json:",omitempty"`
-	InvoiceId            string     `json:",omitempty"`
-	Installment          int        `json:",omitempty"`
-	InstallmentCount     int        `json:",omitempty"`
-	Balance              int        `json:",omitempty"`
-	HolderName           string     `json:",omitempty"`
-	Source               string     `json:",omitempty"`
-	ExternalId           string     `json:",omitempty"`
-	Description          string     `json:",omitempty"`
-	CardEnding           string     `json:",omitempty"`
-	Tax                  int        `json:",omitempty"`
-	Rate                 float64    `json:",omitempty"`
-	MerchantAmount       int        `json:",omitempty"`
-	MerchantCurrencyCode string     `json:",omitempty"`
-	Created              *time.Time `json:",omitempty"`
+	Id                   string                     `json:",omitempty"`
+	Amount               int                        `json:",omitempty"`
+	InvoiceId            string                     `json:",omitempty"`
+	Installment          int                        `json:",omitempty"`
+	InstallmentCount     int                        `json:",omitempty"`
+	Balance              int                        `json:",omitempty"`
+	HolderName           string                     `json:",omitempty"`
+	Source               string                     `json:",omitempty"`
+	ExternalId           string                     `json:",omitempty"`
+	Description          string                     `json:",omitempty"`
+	CardEnding           string                     `json:",omitempty"`
+	Tax                  int                        `json:",omitempty"`
+	Rate                 float64                    `json:",omitempty"`
+	MerchantAmount       int                        `json:",omitempty"`
+	MerchantCurrencyCode string                     `json:",omitempty"`
+	Created              *time.Time                 `json:",omitempty"`
+	Extra                map[string]json.RawMessage `json:"-"`
 }
 
 var resource = map[string]string{"name": "IssuingBillingTransaction"}
 
+//	IssuingBillingTransaction Client struct
+//
+//	Runs the IssuingBillingTransaction functions with the settings of its own utils.Client
+//	instead of starkinfra.User and the other package defaults.
+
+type Client struct {
+	Api *utils.Client
+}
+
 func Query(params map[string]interface{}, user user.User) (chan IssuingBillingTransaction, chan Error.StarkErrors) {
 	//	Retrieve IssuingBillingTransactions
 	//
@@ -68,29 +80,133 @@ func Query(params map[string]interface{}, user user.User) (chan IssuingBillingTr
 	//
 	//	Return:
 	//	- channel of IssuingBillingTransaction structs with updated attributes
-	var issuingBillingTransaction IssuingBillingTransaction
+	return Client{Api: utils.Default(user)}.Query(params)
+}
+
+func QueryCtx(ctx context.Context, params map[string]interface{}, user user.User) (chan IssuingBillingTransaction, chan Error.StarkErrors) {
+	//	Retrieve IssuingBillingTransactions
+	//
+	//	Same as Query, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.QueryCtx(ctx, params)
+}
+
+func (c Client) Query(params map[string]interface{}) (chan IssuingBillingTransaction, chan Error.StarkErrors) {
+	//	Retrieve IssuingBillingTransactions
+	return c.QueryCtx(context.Background(), params)
+}
+
+func (c Client) QueryCtx(ctx context.Context, params map[string]interface{}) (chan IssuingBillingTransaction, chan Error.StarkErrors) {
+	//	Retrieve IssuingBillingTransactions
 	transactions := make(chan IssuingBillingTransaction)
 	transactionsError := make(chan Error.StarkErrors)
-	query, errorChannel := utils.Query(resource, params, user)
+	query, errorChannel := c.Api.Stream(ctx, resource, params)
 	go func() {
+		defer close(transactionsError)
+		defer close(transactions)
 		for content := range query {
-			contentByte, _ := json.Marshal(content)
-			err := json.Unmarshal(contentByte, &issuingBillingTransaction)
-			if err != nil {
-				transactionsError <- Error.UnknownError(err.Error())
+			var issuingBillingTransaction IssuingBillingTransaction
+			err := c.Api.Unmarshal(content, &issuingBillingTransaction)
+			if err.Errors != nil {
+				if !utils.SendError(ctx, transactionsError, err) {
+					return
+				}
 				continue
 			}
-			transactions <- issuingBillingTransaction
+			select {
+			case transactions <- issuingBillingTransaction:
+			case <-ctx.Done():
+				return
+			}
 		}
 		for err := range errorChannel {
-			transactionsError <- err
+			if !utils.SendError(ctx, transactionsError, err) {
+				return
+			}
 		}
-		close(transactions)
-		close(transactionsError)
 	}()
 	return transactions, transactionsError
 }
 
+//	IssuingBillingTransaction Iterator struct
+//
+//	Returned by Iterate to walk through IssuingBillingTransaction structs one at a time,
+//	requesting a new page only once the current one is exhausted. Check Err
+//	once Next returns false and Close it when leaving the loop early.
+
+type Iterator struct {
+	api   *utils.Client
+	pages *utils.Iterator
+	value IssuingBillingTransaction
+	err   Error.StarkErrors
+}
+
+func Iterate(params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve IssuingBillingTransactions
+	//
+	//	Same as Query, but returns an Iterator instead of a pair of channels
+	//
+	//	Example:
+	//	iterator := Iterate(params, nil)
+	//	defer iterator.Close()
+	//	for iterator.Next() {
+	//		fmt.Println(iterator.Value())
+	//	}
+	//	if err := iterator.Err(); err.Errors != nil {
+	//		...
+	//	}
+	return Client{Api: utils.Default(user)}.Iterate(params)
+}
+
+func IterateCtx(ctx context.Context, params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve IssuingBillingTransactions
+	//
+	//	Same as Iterate, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.IterateCtx(ctx, params)
+}
+
+func (c Client) Iterate(params map[string]interface{}) *Iterator {
+	//	Retrieve IssuingBillingTransactions
+	return c.IterateCtx(context.Background(), params)
+}
+
+func (c Client) IterateCtx(ctx context.Context, params map[string]interface{}) *Iterator {
+	//	Retrieve IssuingBillingTransactions
+	return &Iterator{api: c.Api, pages: c.Api.Iterate(ctx, resource, params)}
+}
+
+func (i *Iterator) Next() bool {
+	//	Move to the next IssuingBillingTransaction, returning false when there are no more or a request failed
+	var value IssuingBillingTransaction
+	if i.err.Errors != nil || !i.pages.Next() {
+		return false
+	}
+	i.err = i.api.Unmarshal(i.pages.Value(), &value)
+	if i.err.Errors != nil {
+		i.pages.Close()
+		return false
+	}
+	i.value = value
+	return true
+}
+
+func (i *Iterator) Value() IssuingBillingTransaction {
+	//	Current IssuingBillingTransaction, set by the last call to Next
+	return i.value
+}
+
+func (i *Iterator) Err() Error.StarkErrors {
+	//	Error that stopped the iteration, if any
+	if i.err.Errors != nil {
+		return i.err
+	}
+	return i.pages.Err()
+}
+
+func (i *Iterator) Close() {
+	//	Stop the iteration, releasing the current page
+	i.pages.Close()
+}
+
 func Page(params map[string]interface{}, user user.User) ([]IssuingBillingTransaction, string, Error.StarkErrors) {
 	//	Retrieve paged IssuingBillingTransactions
 	//
@@ -111,11 +227,28 @@ func Page(params map[string]interface{}, user user.User) ([]IssuingBillingTransa
 	//	Return:
 	//	- slice of IssuingBillingTransaction structs with updated attributes
 	//	- cursor to retrieve the next page of IssuingBillingTransaction structs
+	return Client{Api: utils.Default(user)}.Page(params)
+}
+
+func PageCtx(ctx context.Context, params map[string]interface{}, user user.User) ([]IssuingBillingTransaction, string, Error.StarkErrors) {
+	//	Retrieve paged IssuingBillingTransactions
+	//
+	//	Same as Page, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.PageCtx(ctx, params)
+}
+
+func (c Client) Page(params map[string]interface{}) ([]IssuingBillingTransaction, string, Error.StarkErrors) {
+	//	Retrieve paged IssuingBillingTransactions
+	return c.PageCtx(context.Background(), params)
+}
+
+func (c Client) PageCtx(ctx context.Context, params map[string]interface{}) ([]IssuingBillingTransaction, string, Error.StarkErrors) {
+	//	Retrieve paged IssuingBillingTransactions
 	var issuingBillingTransactions []IssuingBillingTransaction
-	page, cursor, err := utils.Page(resource, params, user)
-	unmarshalError := json.Unmarshal(page, &issuingBillingTransactions)
-	if unmarshalError != nil {
+	page, cursor, err := c.Api.Page(ctx, resource, params)
+	if err.Errors != nil {
 		return issuingBillingTransactions, cursor, err
 	}
+	err = c.Api.Unmarshal(page, &issuingBillingTransactions)
 	return issuingBillingTransactions, cursor, err
 }
@@ -1,6 +1,7 @@
 package issuingcard
 
 import (
+	"context"
 	"encoding/json"
 	Error "github.com/starkinfra/core-go/starkcore/error"
 	"github.com/starkinfra/core-go/starkcore/user/user"
@@ -42,37 +43,46 @@ import (
 //	- Expiration [time.Time]: [EXPANDABLE] Masked card expiration datetime. Expand to unmask the value.
 //	- Updated [time.Time]: Latest update datetime for the IssuingCard. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC),
 //	- Created [time.Time]: Creation datetime for the IssuingCard. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC),
+//	- Extra [map[string]json.RawMessage]: Fields of the IssuingCard sent by the API but not declared in this SDK version yet, keyed by their JSON name. ex: map[string]json.RawMessage{"newField": json.RawMessage(`"value"`)}
 
 type IssuingCard struct {
-	HolderName       string                    `json:",omitempty"`
-	HolderTaxId      string                    `json:",omitempty"`
-	HolderExternalId string                    `json:",omitempty"`
-	DisplayName      string                    `json:",omitempty"`
-	Rules            []IssuingRule.IssuingRule `json:",omitempty"`
-	ProductId        string                    `json:",omitempty"`
-	Tags             []string                  `json:",omitempty"`
-	StreetLine1      string                    `json:",omitempty"`
-	StreetLine2      string                    `json:",omitempty"`
-	District         string                    `json:",omitempty"`
-	City             string                    `json:",omitempty"`
-	StateCode        string                    `json:",omitempty"`
-	ZipCode          string                    `json:",omitempty"`
-	Id               string                    `json:",omitempty"`
-	HolderId         string                    `json:",omitempty"`
-	Type             string                    `json:",omitempty"`
-	Status           string                    `json:",omitempty"`
-	IsPinDefined     bool                      `json:",omitempty"`
-	Number           string                    `json:",omitempty"`
-	SecurityCode     string                    `json:",omitempty"`
-	Expiration       string                    `json:",omitempty"`
-	Updated          *time.Time                `json:",omitempty"`
-	Created          *time.Time                `json:",omitempty"`
-}
-
-var object IssuingCard
-var objects []IssuingCard
+	HolderName       string                     `json:",omitempty"`
+	HolderTaxId      string                     `json:",omitempty"`
+	HolderExternalId string                     `json:",omitempty"`
+	DisplayName      string                     `json:",omitempty"`
+	Rules            []IssuingRule.IssuingRule  `json:",omitempty"`
+	ProductId        string                     `json:",omitempty"`
+	Tags             []string                   `json:",omitempty"`
+	StreetLine1      string                     `json:",omitempty"`
+	StreetLine2      string                     `json:",omitempty"`
+	District         string                     `json:",omitempty"`
+	City             string                     `json:",omitempty"`
+	StateCode        string                     `json:",omitempty"`
+	ZipCode          string                     `json:",omitempty"`
+	Id               string                     `json:",omitempty"`
+	HolderId         string                     `json:",omitempty"`
+	Type             string                     `json:",omitempty"`
+	Status           string                     `json:",omitempty"`
+	IsPinDefined     bool                       `json:",omitempty"`
+	Number           string                     `json:",omitempty"`
+	SecurityCode     string                     `json:",omitempty"`
+	Expiration       string                     `json:",omitempty"`
+	Updated          *time.Time                 `json:",omitempty"`
+	Created          *time.Time                 `json:",omitempty"`
+	Extra            map[string]json.RawMessage `json:"-"`
+}
+
 var resource = map[string]string{"name": "IssuingCard"}
 
+//	IssuingCard Client struct
+//
+//	Runs the IssuingCard functions with the settings of its own utils.Client
+//	instead of starkinfra.User and the other package defaults.
+
+type Client struct {
+	Api *utils.Client
+}
+
 func Create(cards []IssuingCard, expand map[string]interface{}, user user.User) ([]IssuingCard, Error.StarkErrors) {
 	//	Create IssuingCards
 	//
@@ -87,11 +97,28 @@ func Create(cards []IssuingCard, expand map[string]interface{}, user user.User)
 	//
 	//	Return:
 	//	- slice of IssuingCard structs with updated attributes
-	create, err := utils.Multi(resource, cards, expand, user)
-	unmarshalError := json.Unmarshal(create, &cards)
-	if unmarshalError != nil {
+	return Client{Api: utils.Default(user)}.Create(cards, expand)
+}
+
+func CreateCtx(ctx context.Context, cards []IssuingCard, expand map[string]interface{}, user user.User) ([]IssuingCard, Error.StarkErrors) {
+	//	Create IssuingCards
+	//
+	//	Same as Create, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.CreateCtx(ctx, cards, expand)
+}
+
+func (c Client) Create(cards []IssuingCard, expand map[string]interface{}) ([]IssuingCard, Error.StarkErrors) {
+	//	Create IssuingCards
+	return c.CreateCtx(context.Background(), cards, expand)
+}
+
+func (c Client) CreateCtx(ctx context.Context, cards []IssuingCard, expand map[string]interface{}) ([]IssuingCard, Error.StarkErrors) {
+	//	Create IssuingCards
+	create, err := c.Api.Multi(ctx, resource, cards, expand)
+	if err.Errors != nil {
 		return cards, err
 	}
+	err = c.Api.Unmarshal(create, &cards)
 	return cards, err
 }
 
@@ -109,11 +136,29 @@ func Get(id string, expand map[string]interface{}, user user.User) (IssuingCard,
 	//
 	// 	Return:
 	//  - IssuingCard struct that corresponds to the given id.
-	get, err := utils.Get(resource, id, expand, user)
-	unmarshalError := json.Unmarshal(get, &object)
-	if unmarshalError != nil {
+	return Client{Api: utils.Default(user)}.Get(id, expand)
+}
+
+func GetCtx(ctx context.Context, id string, expand map[string]interface{}, user user.User) (IssuingCard, Error.StarkErrors) {
+	//	Retrieve a specific IssuingCards by its id
+	//
+	//	Same as Get, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.GetCtx(ctx, id, expand)
+}
+
+func (c Client) Get(id string, expand map[string]interface{}) (IssuingCard, Error.StarkErrors) {
+	//	Retrieve a specific IssuingCards by its id
+	return c.GetCtx(context.Background(), id, expand)
+}
+
+func (c Client) GetCtx(ctx context.Context, id string, expand map[string]interface{}) (IssuingCard, Error.StarkErrors) {
+	//	Retrieve a specific IssuingCards by its id
+	var object IssuingCard
+	get, err := c.Api.Get(ctx, resource, id, expand)
+	if err.Errors != nil {
 		return object, err
 	}
+	err = c.Api.Unmarshal(get, &object)
 	return object, err
 }
 
@@ -137,28 +182,133 @@ func Query(params map[string]interface{}, user user.User) (chan IssuingCard, cha
 	//
 	//	Return:
 	//	- channel of IssuingCard structs with updated attributes
+	return Client{Api: utils.Default(user)}.Query(params)
+}
+
+func QueryCtx(ctx context.Context, params map[string]interface{}, user user.User) (chan IssuingCard, chan Error.StarkErrors) {
+	//	Retrieve IssuingCard structs
+	//
+	//	Same as Query, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.QueryCtx(ctx, params)
+}
+
+func (c Client) Query(params map[string]interface{}) (chan IssuingCard, chan Error.StarkErrors) {
+	//	Retrieve IssuingCard structs
+	return c.QueryCtx(context.Background(), params)
+}
+
+func (c Client) QueryCtx(ctx context.Context, params map[string]interface{}) (chan IssuingCard, chan Error.StarkErrors) {
+	//	Retrieve IssuingCard structs
 	cards := make(chan IssuingCard)
 	cardsError := make(chan Error.StarkErrors)
-	query, errorChannel := utils.Query(resource, params, user)
+	query, errorChannel := c.Api.Stream(ctx, resource, params)
 	go func() {
+		defer close(cardsError)
+		defer close(cards)
 		for content := range query {
-			contentByte, _ := json.Marshal(content)
-			err := json.Unmarshal(contentByte, &object)
-			if err != nil {
-				cardsError <- Error.UnknownError(err.Error())
+			var object IssuingCard
+			err := c.Api.Unmarshal(content, &object)
+			if err.Errors != nil {
+				if !utils.SendError(ctx, cardsError, err) {
+					return
+				}
 				continue
 			}
-			cards <- object
+			select {
+			case cards <- object:
+			case <-ctx.Done():
+				return
+			}
 		}
 		for err := range errorChannel {
-			cardsError <- err
+			if !utils.SendError(ctx, cardsError, err) {
+				return
+			}
 		}
-		close(cards)
-		close(cardsError)
 	}()
 	return cards, cardsError
 }
 
+//	IssuingCard Iterator struct
+//
+//	Returned by Iterate to walk through IssuingCard structs one at a time,
+//	requesting a new page only once the current one is exhausted. Check Err
+//	once Next returns false and Close it when leaving the loop early.
+
+type Iterator struct {
+	api   *utils.Client
+	pages *utils.Iterator
+	value IssuingCard
+	err   Error.StarkErrors
+}
+
+func Iterate(params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve IssuingCard structs
+	//
+	//	Same as Query, but returns an Iterator instead of a pair of channels
+	//
+	//	Example:
+	//	iterator := Iterate(params, nil)
+	//	defer iterator.Close()
+	//	for iterator.Next() {
+	//		fmt.Println(iterator.Value())
+	//	}
+	//	if err := iterator.Err(); err.Errors != nil {
+	//		...
+	//	}
+	return Client{Api: utils.Default(user)}.Iterate(params)
+}
+
+func IterateCtx(ctx context.Context, params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve IssuingCard structs
+	//
+	//	Same as Iterate, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.IterateCtx(ctx, params)
+}
+
+func (c Client) Iterate(params map[string]interface{}) *Iterator {
+	//	Retrieve IssuingCard structs
+	return c.IterateCtx(context.Background(), params)
+}
+
+func (c Client) IterateCtx(ctx context.Context, params map[string]interface{}) *Iterator {
+	//	Retrieve IssuingCard structs
+	return &Iterator{api: c.Api, pages: c.Api.Iterate(ctx, resource, params)}
+}
+
+func (i *Iterator) Next() bool {
+	//	Move to the next IssuingCard, returning false when there are no more or a request failed
+	var value IssuingCard
+	if i.err.Errors != nil || !i.pages.Next() {
+		return false
+	}
+	i.err = i.api.Unmarshal(i.pages.Value(), &value)
+	if i.err.Errors != nil {
+		i.pages.Close()
+		return false
+	}
+	i.value = value
+	return true
+}
+
+func (i *Iterator) Value() IssuingCard {
+	//	Current IssuingCard, set by the last call to Next
+	return i.value
+}
+
+func (i *Iterator) Err() Error.StarkErrors {
+	//	Error that stopped the iteration, if any
+	if i.err.Errors != nil {
+		return i.err
+	}
+	return i.pages.Err()
+}
+
+func (i *Iterator) Close() {
+	//	Stop the iteration, releasing the current page
+	i.pages.Close()
+}
+
 func Page(params map[string]interface{}, user user.User) ([]IssuingCard, string, Error.StarkErrors) {
 	//	Retrieve paged IssuingCards
 	//
@@ -182,11 +332,29 @@ func Page(params map[string]interface{}, user user.User) ([]IssuingCard, string,
 	//	Return:
 	//	- slice of IssuingCards structs with updated attributes
 	//	- cursor to retrieve the next page of IssuingCards structs
-	page, cursor, err := utils.Page(resource, params, user)
-	unmarshalError := json.Unmarshal(page, &objects)
-	if unmarshalError != nil {
+	return Client{Api: utils.Default(user)}.Page(params)
+}
+
+func PageCtx(ctx context.Context, params map[string]interface{}, user user.User) ([]IssuingCard, string, Error.StarkErrors) {
+	//	Retrieve paged IssuingCards
+	//
+	//	Same as Page, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.PageCtx(ctx, params)
+}
+
+func (c Client) Page(params map[string]interface{}) ([]IssuingCard, string, Error.StarkErrors) {
+	//	Retrieve paged IssuingCards
+	return c.PageCtx(context.Background(), params)
+}
+
+func (c Client) PageCtx(ctx context.Context, params map[string]interface{}) ([]IssuingCard, string, Error.StarkErrors) {
+	//	Retrieve paged IssuingCards
+	var objects []IssuingCard
+	page, cursor, err := c.Api.Page(ctx, resource, params)
+	if err.Errors != nil {
 		return objects, cursor, err
 	}
+	err = c.Api.Unmarshal(page, &objects)
 	return objects, cursor, err
 }
 
@@ -210,11 +378,29 @@ func Update(id string, patchData map[string]interface{}, user user.User) (Issuin
 	//
 	//	Return:
 	//	- target IssuingCard with updated attributes
-	update, err := utils.Patch(resource, id, patchData, user)
-	unmarshalError := json.Unmarshal(update, &object)
-	if unmarshalError != nil {
+	return Client{Api: utils.Default(user)}.Update(id, patchData)
+}
+
+func UpdateCtx(ctx context.Context, id string, patchData map[string]interface{}, user user.User) (IssuingCard, Error.StarkErrors) {
+	//	Update IssuingCard entity
+	//
+	//	Same as Update, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.UpdateCtx(ctx, id, patchData)
+}
+
+func (c Client) Update(id string, patchData map[string]interface{}) (IssuingCard, Error.StarkErrors) {
+	//	Update IssuingCard entity
+	return c.UpdateCtx(context.Background(), id, patchData)
+}
+
+func (c Client) UpdateCtx(ctx context.Context, id string, patchData map[string]interface{}) (IssuingCard, Error.StarkErrors) {
+	//	Update IssuingCard entity
+	var object IssuingCard
+	update, err := c.Api.Patch(ctx, resource, id, patchData)
+	if err.Errors != nil {
 		return object, err
 	}
+	err = c.Api.Unmarshal(update, &object)
 	return object, err
 }
 
@@ -229,10 +415,28 @@ func Cancel(id string, user user.User) (IssuingCard, Error.StarkErrors) {
 	//
 	//	Return:
 	//	- canceled IssuingCard struct
-	deleted, err := utils.Delete(resource, id, user)
-	unmarshalError := json.Unmarshal(deleted, &object)
-	if unmarshalError != nil {
+	return Client{Api: utils.Default(user)}.Cancel(id)
+}
+
+func CancelCtx(ctx context.Context, id string, user user.User) (IssuingCard, Error.StarkErrors) {
+	//	Cancel an IssuingCard entity
+	//
+	//	Same as Cancel, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.CancelCtx(ctx, id)
+}
+
+func (c Client) Cancel(id string) (IssuingCard, Error.StarkErrors) {
+	//	Cancel an IssuingCard entity
+	return c.CancelCtx(context.Background(), id)
+}
+
+func (c Client) CancelCtx(ctx context.Context, id string) (IssuingCard, Error.StarkErrors) {
+	//	Cancel an IssuingCard entity
+	var object IssuingCard
+	deleted, err := c.Api.Delete(ctx, resource, id)
+	if err.Errors != nil {
 		return object, err
 	}
+	err = c.Api.Unmarshal(deleted, &object)
 	return object, err
 }
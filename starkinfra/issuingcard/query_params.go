@@ -0,0 +1,40 @@
+package issuingcard
+
+import (
+	"github.com/starkinfra/sdk-go/starkinfra/utils"
+	"time"
+)
+
+//	IssuingCard QueryParams struct
+//
+//	Typed filters for Query, Iterate and Page. Fields left empty are not sent.
+//
+//	Attributes:
+//	- Limit [int, default nil]: Maximum number of structs to be retrieved. Unlimited if nil, except on Page, which returns up to 100. ex: 35
+//	- Cursor [string, default nil]: Cursor returned on the previous Page call. Only used by Page
+//	- Ids [slice of strings, default nil]: Slice of ids to filter retrieved structs. ex: []string{"5656565656565656", "4545454545454545"}
+//	- After [time.Time, default nil]: Date filter for structs created only after specified date. ex: time.Date(2022, 11, 10, 0, 0, 0, 0, time.UTC)
+//	- Before [time.Time, default nil]: Date filter for structs created only before specified date. ex: time.Date(2022, 11, 10, 0, 0, 0, 0, time.UTC)
+//	- Status [slice of strings, default nil]: Filter for status of retrieved structs. ex: []string{"active", "blocked", "canceled", "expired"}
+//	- Types [slice of strings, default nil]: Card type. ex: []string{"virtual"}
+//	- HolderIds [slice of strings, default nil]: Card holder IDs. ex: []string{"5656565656565656", "4545454545454545"}
+//	- Tags [slice of strings, default nil]: Tags to filter retrieved structs. ex: []string{"tony", "stark"}
+//	- Expand [slice of strings, default nil]: Fields to expand information. ex: []string{"rules", "securityCode", "number", "expiration"}
+
+type QueryParams struct {
+	Limit     int       `json:"limit,omitempty"`
+	Cursor    string    `json:"cursor,omitempty"`
+	Ids       []string  `json:"ids,omitempty"`
+	After     time.Time `json:"after,omitempty"`
+	Before    time.Time `json:"before,omitempty"`
+	Status    []string  `json:"status,omitempty"`
+	Types     []string  `json:"types,omitempty"`
+	HolderIds []string  `json:"holderIds,omitempty"`
+	Tags      []string  `json:"tags,omitempty"`
+	Expand    []string  `json:"expand,omitempty"`
+}
+
+func (p QueryParams) Map() map[string]interface{} {
+	//	Convert the QueryParams into the params map taken by Query, Iterate and Page
+	return utils.ParamsMap(p)
+}
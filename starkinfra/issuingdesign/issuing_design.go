@@ -1,6 +1,7 @@
 package issuingdesign
 
 import (
+	"context"
 	"encoding/json"
 	Error "github.com/starkinfra/core-go/starkcore/error"
 	"github.com/starkinfra/core-go/starkcore/user/user"
@@ -19,18 +20,29 @@ import (
 //	- Type [string]: card or package design type. Options: "card", "envelope"
 //  - Updated [time.Time]: updated datetime for the IssuingDesign. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC),
 //	- Created [time.Time]: creation datetime for the IssuingDesign. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC),
+//	- Extra [map[string]json.RawMessage]: Fields of the IssuingDesign sent by the API but not declared in this SDK version yet, keyed by their JSON name. ex: map[string]json.RawMessage{"newField": json.RawMessage(`"value"`)}
 
 type IssuingDesign struct {
-	Id          string     `json:",omitempty"`
-	Name        string     `json:",omitempty"`
-	EmbosserIds []string   `json:",omitempty"`
-	Type        string     `json:",omitempty"`
-	Updated     *time.Time `json:",omitempty"`
-	Created     *time.Time `json:",omitempty"`
+	Id          string                     `json:",omitempty"`
+	Name        string                     `json:",omitempty"`
+	EmbosserIds []string                   `json:",omitempty"`
+	Type        string                     `json:",omitempty"`
+	Updated     *time.Time                 `json:",omitempty"`
+	Created     *time.Time                 `json:",omitempty"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 var resource = map[string]string{"name": "IssuingDesign"}
 
+//	IssuingDesign Client struct
+//
+//	Runs the IssuingDesign functions with the settings of its own utils.Client
+//	instead of starkinfra.User and the other package defaults.
+
+type Client struct {
+	Api *utils.Client
+}
+
 func Get(id string, user user.User) (IssuingDesign, Error.StarkErrors) {
 	//	Retrieve a specific IssuingDesign by its id
 	//
@@ -44,12 +56,29 @@ func Get(id string, user user.User) (IssuingDesign, Error.StarkErrors) {
 	//
 	//	Return:
 	//	- issuingDesign struct that corresponds to the given id.
+	return Client{Api: utils.Default(user)}.Get(id)
+}
+
+func GetCtx(ctx context.Context, id string, user user.User) (IssuingDesign, Error.StarkErrors) {
+	//	Retrieve a specific IssuingDesign by its id
+	//
+	//	Same as Get, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.GetCtx(ctx, id)
+}
+
+func (c Client) Get(id string) (IssuingDesign, Error.StarkErrors) {
+	//	Retrieve a specific IssuingDesign by its id
+	return c.GetCtx(context.Background(), id)
+}
+
+func (c Client) GetCtx(ctx context.Context, id string) (IssuingDesign, Error.StarkErrors) {
+	//	Retrieve a specific IssuingDesign by its id
 	var issuingDesign IssuingDesign
-	get, err := utils.Get(resource, id, nil, user)
-	unmarshalError := json.Unmarshal(get, &issuingDesign)
-	if unmarshalError != nil {
+	get, err := c.Api.Get(ctx, resource, id, nil)
+	if err.Errors != nil {
 		return issuingDesign, err
 	}
+	err = c.Api.Unmarshal(get, &issuingDesign)
 	return issuingDesign, err
 }
 
@@ -66,29 +95,133 @@ func Query(params map[string]interface{}, user user.User) (chan IssuingDesign, c
 	//
 	//	Return:
 	//	- channel of IssuingDesign structs with updated attributes
-	var issuingDesign IssuingDesign
+	return Client{Api: utils.Default(user)}.Query(params)
+}
+
+func QueryCtx(ctx context.Context, params map[string]interface{}, user user.User) (chan IssuingDesign, chan Error.StarkErrors) {
+	//	Retrieve IssuingDesigns
+	//
+	//	Same as Query, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.QueryCtx(ctx, params)
+}
+
+func (c Client) Query(params map[string]interface{}) (chan IssuingDesign, chan Error.StarkErrors) {
+	//	Retrieve IssuingDesigns
+	return c.QueryCtx(context.Background(), params)
+}
+
+func (c Client) QueryCtx(ctx context.Context, params map[string]interface{}) (chan IssuingDesign, chan Error.StarkErrors) {
+	//	Retrieve IssuingDesigns
 	designs := make(chan IssuingDesign)
 	designsError := make(chan Error.StarkErrors)
-	query, errorChannel := utils.Query(resource, params, user)
+	query, errorChannel := c.Api.Stream(ctx, resource, params)
 	go func() {
+		defer close(designsError)
+		defer close(designs)
 		for content := range query {
-			contentByte, _ := json.Marshal(content)
-			err := json.Unmarshal(contentByte, &issuingDesign)
-			if err != nil {
-				designsError <- Error.UnknownError(err.Error())
+			var issuingDesign IssuingDesign
+			err := c.Api.Unmarshal(content, &issuingDesign)
+			if err.Errors != nil {
+				if !utils.SendError(ctx, designsError, err) {
+					return
+				}
 				continue
 			}
-			designs <- issuingDesign
+			select {
+			case designs <- issuingDesign:
+			case <-ctx.Done():
+				return
+			}
 		}
 		for err := range errorChannel {
-			designsError <- err
+			if !utils.SendError(ctx, designsError, err) {
+				return
+			}
 		}
-		close(designs)
-		close(designsError)
 	}()
 	return designs, designsError
 }
 
+//	IssuingDesign Iterator struct
+//
+//	Returned by Iterate to walk through IssuingDesign structs one at a time,
+//	requesting a new page only once the current one is exhausted. Check Err
+//	once Next returns false and Close it when leaving the loop early.
+
+type Iterator struct {
+	api   *utils.Client
+	pages *utils.Iterator
+	value IssuingDesign
+	err   Error.StarkErrors
+}
+
+func Iterate(params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve IssuingDesigns
+	//
+	//	Same as Query, but returns an Iterator instead of a pair of channels
+	//
+	//	Example:
+	//	iterator := Iterate(params, nil)
+	//	defer iterator.Close()
+	//	for iterator.Next() {
+	//		fmt.Println(iterator.Value())
+	//	}
+	//	if err := iterator.Err(); err.Errors != nil {
+	//		...
+	//	}
+	return Client{Api: utils.Default(user)}.Iterate(params)
+}
+
+func IterateCtx(ctx context.Context, params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve IssuingDesigns
+	//
+	//	Same as Iterate, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.IterateCtx(ctx, params)
+}
+
+func (c Client) Iterate(params map[string]interface{}) *Iterator {
+	//	Retrieve IssuingDesigns
+	return c.IterateCtx(context.Background(), params)
+}
+
+func (c Client) IterateCtx(ctx context.Context, params map[string]interface{}) *Iterator {
+	//	Retrieve IssuingDesigns
+	return &Iterator{api: c.Api, pages: c.Api.Iterate(ctx, resource, params)}
+}
+
+func (i *Iterator) Next() bool {
+	//	Move to the next IssuingDesign, returning false when there are no more or a request failed
+	var value IssuingDesign
+	if i.err.Errors != nil || !i.pages.Next() {
+		return false
+	}
+	i.err = i.api.Unmarshal(i.pages.Value(), &value)
+	if i.err.Errors != nil {
+		i.pages.Close()
+		return false
+	}
+	i.value = value
+	return true
+}
+
+func (i *Iterator) Value() IssuingDesign {
+	//	Current IssuingDesign, set by the last call to Next
+	return i.value
+}
+
+func (i *Iterator) Err() Error.StarkErrors {
+	//	Error that stopped the iteration, if any
+	if i.err.Errors != nil {
+		return i.err
+	}
+	return i.pages.Err()
+}
+
+func (i *Iterator) Close() {
+	//	Stop the iteration, releasing the current page
+	i.pages.Close()
+}
+
 func Page(params map[string]interface{}, user user.User) ([]IssuingDesign, string, Error.StarkErrors) {
 	//	Retrieve paged IssuingDesign structs
 	//
@@ -105,12 +238,29 @@ func Page(params map[string]interface{}, user user.User) ([]IssuingDesign, strin
 	//	Return:
 	//	- slice of IssuingDesign structs with updated attributes
 	//	- cursor to retrieve the next page of IssuingDesign structs
+	return Client{Api: utils.Default(user)}.Page(params)
+}
+
+func PageCtx(ctx context.Context, params map[string]interface{}, user user.User) ([]IssuingDesign, string, Error.StarkErrors) {
+	//	Retrieve paged IssuingDesign structs
+	//
+	//	Same as Page, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.PageCtx(ctx, params)
+}
+
+func (c Client) Page(params map[string]interface{}) ([]IssuingDesign, string, Error.StarkErrors) {
+	//	Retrieve paged IssuingDesign structs
+	return c.PageCtx(context.Background(), params)
+}
+
+func (c Client) PageCtx(ctx context.Context, params map[string]interface{}) ([]IssuingDesign, string, Error.StarkErrors) {
+	//	Retrieve paged IssuingDesign structs
 	var issuingDesigns []IssuingDesign
-	page, cursor, err := utils.Page(resource, params, user)
-	unmarshalError := json.Unmarshal(page, &issuingDesigns)
-	if unmarshalError != nil {
+	page, cursor, err := c.Api.Page(ctx, resource, params)
+	if err.Errors != nil {
 		return issuingDesigns, cursor, err
 	}
+	err = c.Api.Unmarshal(page, &issuingDesigns)
 	return issuingDesigns, cursor, err
 }
 
@@ -127,5 +277,22 @@ func Pdf(id string, user user.User) ([]byte, Error.StarkErrors) {
 	//
 	//	Return:
 	//	- IssuingDesign pdf file
-	return utils.GetContent(resource, id, nil, user, "pdf")
+	return Client{Api: utils.Default(user)}.Pdf(id)
+}
+
+func PdfCtx(ctx context.Context, id string, user user.User) ([]byte, Error.StarkErrors) {
+	//	Retrieve a specific IssuingDesign pdf file
+	//
+	//	Same as Pdf, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.PdfCtx(ctx, id)
+}
+
+func (c Client) Pdf(id string) ([]byte, Error.StarkErrors) {
+	//	Retrieve a specific IssuingDesign pdf file
+	return c.PdfCtx(context.Background(), id)
+}
+
+func (c Client) PdfCtx(ctx context.Context, id string) ([]byte, Error.StarkErrors) {
+	//	Retrieve a specific IssuingDesign pdf file
+	return c.Api.GetContent(ctx, resource, id, nil, "pdf")
 }
@@ -1,6 +1,7 @@
 package issuingembossingkit
 
 import (
+	"context"
 	"encoding/json"
 	Error "github.com/starkinfra/core-go/starkcore/error"
 	"github.com/starkinfra/core-go/starkcore/user/user"
@@ -19,6 +20,7 @@ import (
 //	- Designs [slice of IssuingDesigns]: slice of IssuingDesign objects. ex: "created", "processing", "success", "failed"
 //	- Updated [time.Time]: Latest update datetime for the IssuingEmbossingKit. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC),
 //	- Created [time.Time]: Creation datetime for the IssuingEmbossingKit. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC),
+//	- Extra [map[string]json.RawMessage]: Fields of the IssuingEmbossingKit sent by the API but not declared in this SDK version yet, keyed by their JSON name. ex: map[string]json.RawMessage{"newField": json.RawMessage(`"value"`)}
 
 type IssuingEmbossingKit struct {
 	Id      string                        `json:",omitempty"`
@@ -26,10 +28,20 @@ type IssuingEmbossingKit struct {
 	Designs []IssuingDesign.IssuingDesign `json:",omitempty"`
 	Updated *time.Time                    `json:",omitempty"`
 	Created *time.Time                    `json:",omitempty"`
+	Extra   map[string]json.RawMessage    `json:"-"`
 }
 
 var resource = map[string]string{"name": "IssuingEmbossingKit"}
 
+//	IssuingEmbossingKit Client struct
+//
+//	Runs the IssuingEmbossingKit functions with the settings of its own utils.Client
+//	instead of starkinfra.User and the other package defaults.
+
+type Client struct {
+	Api *utils.Client
+}
+
 func Get(id string, user user.User) (IssuingEmbossingKit, Error.StarkErrors) {
 	//	Retrieve a specific IssuingEmbossingKit by its id
 	//
@@ -43,12 +55,29 @@ func Get(id string, user user.User) (IssuingEmbossingKit, Error.StarkErrors) {
 	//
 	//	Return:
 	//	- issuingEmbossingKit struct that corresponds to the given id.
+	return Client{Api: utils.Default(user)}.Get(id)
+}
+
+func GetCtx(ctx context.Context, id string, user user.User) (IssuingEmbossingKit, Error.StarkErrors) {
+	//	Retrieve a specific IssuingEmbossingKit by its id
+	//
+	//	Same as Get, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.GetCtx(ctx, id)
+}
+
+func (c Client) Get(id string) (IssuingEmbossingKit, Error.StarkErrors) {
+	//	Retrieve a specific IssuingEmbossingKit by its id
+	return c.GetCtx(context.Background(), id)
+}
+
+func (c Client) GetCtx(ctx context.Context, id string) (IssuingEmbossingKit, Error.StarkErrors) {
+	//	Retrieve a specific IssuingEmbossingKit by its id
 	var issuingEmbossingKit IssuingEmbossingKit
-	get, err := utils.Get(resource, id, nil, user)
-	unmarshalError := json.Unmarshal(get, &issuingEmbossingKit)
-	if unmarshalError != nil {
+	get, err := c.Api.Get(ctx, resource, id, nil)
+	if err.Errors != nil {
 		return issuingEmbossingKit, err
 	}
+	err = c.Api.Unmarshal(get, &issuingEmbossingKit)
 	return issuingEmbossingKit, err
 }
 
@@ -69,29 +98,133 @@ func Query(params map[string]interface{}, user user.User) (chan IssuingEmbossing
 	//
 	//	Return:
 	//	- channel of IssuingEmbossingKit structs with updated attributes
-	var issuingEmbossingKit IssuingEmbossingKit
+	return Client{Api: utils.Default(user)}.Query(params)
+}
+
+func QueryCtx(ctx context.Context, params map[string]interface{}, user user.User) (chan IssuingEmbossingKit, chan Error.StarkErrors) {
+	//	Retrieve IssuingEmbossingKits
+	//
+	//	Same as Query, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.QueryCtx(ctx, params)
+}
+
+func (c Client) Query(params map[string]interface{}) (chan IssuingEmbossingKit, chan Error.StarkErrors) {
+	//	Retrieve IssuingEmbossingKits
+	return c.QueryCtx(context.Background(), params)
+}
+
+func (c Client) QueryCtx(ctx context.Context, params map[string]interface{}) (chan IssuingEmbossingKit, chan Error.StarkErrors) {
+	//	Retrieve IssuingEmbossingKits
 	kits := make(chan IssuingEmbossingKit)
 	kitsError := make(chan Error.StarkErrors)
-	query, errorChannel := utils.Query(resource, params, user)
+	query, errorChannel := c.Api.Stream(ctx, resource, params)
 	go func() {
+		defer close(kitsError)
+		defer close(kits)
 		for content := range query {
-			contentByte, _ := json.Marshal(content)
-			err := json.Unmarshal(contentByte, &issuingEmbossingKit)
-			if err != nil {
-				kitsError <- Error.UnknownError(err.Error())
+			var issuingEmbossingKit IssuingEmbossingKit
+			err := c.Api.Unmarshal(content, &issuingEmbossingKit)
+			if err.Errors != nil {
+				if !utils.SendError(ctx, kitsError, err) {
+					return
+				}
 				continue
 			}
-			kits <- issuingEmbossingKit
+			select {
+			case kits <- issuingEmbossingKit:
+			case <-ctx.Done():
+				return
+			}
 		}
 		for err := range errorChannel {
-			kitsError <- err
+			if !utils.SendError(ctx, kitsError, err) {
+				return
+			}
 		}
-		close(kits)
-		close(kitsError)
 	}()
 	return kits, kitsError
 }
 
+//	IssuingEmbossingKit Iterator struct
+//
+//	Returned by Iterate to walk through IssuingEmbossingKit structs one at a time,
+//	requesting a new page only once the current one is exhausted. Check Err
+//	once Next returns false and Close it when leaving the loop early.
+
+type Iterator struct {
+	api   *utils.Client
+	pages *utils.Iterator
+	value IssuingEmbossingKit
+	err   Error.StarkErrors
+}
+
+func Iterate(params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve IssuingEmbossingKits
+	//
+	//	Same as Query, but returns an Iterator instead of a pair of channels
+	//
+	//	Example:
+	//	iterator := Iterate(params, nil)
+	//	defer iterator.Close()
+	//	for iterator.Next() {
+	//		fmt.Println(iterator.Value())
+	//	}
+	//	if err := iterator.Err(); err.Errors != nil {
+	//		...
+	//	}
+	return Client{Api: utils.Default(user)}.Iterate(params)
+}
+
+func IterateCtx(ctx context.Context, params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve IssuingEmbossingKits
+	//
+	//	Same as Iterate, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.IterateCtx(ctx, params)
+}
+
+func (c Client) Iterate(params map[string]interface{}) *Iterator {
+	//	Retrieve IssuingEmbossingKits
+	return c.IterateCtx(context.Background(), params)
+}
+
+func (c Client) IterateCtx(ctx context.Context, params map[string]interface{}) *Iterator {
+	//	Retrieve IssuingEmbossingKits
+	return &Iterator{api: c.Api, pages: c.Api.Iterate(ctx, resource, params)}
+}
+
+func (i *Iterator) Next() bool {
+	//	Move to the next IssuingEmbossingKit, returning false when there are no more or a request failed
+	var value IssuingEmbossingKit
+	if i.err.Errors != nil || !i.pages.Next() {
+		return false
+	}
+	i.err = i.api.Unmarshal(i.pages.Value(), &value)
+	if i.err.Errors != nil {
+		i.pages.Close()
+		return false
+	}
+	i.value = value
+	return true
+}
+
+func (i *Iterator) Value() IssuingEmbossingKit {
+	//	Current IssuingEmbossingKit, set by the last call to Next
+	return i.value
+}
+
+func (i *Iterator) Err() Error.StarkErrors {
+	//	Error that stopped the iteration, if any
+	if i.err.Errors != nil {
+		return i.err
+	}
+	return i.pages.Err()
+}
+
+func (i *Iterator) Close() {
+	//	Stop the iteration, releasing the current page
+	i.pages.Close()
+}
+
 func Page(params map[string]interface{}, user user.User) ([]IssuingEmbossingKit, string, Error.StarkErrors) {
 	//	Retrieve paged IssuingEmbossingKit structs
 	//
@@ -112,11 +245,28 @@ func Page(params map[string]interface{}, user user.User) ([]IssuingEmbossingKit,
 	//	Return:
 	//	- slice of IssuingEmbossingKit structs with updated attributes
 	//	- cursor to retrieve the next page of IssuingEmbossingKit structs
+	return Client{Api: utils.Default(user)}.Page(params)
+}
+
+func PageCtx(ctx context.Context, params map[string]interface{}, user user.User) ([]IssuingEmbossingKit, string, Error.StarkErrors) {
+	//	Retrieve paged IssuingEmbossingKit structs
+	//
+	//	Same as Page, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.PageCtx(ctx, params)
+}
+
+func (c Client) Page(params map[string]interface{}) ([]IssuingEmbossingKit, string, Error.StarkErrors) {
+	//	Retrieve paged IssuingEmbossingKit structs
+	return c.PageCtx(context.Background(), params)
+}
+
+func (c Client) PageCtx(ctx context.Context, params map[string]interface{}) ([]IssuingEmbossingKit, string, Error.StarkErrors) {
+	//	Retrieve paged IssuingEmbossingKit structs
 	var issuingEmbossingKits []IssuingEmbossingKit
-	page, cursor, err := utils.Page(resource, params, user)
-	unmarshalError := json.Unmarshal(page, &issuingEmbossingKits)
-	if unmarshalError != nil {
+	page, cursor, err := c.Api.Page(ctx, resource, params)
+	if err.Errors != nil {
 		return issuingEmbossingKits, cursor, err
 	}
+	err = c.Api.Unmarshal(page, &issuingEmbossingKits)
 	return issuingEmbossingKits, cursor, err
 }
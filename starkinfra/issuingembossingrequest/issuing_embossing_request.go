@@ -1,6 +1,7 @@
 package issuingembossingrequest
 
 import (
+	"context"
 	"encoding/json"
 	Error "github.com/starkinfra/core-go/starkcore/error"
 	"github.com/starkinfra/core-go/starkcore/user/user"
@@ -39,34 +40,45 @@ import (
 //	- Status [string]: Status of the IssuingEmbossingRequest. ex: "created", "processing", "success", "failed"
 //  - Updated [time.Time]: Latest update datetime for the IssuingEmbossingRequest. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC),
 //	- Created [time.Time]: Creation datetime for the IssuingEmbossingRequest. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC),
+//	- Extra [map[string]json.RawMessage]: Fields of the IssuingEmbossingRequest sent by the API but not declared in this SDK version yet, keyed by their JSON name. ex: map[string]json.RawMessage{"newField": json.RawMessage(`"value"`)}
 
 type IssuingEmbossingRequest struct {
-	CardId                 string     `json:",omitempty"`
-	KitId                  string     `json:",omitempty"`
-	DisplayName1           string     `json:",omitempty"`
-	ShippingCity           string     `json:",omitempty"`
-	ShippingCountryCode    string     `json:",omitempty"`
-	ShippingDistrict       string     `json:",omitempty"`
-	ShippingStateCode      string     `json:",omitempty"`
-	ShippingStreetLine1    string     `json:",omitempty"`
-	ShippingStreetLine2    string     `json:",omitempty"`
-	ShippingService        string     `json:",omitempty"`
-	ShippingTrackingNumber string     `json:",omitempty"`
-	ShippingZipCode        string     `json:",omitempty"`
-	EmbosserId             string     `json:",omitempty"`
-	DisplayName2           string     `json:",omitempty"`
-	DisplayName3           string     `json:",omitempty"`
-	ShippingPhone          string     `json:",omitempty"`
-	Tags                   []string   `json:",omitempty"`
-	Id                     string     `json:",omitempty"`
-	Fee                    int        `json:",omitempty"`
-	Status                 string     `json:",omitempty"`
-	Updated                *time.Time `json:",omitempty"`
-	Created                *time.Time `json:",omitempty"`
+	CardId                 string                     `json:",omitempty"`
+	KitId                  string                     `json:",omitempty"`
+	DisplayName1           string                     `json:",omitempty"`
+	ShippingCity           string                     `json:",omitempty"`
+	ShippingCountryCode    string                     `json:",omitempty"`
+	ShippingDistrict       string                     `json:",omitempty"`
+	ShippingStateCode      string                     `json:",omitempty"`
+	ShippingStreetLine1    string                     `json:",omitempty"`
+	ShippingStreetLine2    string                     `json:",omitempty"`
+	ShippingService        string                     `json:",omitempty"`
+	ShippingTrackingNumber string                     `json:",omitempty"`
+	ShippingZipCode        string                     `json:",omitempty"`
+	EmbosserId             string                     `json:",omitempty"`
+	DisplayName2           string                     `json:",omitempty"`
+	DisplayName3           string                     `json:",omitempty"`
+	ShippingPhone          string                     `json:",omitempty"`
+	Tags                   []string                   `json:",omitempty"`
+	Id                     string                     `json:",omitempty"`
+	Fee                    int                        `json:",omitempty"`
+	Status                 string                     `json:",omitempty"`
+	Updated                *time.Time                 `json:",omitempty"`
+	Created                *time.Time                 `json:",omitempty"`
+	Extra                  map[string]json.RawMessage `json:"-"`
 }
 
 var resource = map[string]string{"name": "IssuingEmbossingRequest"}
 
+//	IssuingEmbossingRequest Client struct
+//
+//	Runs the IssuingEmbossingRequest functions with the settings of its own utils.Client
+//	instead of starkinfra.User and the other package defaults.
+
+type Client struct {
+	Api *utils.Client
+}
+
 func Create(requests []IssuingEmbossingRequest, user user.User) ([]IssuingEmbossingRequest, Error.StarkErrors) {
 	//	Create IssuingEmbossingRequests
 	//
@@ -80,11 +92,28 @@ func Create(requests []IssuingEmbossingRequest, user user.User) ([]IssuingEmboss
 	//
 	//	Return:
 	//	- slice of IssuingEmbossingRequest structs with updated attributes
-	create, err := utils.Multi(resource, requests, nil, user)
-	unmarshalError := json.Unmarshal(create, &requests)
-	if unmarshalError != nil {
+	return Client{Api: utils.Default(user)}.Create(requests)
+}
+
+func CreateCtx(ctx context.Context, requests []IssuingEmbossingRequest, user user.User) ([]IssuingEmbossingRequest, Error.StarkErrors) {
+	//	Create IssuingEmbossingRequests
+	//
+	//	Same as Create, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.CreateCtx(ctx, requests)
+}
+
+func (c Client) Create(requests []IssuingEmbossingRequest) ([]IssuingEmbossingRequest, Error.StarkErrors) {
+	//	Create IssuingEmbossingRequests
+	return c.CreateCtx(context.Background(), requests)
+}
+
+func (c Client) CreateCtx(ctx context.Context, requests []IssuingEmbossingRequest) ([]IssuingEmbossingRequest, Error.StarkErrors) {
+	//	Create IssuingEmbossingRequests
+	create, err := c.Api.Multi(ctx, resource, requests, nil)
+	if err.Errors != nil {
 		return requests, err
 	}
+	err = c.Api.Unmarshal(create, &requests)
 	return requests, err
 }
 
@@ -101,12 +130,29 @@ func Get(id string, user user.User) (IssuingEmbossingRequest, Error.StarkErrors)
 	//
 	//	Return:
 	//	- issuingEmbossingRequest struct that corresponds to the given id.
+	return Client{Api: utils.Default(user)}.Get(id)
+}
+
+func GetCtx(ctx context.Context, id string, user user.User) (IssuingEmbossingRequest, Error.StarkErrors) {
+	//	Retrieve a specific IssuingEmbossingRequest by its id
+	//
+	//	Same as Get, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.GetCtx(ctx, id)
+}
+
+func (c Client) Get(id string) (IssuingEmbossingRequest, Error.StarkErrors) {
+	//	Retrieve a specific IssuingEmbossingRequest by its id
+	return c.GetCtx(context.Background(), id)
+}
+
+func (c Client) GetCtx(ctx context.Context, id string) (IssuingEmbossingRequest, Error.StarkErrors) {
+	//	Retrieve a specific IssuingEmbossingRequest by its id
 	var issuingEmbossingRequest IssuingEmbossingRequest
-	get, err := utils.Get(resource, id, nil, user)
-	unmarshalError := json.Unmarshal(get, &issuingEmbossingRequest)
-	if unmarshalError != nil {
+	get, err := c.Api.Get(ctx, resource, id, nil)
+	if err.Errors != nil {
 		return issuingEmbossingRequest, err
 	}
+	err = c.Api.Unmarshal(get, &issuingEmbossingRequest)
 	return issuingEmbossingRequest, err
 }
 
@@ -128,29 +174,133 @@ func Query(params map[string]interface{}, user user.User) (chan IssuingEmbossing
 	//
 	//	Return:
 	//	- channel of IssuingEmbossingRequest structs with updated attributes
-	var issuingEmbossingRequest IssuingEmbossingRequest
+	return Client{Api: utils.Default(user)}.Query(params)
+}
+
+func QueryCtx(ctx context.Context, params map[string]interface{}, user user.User) (chan IssuingEmbossingRequest, chan Error.StarkErrors) {
+	//	Retrieve IssuingEmbossingRequests
+	//
+	//	Same as Query, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.QueryCtx(ctx, params)
+}
+
+func (c Client) Query(params map[string]interface{}) (chan IssuingEmbossingRequest, chan Error.StarkErrors) {
+	//	Retrieve IssuingEmbossingRequests
+	return c.QueryCtx(context.Background(), params)
+}
+
+func (c Client) QueryCtx(ctx context.Context, params map[string]interface{}) (chan IssuingEmbossingRequest, chan Error.StarkErrors) {
+	//	Retrieve IssuingEmbossingRequests
 	requests := make(chan IssuingEmbossingRequest)
 	requestsError := make(chan Error.StarkErrors)
-	query, errorChannel := utils.Query(resource, params, user)
+	query, errorChannel := c.Api.Stream(ctx, resource, params)
 	go func() {
+		defer close(requestsError)
+		defer close(requests)
 		for content := range query {
-			contentByte, _ := json.Marshal(content)
-			err := json.Unmarshal(contentByte, &issuingEmbossingRequest)
-			if err != nil {
-				requestsError <- Error.UnknownError(err.Error())
+			var issuingEmbossingRequest IssuingEmbossingRequest
+			err := c.Api.Unmarshal(content, &issuingEmbossingRequest)
+			if err.Errors != nil {
+				if !utils.SendError(ctx, requestsError, err) {
+					return
+				}
 				continue
 			}
-			requests <- issuingEmbossingRequest
+			select {
+			case requests <- issuingEmbossingRequest:
+			case <-ctx.Done():
+				return
+			}
 		}
 		for err := range errorChannel {
-			requestsError <- err
+			if !utils.SendError(ctx, requestsError, err) {
+				return
+			}
 		}
-		close(requests)
-		close(requestsError)
 	}()
 	return requests, requestsError
 }
 
+//	IssuingEmbossingRequest Iterator struct
+//
+//	Returned by Iterate to walk through IssuingEmbossingRequest structs one at a time,
+//	requesting a new page only once the current one is exhausted. Check Err
+//	once Next returns false and Close it when leaving the loop early.
+
+type Iterator struct {
+	api   *utils.Client
+	pages *utils.Iterator
+	value IssuingEmbossingRequest
+	err   Error.StarkErrors
+}
+
+func Iterate(params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve IssuingEmbossingRequests
+	//
+	//	Same as Query, but returns an Iterator instead of a pair of channels
+	//
+	//	Example:
+	//	iterator := Iterate(params, nil)
+	//	defer iterator.Close()
+	//	for iterator.Next() {
+	//		fmt.Println(iterator.Value())
+	//	}
+	//	if err := iterator.Err(); err.Errors != nil {
+	//		...
+	//	}
+	return Client{Api: utils.Default(user)}.Iterate(params)
+}
+
+func IterateCtx(ctx context.Context, params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve IssuingEmbossingRequests
+	//
+	//	Same as Iterate, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.IterateCtx(ctx, params)
+}
+
+func (c Client) Iterate(params map[string]interface{}) *Iterator {
+	//	Retrieve IssuingEmbossingRequests
+	return c.IterateCtx(context.Background(), params)
+}
+
+func (c Client) IterateCtx(ctx context.Context, params map[string]interface{}) *Iterator {
+	//	Retrieve IssuingEmbossingRequests
+	return &Iterator{api: c.Api, pages: c.Api.Iterate(ctx, resource, params)}
+}
+
+func (i *Iterator) Next() bool {
+	//	Move to the next IssuingEmbossingRequest, returning false when there are no more or a request failed
+	var value IssuingEmbossingRequest
+	if i.err.Errors != nil || !i.pages.Next() {
+		return false
+	}
+	i.err = i.api.Unmarshal(i.pages.Value(), &value)
+	if i.err.Errors != nil {
+		i.pages.Close()
+		return false
+	}
+	i.value = value
+	return true
+}
+
+func (i *Iterator) Value() IssuingEmbossingRequest {
+	//	Current IssuingEmbossingRequest, set by the last call to Next
+	return i.value
+}
+
+func (i *Iterator) Err() Error.StarkErrors {
+	//	Error that stopped the iteration, if any
+	if i.err.Errors != nil {
+		return i.err
+	}
+	return i.pages.Err()
+}
+
+func (i *Iterator) Close() {
+	//	Stop the iteration, releasing the current page
+	i.pages.Close()
+}
+
 func Page(params map[string]interface{}, user user.User) ([]IssuingEmbossingRequest, string, Error.StarkErrors) {
 	//	Retrieve paged IssuingEmbossingRequest structs
 	//
@@ -172,11 +322,28 @@ func Page(params map[string]interface{}, user user.User) ([]IssuingEmbossingRequ
 	//	Return:
 	//	- slice of IssuingEmbossingRequest structs with updated attributes
 	//	- cursor to retrieve the next page of IssuingEmbossingRequest structs
+	return Client{Api: utils.Default(user)}.Page(params)
+}
+
+func PageCtx(ctx context.Context, params map[string]interface{}, user user.User) ([]IssuingEmbossingRequest, string, Error.StarkErrors) {
+	//	Retrieve paged IssuingEmbossingRequest structs
+	//
+	//	Same as Page, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.PageCtx(ctx, params)
+}
+
+func (c Client) Page(params map[string]interface{}) ([]IssuingEmbossingRequest, string, Error.StarkErrors) {
+	//	Retrieve paged IssuingEmbossingRequest structs
+	return c.PageCtx(context.Background(), params)
+}
+
+func (c Client) PageCtx(ctx context.Context, params map[string]interface{}) ([]IssuingEmbossingRequest, string, Error.StarkErrors) {
+	//	Retrieve paged IssuingEmbossingRequest structs
 	var issuingEmbossingRequests []IssuingEmbossingRequest
-	page, cursor, err := utils.Page(resource, params, user)
-	unmarshalError := json.Unmarshal(page, &issuingEmbossingRequests)
-	if unmarshalError != nil {
+	page, cursor, err := c.Api.Page(ctx, resource, params)
+	if err.Errors != nil {
 		return issuingEmbossingRequests, cursor, err
 	}
+	err = c.Api.Unmarshal(page, &issuingEmbossingRequests)
 	return issuingEmbossingRequests, cursor, err
 }
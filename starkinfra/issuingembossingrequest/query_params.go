@@ -0,0 +1,36 @@
+package issuingembossingrequest
+
+import (
+	"github.com/starkinfra/sdk-go/starkinfra/utils"
+	"time"
+)
+
+//	IssuingEmbossingRequest QueryParams struct
+//
+//	Typed filters for Query, Iterate and Page. Fields left empty are not sent.
+//
+//	Attributes:
+//	- Limit [int, default nil]: Maximum number of structs to be retrieved. Unlimited if nil, except on Page, which returns up to 100. ex: 35
+//	- Cursor [string, default nil]: Cursor returned on the previous Page call. Only used by Page
+//	- After [time.Time, default nil]: Date filter for structs created only after specified date. ex: time.Date(2022, 11, 10, 0, 0, 0, 0, time.UTC)
+//	- Before [time.Time, default nil]: Date filter for structs created only before specified date. ex: time.Date(2022, 11, 10, 0, 0, 0, 0, time.UTC)
+//	- Status [slice of strings, default nil]: Filter for status of retrieved structs. ex: []string{"created", "processing", "success", "failed"}
+//	- CardIds [slice of strings, default nil]: Slice of cardIds to filter retrieved structs. ex: []string{"5656565656565656", "4545454545454545"}
+//	- Ids [slice of strings, default nil]: Slice of ids to filter retrieved structs. ex: []string{"5656565656565656", "4545454545454545"}
+//	- Tags [slice of strings, default nil]: Tags to filter retrieved structs. ex: []string{"tony", "stark"}
+
+type QueryParams struct {
+	Limit   int       `json:"limit,omitempty"`
+	Cursor  string    `json:"cursor,omitempty"`
+	After   time.Time `json:"after,omitempty"`
+	Before  time.Time `json:"before,omitempty"`
+	Status  []string  `json:"status,omitempty"`
+	CardIds []string  `json:"cardIds,omitempty"`
+	Ids     []string  `json:"ids,omitempty"`
+	Tags    []string  `json:"tags,omitempty"`
+}
+
+func (p QueryParams) Map() map[string]interface{} {
+	//	Convert the QueryParams into the params map taken by Query, Iterate and Page
+	return utils.ParamsMap(p)
+}
@@ -1,6 +1,7 @@
 package issuingholder
 
 import (
+	"context"
 	"encoding/json"
 	Error "github.com/starkinfra/core-go/starkcore/error"
 	"github.com/starkinfra/core-go/starkcore/user/user"
@@ -27,21 +28,32 @@ import (
 //	- Status [string]: Current IssuingHolder status. ex: "active", "blocked", "canceled"
 //	- Updated [time.Time]: Latest update datetime for the IssuingHolder. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC),
 //	- Created [time.Time]: Creation datetime for the IssuingHolder. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC),
+//	- Extra [map[string]json.RawMessage]: Fields of the IssuingHolder sent by the API but not declared in this SDK version yet, keyed by their JSON name. ex: map[string]json.RawMessage{"newField": json.RawMessage(`"value"`)}
 
 type IssuingHolder struct {
-	Name       string                    `json:",omitempty"`
-	TaxId      string                    `json:",omitempty"`
-	ExternalId string                    `json:",omitempty"`
-	Rules      []IssuingRule.IssuingRule `json:",omitempty"`
-	Tags       []string                  `json:",omitempty"`
-	Id         string                    `json:",omitempty"`
-	Status     string                    `json:",omitempty"`
-	Updated    *time.Time                `json:",omitempty"`
-	Created    *time.Time                `json:",omitempty"`
+	Name       string                     `json:",omitempty"`
+	TaxId      string                     `json:",omitempty"`
+	ExternalId string                     `json:",omitempty"`
+	Rules      []IssuingRule.IssuingRule  `json:",omitempty"`
+	Tags       []string                   `json:",omitempty"`
+	Id         string                     `json:",omitempty"`
+	Status     string                     `json:",omitempty"`
+	Updated    *time.Time                 `json:",omitempty"`
+	Created    *time.Time                 `json:",omitempty"`
+	Extra      map[string]json.RawMessage `json:"-"`
 }
 
 var resource = map[string]string{"name": "IssuingHolder"}
 
+//	IssuingHolder Client struct
+//
+//	Runs the IssuingHolder functions with the settings of its own utils.Client
+//	instead of starkinfra.User and the other package defaults.
+
+type Client struct {
+	Api *utils.Client
+}
+
 func Create(holders []IssuingHolder, expand map[string]interface{}, user user.User) ([]IssuingHolder, Error.StarkErrors) {
 	//	Create IssuingHolder
 	//
@@ -56,12 +68,29 @@ func Create(holders []IssuingHolder, expand map[string]interface{}, user user.Us
 	//
 	//	Return:
 	//	- slice of IssuingHolder structs with updated attributes
+	return Client{Api: utils.Default(user)}.Create(holders, expand)
+}
+
+func CreateCtx(ctx context.Context, holders []IssuingHolder, expand map[string]interface{}, user user.User) ([]IssuingHolder, Error.StarkErrors) {
+	//	Create IssuingHolder
+	//
+	//	Same as Create, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.CreateCtx(ctx, holders, expand)
+}
+
+func (c Client) Create(holders []IssuingHolder, expand map[string]interface{}) ([]IssuingHolder, Error.StarkErrors) {
+	//	Create IssuingHolder
+	return c.CreateCtx(context.Background(), holders, expand)
+}
+
+func (c Client) CreateCtx(ctx context.Context, holders []IssuingHolder, expand map[string]interface{}) ([]IssuingHolder, Error.StarkErrors) {
+	//	Create IssuingHolder
 	var issuingHolders []IssuingHolder
-	create, err := utils.Multi(resource, holders, expand, user)
-	unmarshalError := json.Unmarshal(create, &issuingHolders)
-	if unmarshalError != nil {
+	create, err := c.Api.Multi(ctx, resource, holders, expand)
+	if err.Errors != nil {
 		return issuingHolders, err
 	}
+	err = c.Api.Unmarshal(create, &issuingHolders)
 	return issuingHolders, err
 }
 
@@ -79,12 +108,29 @@ func Get(id string, expand map[string]interface{}, user user.User) (IssuingHolde
 	//
 	//	Return:
 	//	- issuingHolder struct that corresponds to the given id.
+	return Client{Api: utils.Default(user)}.Get(id, expand)
+}
+
+func GetCtx(ctx context.Context, id string, expand map[string]interface{}, user user.User) (IssuingHolder, Error.StarkErrors) {
+	//	Retrieve a specific IssuingHolder by its id
+	//
+	//	Same as Get, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.GetCtx(ctx, id, expand)
+}
+
+func (c Client) Get(id string, expand map[string]interface{}) (IssuingHolder, Error.StarkErrors) {
+	//	Retrieve a specific IssuingHolder by its id
+	return c.GetCtx(context.Background(), id, expand)
+}
+
+func (c Client) GetCtx(ctx context.Context, id string, expand map[string]interface{}) (IssuingHolder, Error.StarkErrors) {
+	//	Retrieve a specific IssuingHolder by its id
 	var issuingHolder IssuingHolder
-	get, err := utils.Get(resource, id, expand, user)
-	unmarshalError := json.Unmarshal(get, &issuingHolder)
-	if unmarshalError != nil {
+	get, err := c.Api.Get(ctx, resource, id, expand)
+	if err.Errors != nil {
 		return issuingHolder, err
 	}
+	err = c.Api.Unmarshal(get, &issuingHolder)
 	return issuingHolder, err
 }
 
@@ -106,29 +152,133 @@ func Query(params map[string]interface{}, user user.User) (chan IssuingHolder, c
 	//
 	//	Return:
 	//	- channel of IssuingHolder structs with updated attributes
-	var issuingHolder IssuingHolder
+	return Client{Api: utils.Default(user)}.Query(params)
+}
+
+func QueryCtx(ctx context.Context, params map[string]interface{}, user user.User) (chan IssuingHolder, chan Error.StarkErrors) {
+	//	Retrieve IssuingHolders
+	//
+	//	Same as Query, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.QueryCtx(ctx, params)
+}
+
+func (c Client) Query(params map[string]interface{}) (chan IssuingHolder, chan Error.StarkErrors) {
+	//	Retrieve IssuingHolders
+	return c.QueryCtx(context.Background(), params)
+}
+
+func (c Client) QueryCtx(ctx context.Context, params map[string]interface{}) (chan IssuingHolder, chan Error.StarkErrors) {
+	//	Retrieve IssuingHolders
 	holders := make(chan IssuingHolder)
 	holdersError := make(chan Error.StarkErrors)
-	query, errorChannel := utils.Query(resource, params, user)
+	query, errorChannel := c.Api.Stream(ctx, resource, params)
 	go func() {
+		defer close(holdersError)
+		defer close(holders)
 		for content := range query {
-			contentByte, _ := json.Marshal(content)
-			err := json.Unmarshal(contentByte, &issuingHolder)
-			if err != nil {
-				holdersError <- Error.UnknownError(err.Error())
+			var issuingHolder IssuingHolder
+			err := c.Api.Unmarshal(content, &issuingHolder)
+			if err.Errors != nil {
+				if !utils.SendError(ctx, holdersError, err) {
+					return
+				}
 				continue
 			}
-			holders <- issuingHolder
+			select {
+			case holders <- issuingHolder:
+			case <-ctx.Done():
+				return
+			}
 		}
 		for err := range errorChannel {
-			holdersError <- err
+			if !utils.SendError(ctx, holdersError, err) {
+				return
+			}
 		}
-		close(holders)
-		close(holdersError)
 	}()
 	return holders, holdersError
 }
 
+//	IssuingHolder Iterator struct
+//
+//	Returned by Iterate to walk through IssuingHolder structs one at a time,
+//	requesting a new page only once the current one is exhausted. Check Err
+//	once Next returns false and Close it when leaving the loop early.
+
+type Iterator struct {
+	api   *utils.Client
+	pages *utils.Iterator
+	value IssuingHolder
+	err   Error.StarkErrors
+}
+
+func Iterate(params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve IssuingHolders
+	//
+	//	Same as Query, but returns an Iterator instead of a pair of channels
+	//
+	//	Example:
+	//	iterator := Iterate(params, nil)
+	//	defer iterator.Close()
+	//	for iterator.Next() {
+	//		fmt.Println(iterator.Value())
+	//	}
+	//	if err := iterator.Err(); err.Errors != nil {
+	//		...
+	//	}
+	return Client{Api: utils.Default(user)}.Iterate(params)
+}
+
+func IterateCtx(ctx context.Context, params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve IssuingHolders
+	//
+	//	Same as Iterate, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.IterateCtx(ctx, params)
+}
+
+func (c Client) Iterate(params map[string]interface{}) *Iterator {
+	//	Retrieve IssuingHolders
+	return c.IterateCtx(context.Background(), params)
+}
+
+func (c Client) IterateCtx(ctx context.Context, params map[string]interface{}) *Iterator {
+	//	Retrieve IssuingHolders
+	return &Iterator{api: c.Api, pages: c.Api.Iterate(ctx, resource, params)}
+}
+
+func (i *Iterator) Next() bool {
+	//	Move to the next IssuingHolder, returning false when there are no more or a request failed
+	var value IssuingHolder
+	if i.err.Errors != nil || !i.pages.Next() {
+		return false
+	}
+	i.err = i.api.Unmarshal(i.pages.Value(), &value)
+	if i.err.Errors != nil {
+		i.pages.Close()
+		return false
+	}
+	i.value = value
+	return true
+}
+
+func (i *Iterator) Value() IssuingHolder {
+	//	Current IssuingHolder, set by the last call to Next
+	return i.value
+}
+
+func (i *Iterator) Err() Error.StarkErrors {
+	//	Error that stopped the iteration, if any
+	if i.err.Errors != nil {
+		return i.err
+	}
+	return i.pages.Err()
+}
+
+func (i *Iterator) Close() {
+	//	Stop the iteration, releasing the current page
+	i.pages.Close()
+}
+
 func Page(params map[string]interface{}, user user.User) ([]IssuingHolder, string, Error.StarkErrors) {
 	//	Retrieve IssuingHolders
 	//
@@ -150,12 +300,29 @@ func Page(params map[string]interface{}, user user.User) ([]IssuingHolder, strin
 	//	Return:
 	//	- slice of IssuingHolder structs with updated attributes
 	//	- cursor to retrieve the next page of IssuingHolder structs
+	return Client{Api: utils.Default(user)}.Page(params)
+}
+
+func PageCtx(ctx context.Context, params map[string]interface{}, user user.User) ([]IssuingHolder, string, Error.StarkErrors) {
+	//	Retrieve IssuingHolders
+	//
+	//	Same as Page, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.PageCtx(ctx, params)
+}
+
+func (c Client) Page(params map[string]interface{}) ([]IssuingHolder, string, Error.StarkErrors) {
+	//	Retrieve IssuingHolders
+	return c.PageCtx(context.Background(), params)
+}
+
+func (c Client) PageCtx(ctx context.Context, params map[string]interface{}) ([]IssuingHolder, string, Error.StarkErrors) {
+	//	Retrieve IssuingHolders
 	var issuingHolders []IssuingHolder
-	page, cursor, err := utils.Page(resource, params, user)
-	unmarshalError := json.Unmarshal(page, &issuingHolders)
-	if unmarshalError != nil {
+	page, cursor, err := c.Api.Page(ctx, resource, params)
+	if err.Errors != nil {
 		return issuingHolders, cursor, err
 	}
+	err = c.Api.Unmarshal(page, &issuingHolders)
 	return issuingHolders, cursor, err
 }
 
@@ -178,12 +345,29 @@ func Update(id string, patchData map[string]interface{}, user user.User) (Issuin
 	//
 	//	Return:
 	//	- target IssuingHolder with updated attributes
+	return Client{Api: utils.Default(user)}.Update(id, patchData)
+}
+
+func UpdateCtx(ctx context.Context, id string, patchData map[string]interface{}, user user.User) (IssuingHolder, Error.StarkErrors) {
+	//	Update IssuingHolder entity
+	//
+	//	Same as Update, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.UpdateCtx(ctx, id, patchData)
+}
+
+func (c Client) Update(id string, patchData map[string]interface{}) (IssuingHolder, Error.StarkErrors) {
+	//	Update IssuingHolder entity
+	return c.UpdateCtx(context.Background(), id, patchData)
+}
+
+func (c Client) UpdateCtx(ctx context.Context, id string, patchData map[string]interface{}) (IssuingHolder, Error.StarkErrors) {
+	//	Update IssuingHolder entity
 	var issuingHolder IssuingHolder
-	update, err := utils.Patch(resource, id, patchData, user)
-	unmarshalError := json.Unmarshal(update, &issuingHolder)
-	if unmarshalError != nil {
+	update, err := c.Api.Patch(ctx, resource, id, patchData)
+	if err.Errors != nil {
 		return issuingHolder, err
 	}
+	err = c.Api.Unmarshal(update, &issuingHolder)
 	return issuingHolder, err
 }
 
@@ -200,11 +384,28 @@ func Cancel(id string, user user.User) (IssuingHolder, Error.StarkErrors) {
 	//
 	//	Return:
 	//	- canceled IssuingHolder struct
+	return Client{Api: utils.Default(user)}.Cancel(id)
+}
+
+func CancelCtx(ctx context.Context, id string, user user.User) (IssuingHolder, Error.StarkErrors) {
+	//	Cancel an IssuingHolder entity
+	//
+	//	Same as Cancel, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.CancelCtx(ctx, id)
+}
+
+func (c Client) Cancel(id string) (IssuingHolder, Error.StarkErrors) {
+	//	Cancel an IssuingHolder entity
+	return c.CancelCtx(context.Background(), id)
+}
+
+func (c Client) CancelCtx(ctx context.Context, id string) (IssuingHolder, Error.StarkErrors) {
+	//	Cancel an IssuingHolder entity
 	var issuingHolder IssuingHolder
-	deleted, err := utils.Delete(resource, id, user)
-	unmarshalError := json.Unmarshal(deleted, &issuingHolder)
-	if unmarshalError != nil {
+	deleted, err := c.Api.Delete(ctx, resource, id)
+	if err.Errors != nil {
 		return issuingHolder, err
 	}
+	err = c.Api.Unmarshal(deleted, &issuingHolder)
 	return issuingHolder, err
 }
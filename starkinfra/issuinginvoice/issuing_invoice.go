@@ -1,6 +1,7 @@
 package issuinginvoice
 
 import (
+	"context"
 	"encoding/json"
 	Error "github.com/starkinfra/core-go/starkcore/error"
 	"github.com/starkinfra/core-go/starkcore/user/user"
@@ -29,24 +30,35 @@ import (
 //	- IssuingTransactionId [string]: ledger transaction ids linked to this IssuingInvoice. ex: "issuing-invoice/5656565656565656"
 //	- Updated [time.Time]: latest update datetime for the IssuingInvoice. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC),
 //	- Created [time.Time]: creation datetime for the IssuingInvoice. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC),
+//	- Extra [map[string]json.RawMessage]: Fields of the IssuingInvoice sent by the API but not declared in this SDK version yet, keyed by their JSON name. ex: map[string]json.RawMessage{"newField": json.RawMessage(`"value"`)}
 
 type IssuingInvoice struct {
-	Id                   string     `json:",omitempty"`
-	Amount               int        `json:",omitempty"`
-	TaxId                string     `json:",omitempty"`
-	Name                 string     `json:",omitempty"`
-	Tags                 []string   `json:",omitempty"`
-	Brcode               string     `json:",omitempty"`
-	Due                  *time.Time `json:",omitempty"`
-	Link                 string     `json:",omitempty"`
-	Status               string     `json:",omitempty"`
-	IssuingTransactionId string     `json:",omitempty"`
-	Updated              *time.Time `json:",omitempty"`
-	Created              *time.Time `json:",omitempty"`
+	Id                   string                     `json:",omitempty"`
+	Amount               int                        `json:",omitempty"`
+	TaxId                string                     `json:",omitempty"`
+	Name                 string                     `json:",omitempty"`
+	Tags                 []string                   `json:",omitempty"`
+	Brcode               string                     `json:",omitempty"`
+	Due                  *time.Time                 `json:",omitempty"`
+	Link                 string                     `json:",omitempty"`
+	Status               string                     `json:",omitempty"`
+	IssuingTransactionId string                     `json:",omitempty"`
+	Updated              *time.Time                 `json:",omitempty"`
+	Created              *time.Time                 `json:",omitempty"`
+	Extra                map[string]json.RawMessage `json:"-"`
 }
 
 var resource = map[string]string{"name": "IssuingInvoice"}
 
+//	IssuingInvoice Client struct
+//
+//	Runs the IssuingInvoice functions with the settings of its own utils.Client
+//	instead of starkinfra.User and the other package defaults.
+
+type Client struct {
+	Api *utils.Client
+}
+
 func Create(invoice IssuingInvoice, user user.User) (IssuingInvoice, Error.StarkErrors) {
 	//	Create an IssuingInvoice
 	//
@@ -60,11 +72,28 @@ func Create(invoice IssuingInvoice, user user.User) (IssuingInvoice, Error.Stark
 	//
 	//	Return:
 	//	- issuingInvoice struct with updated attributes
-	create, err := utils.Single(resource, invoice, user)
-	unmarshalError := json.Unmarshal(create, &invoice)
-	if unmarshalError != nil {
+	return Client{Api: utils.Default(user)}.Create(invoice)
+}
+
+func CreateCtx(ctx context.Context, invoice IssuingInvoice, user user.User) (IssuingInvoice, Error.StarkErrors) {
+	//	Create an IssuingInvoice
+	//
+	//	Same as Create, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.CreateCtx(ctx, invoice)
+}
+
+func (c Client) Create(invoice IssuingInvoice) (IssuingInvoice, Error.StarkErrors) {
+	//	Create an IssuingInvoice
+	return c.CreateCtx(context.Background(), invoice)
+}
+
+func (c Client) CreateCtx(ctx context.Context, invoice IssuingInvoice) (IssuingInvoice, Error.StarkErrors) {
+	//	Create an IssuingInvoice
+	create, err := c.Api.Single(ctx, resource, invoice)
+	if err.Errors != nil {
 		return invoice, err
 	}
+	err = c.Api.Unmarshal(create, &invoice)
 	return invoice, err
 }
 
@@ -81,12 +110,29 @@ func Get(id string, user user.User) (IssuingInvoice, Error.StarkErrors) {
 	//
 	//	Return:
 	//	- issuingInvoice struct that corresponds to the given id.
+	return Client{Api: utils.Default(user)}.Get(id)
+}
+
+func GetCtx(ctx context.Context, id string, user user.User) (IssuingInvoice, Error.StarkErrors) {
+	//	Retrieve a specific IssuingInvoice by its id
+	//
+	//	Same as Get, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.GetCtx(ctx, id)
+}
+
+func (c Client) Get(id string) (IssuingInvoice, Error.StarkErrors) {
+	//	Retrieve a specific IssuingInvoice by its id
+	return c.GetCtx(context.Background(), id)
+}
+
+func (c Client) GetCtx(ctx context.Context, id string) (IssuingInvoice, Error.StarkErrors) {
+	//	Retrieve a specific IssuingInvoice by its id
 	var issuingInvoice IssuingInvoice
-	get, err := utils.Get(resource, id, nil, user)
-	unmarshalError := json.Unmarshal(get, &issuingInvoice)
-	if unmarshalError != nil {
+	get, err := c.Api.Get(ctx, resource, id, nil)
+	if err.Errors != nil {
 		return issuingInvoice, err
 	}
+	err = c.Api.Unmarshal(get, &issuingInvoice)
 	return issuingInvoice, err
 }
 
@@ -106,29 +152,133 @@ func Query(params map[string]interface{}, user user.User) (chan IssuingInvoice,
 	//
 	//	Return:
 	//	- channel of IssuingInvoices structs with updated attributes
-	var issuingInvoice IssuingInvoice
+	return Client{Api: utils.Default(user)}.Query(params)
+}
+
+func QueryCtx(ctx context.Context, params map[string]interface{}, user user.User) (chan IssuingInvoice, chan Error.StarkErrors) {
+	//	Retrieve IssuingInvoice
+	//
+	//	Same as Query, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.QueryCtx(ctx, params)
+}
+
+func (c Client) Query(params map[string]interface{}) (chan IssuingInvoice, chan Error.StarkErrors) {
+	//	Retrieve IssuingInvoice
+	return c.QueryCtx(context.Background(), params)
+}
+
+func (c Client) QueryCtx(ctx context.Context, params map[string]interface{}) (chan IssuingInvoice, chan Error.StarkErrors) {
+	//	Retrieve IssuingInvoice
 	invoices := make(chan IssuingInvoice)
 	invoicesError := make(chan Error.StarkErrors)
-	query, errorChannel := utils.Query(resource, params, user)
+	query, errorChannel := c.Api.Stream(ctx, resource, params)
 	go func() {
+		defer close(invoicesError)
+		defer close(invoices)
 		for content := range query {
-			contentByte, _ := json.Marshal(content)
-			err := json.Unmarshal(contentByte, &issuingInvoice)
-			if err != nil {
-				invoicesError <- Error.UnknownError(err.Error())
+			var issuingInvoice IssuingInvoice
+			err := c.Api.Unmarshal(content, &issuingInvoice)
+			if err.Errors != nil {
+				if !utils.SendError(ctx, invoicesError, err) {
+					return
+				}
 				continue
 			}
-			invoices <- issuingInvoice
+			select {
+			case invoices <- issuingInvoice:
+			case <-ctx.Done():
+				return
+			}
 		}
 		for err := range errorChannel {
-			invoicesError <- err
+			if !utils.SendError(ctx, invoicesError, err) {
+				return
+			}
 		}
-		close(invoices)
-		close(invoicesError)
 	}()
 	return invoices, invoicesError
 }
 
+//	IssuingInvoice Iterator struct
+//
+//	Returned by Iterate to walk through IssuingInvoice structs one at a time,
+//	requesting a new page only once the current one is exhausted. Check Err
+//	once Next returns false and Close it when leaving the loop early.
+
+type Iterator struct {
+	api   *utils.Client
+	pages *utils.Iterator
+	value IssuingInvoice
+	err   Error.StarkErrors
+}
+
+func Iterate(params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve IssuingInvoice
+	//
+	//	Same as Query, but returns an Iterator instead of a pair of channels
+	//
+	//	Example:
+	//	iterator := Iterate(params, nil)
+	//	defer iterator.Close()
+	//	for iterator.Next() {
+	//		fmt.Println(iterator.Value())
+	//	}
+	//	if err := iterator.Err(); err.Errors != nil {
+	//		...
+	//	}
+	return Client{Api: utils.Default(user)}.Iterate(params)
+}
+
+func IterateCtx(ctx context.Context, params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve IssuingInvoice
+	//
+	//	Same as Iterate, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.IterateCtx(ctx, params)
+}
+
+func (c Client) Iterate(params map[string]interface{}) *Iterator {
+	//	Retrieve IssuingInvoice
+	return c.IterateCtx(context.Background(), params)
+}
+
+func (c Client) IterateCtx(ctx context.Context, params map[string]interface{}) *Iterator {
+	//	Retrieve IssuingInvoice
+	return &Iterator{api: c.Api, pages: c.Api.Iterate(ctx, resource, params)}
+}
+
+func (i *Iterator) Next() bool {
+	//	Move to the next IssuingInvoice, returning false when there are no more or a request failed
+	var value IssuingInvoice
+	if i.err.Errors != nil || !i.pages.Next() {
+		return false
+	}
+	i.err = i.api.Unmarshal(i.pages.Value(), &value)
+	if i.err.Errors != nil {
+		i.pages.Close()
+		return false
+	}
+	i.value = value
+	return true
+}
+
+func (i *Iterator) Value() IssuingInvoice {
+	//	Current IssuingInvoice, set by the last call to Next
+	return i.value
+}
+
+func (i *Iterator) Err() Error.StarkErrors {
+	//	Error that stopped the iteration, if any
+	if i.err.Errors != nil {
+		return i.err
+	}
+	return i.pages.Err()
+}
+
+func (i *Iterator) Close() {
+	//	Stop the iteration, releasing the current page
+	i.pages.Close()
+}
+
 func Page(params map[string]interface{}, user user.User) ([]IssuingInvoice, string, Error.StarkErrors) {
 	//	Retrieve IssuingInvoices
 	//
@@ -148,11 +298,28 @@ func Page(params map[string]interface{}, user user.User) ([]IssuingInvoice, stri
 	//	Return:
 	//	- slice of IssuingInvoices structs with updated attributes
 	//	- cursor to retrieve the next page of IssuingInvoices structs
+	return Client{Api: utils.Default(user)}.Page(params)
+}
+
+func PageCtx(ctx context.Context, params map[string]interface{}, user user.User) ([]IssuingInvoice, string, Error.StarkErrors) {
+	//	Retrieve IssuingInvoices
+	//
+	//	Same as Page, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.PageCtx(ctx, params)
+}
+
+func (c Client) Page(params map[string]interface{}) ([]IssuingInvoice, string, Error.StarkErrors) {
+	//	Retrieve IssuingInvoices
+	return c.PageCtx(context.Background(), params)
+}
+
+func (c Client) PageCtx(ctx context.Context, params map[string]interface{}) ([]IssuingInvoice, string, Error.StarkErrors) {
+	//	Retrieve IssuingInvoices
 	var issuingInvoices []IssuingInvoice
-	page, cursor, err := utils.Page(resource, params, user)
-	unmarshalError := json.Unmarshal(page, &issuingInvoices)
-	if unmarshalError != nil {
+	page, cursor, err := c.Api.Page(ctx, resource, params)
+	if err.Errors != nil {
 		return issuingInvoices, cursor, err
 	}
+	err = c.Api.Unmarshal(page, &issuingInvoices)
 	return issuingInvoices, cursor, err
 }
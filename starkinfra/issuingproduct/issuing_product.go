@@ -1,6 +1,7 @@
 package issuingproduct
 
 import (
+	"context"
 	"encoding/json"
 	Error "github.com/starkinfra/core-go/starkcore/error"
 	"github.com/starkinfra/core-go/starkcore/user/user"
@@ -21,19 +22,30 @@ import (
 //  - Code [string]: Internal code from card flag informing the product. ex: "MRW", "MCO", "MWB", "MCS"
 //  - CustomerType [string]: Same as holderType. Kept for backward compatibility. ex: "business", "individual"
 //  - Created [time.Time]: Creation datetime for the IssuingProduct. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC),
+//	- Extra [map[string]json.RawMessage]: Fields of the IssuingProduct sent by the API but not declared in this SDK version yet, keyed by their JSON name. ex: map[string]json.RawMessage{"newField": json.RawMessage(`"value"`)}
 
 type IssuingProduct struct {
-	Id           string     `json:",omitempty"`
-	Network      string     `json:",omitempty"`
-	FundingType  string     `json:",omitempty"`
-	HolderType   string     `json:",omitempty"`
-	Code         string     `json:",omitempty"`
-	CustomerType string     `json:",omitempty"`
-	Created      *time.Time `json:",omitempty"`
+	Id           string                     `json:",omitempty"`
+	Network      string                     `json:",omitempty"`
+	FundingType  string                     `json:",omitempty"`
+	HolderType   string                     `json:",omitempty"`
+	Code         string                     `json:",omitempty"`
+	CustomerType string                     `json:",omitempty"`
+	Created      *time.Time                 `json:",omitempty"`
+	Extra        map[string]json.RawMessage `json:"-"`
 }
 
 var resource = map[string]string{"name": "IssuingProduct"}
 
+//	IssuingProduct Client struct
+//
+//	Runs the IssuingProduct functions with the settings of its own utils.Client
+//	instead of starkinfra.User and the other package defaults.
+
+type Client struct {
+	Api *utils.Client
+}
+
 func Query(params map[string]interface{}, user user.User) (chan IssuingProduct, chan Error.StarkErrors) {
 	//	Retrieve IssuingProduct structs
 	//
@@ -46,29 +58,133 @@ func Query(params map[string]interface{}, user user.User) (chan IssuingProduct,
 	//
 	//	Return:
 	//	- channel of IssuingBin structs with updated attributes
-	var issuingProduct IssuingProduct
+	return Client{Api: utils.Default(user)}.Query(params)
+}
+
+func QueryCtx(ctx context.Context, params map[string]interface{}, user user.User) (chan IssuingProduct, chan Error.StarkErrors) {
+	//	Retrieve IssuingProduct structs
+	//
+	//	Same as Query, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.QueryCtx(ctx, params)
+}
+
+func (c Client) Query(params map[string]interface{}) (chan IssuingProduct, chan Error.StarkErrors) {
+	//	Retrieve IssuingProduct structs
+	return c.QueryCtx(context.Background(), params)
+}
+
+func (c Client) QueryCtx(ctx context.Context, params map[string]interface{}) (chan IssuingProduct, chan Error.StarkErrors) {
+	//	Retrieve IssuingProduct structs
 	products := make(chan IssuingProduct)
 	productsError := make(chan Error.StarkErrors)
-	query, errorChannel := utils.Query(resource, params, user)
+	query, errorChannel := c.Api.Stream(ctx, resource, params)
 	go func() {
+		defer close(productsError)
+		defer close(products)
 		for content := range query {
-			contentByte, _ := json.Marshal(content)
-			err := json.Unmarshal(contentByte, &issuingProduct)
-			if err != nil {
-				productsError <- Error.UnknownError(err.Error())
+			var issuingProduct IssuingProduct
+			err := c.Api.Unmarshal(content, &issuingProduct)
+			if err.Errors != nil {
+				if !utils.SendError(ctx, productsError, err) {
+					return
+				}
 				continue
 			}
-			products <- issuingProduct
+			select {
+			case products <- issuingProduct:
+			case <-ctx.Done():
+				return
+			}
 		}
 		for err := range errorChannel {
-			productsError <- err
+			if !utils.SendError(ctx, productsError, err) {
+				return
+			}
 		}
-		close(products)
-		close(productsError)
 	}()
 	return products, productsError
 }
 
+//	IssuingProduct Iterator struct
+//
+//	Returned by Iterate to walk through IssuingProduct structs one at a time,
+//	requesting a new page only once the current one is exhausted. Check Err
+//	once Next returns false and Close it when leaving the loop early.
+
+type Iterator struct {
+	api   *utils.Client
+	pages *utils.Iterator
+	value IssuingProduct
+	err   Error.StarkErrors
+}
+
+func Iterate(params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve IssuingProduct structs
+	//
+	//	Same as Query, but returns an Iterator instead of a pair of channels
+	//
+	//	Example:
+	//	iterator := Iterate(params, nil)
+	//	defer iterator.Close()
+	//	for iterator.Next() {
+	//		fmt.Println(iterator.Value())
+	//	}
+	//	if err := iterator.Err(); err.Errors != nil {
+	//		...
+	//	}
+	return Client{Api: utils.Default(user)}.Iterate(params)
+}
+
+func IterateCtx(ctx context.Context, params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve IssuingProduct structs
+	//
+	//	Same as Iterate, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.IterateCtx(ctx, params)
+}
+
+func (c Client) Iterate(params map[string]interface{}) *Iterator {
+	//	Retrieve IssuingProduct structs
+	return c.IterateCtx(context.Background(), params)
+}
+
+func (c Client) IterateCtx(ctx context.Context, params map[string]interface{}) *Iterator {
+	//	Retrieve IssuingProduct structs
+	return &Iterator{api: c.Api, pages: c.Api.Iterate(ctx, resource, params)}
+}
+
+func (i *Iterator) Next() bool {
+	//	Move to the next IssuingProduct, returning false when there are no more or a request failed
+	var value IssuingProduct
+	if i.err.Errors != nil || !i.pages.Next() {
+		return false
+	}
+	i.err = i.api.Unmarshal(i.pages.Value(), &value)
+	if i.err.Errors != nil {
+		i.pages.Close()
+		return false
+	}
+	i.value = value
+	return true
+}
+
+func (i *Iterator) Value() IssuingProduct {
+	//	Current IssuingProduct, set by the last call to Next
+	return i.value
+}
+
+func (i *Iterator) Err() Error.StarkErrors {
+	//	Error that stopped the iteration, if any
+	if i.err.Errors != nil {
+		return i.err
+	}
+	return i.pages.Err()
+}
+
+func (i *Iterator) Close() {
+	//	Stop the iteration, releasing the current page
+	i.pages.Close()
+}
+
 func Page(params map[string]interface{}, user user.User) ([]IssuingProduct, string, Error.StarkErrors) {
 	//	Retrieve paged IssuingProduct structs
 	//
@@ -84,11 +200,28 @@ func Page(params map[string]interface{}, user user.User) ([]IssuingProduct, stri
 	//	Return:
 	//	- slice of IssuingProduct structs with updated attributes
 	//	- cursor to retrieve the next page of IssuingProduct structs
+	return Client{Api: utils.Default(user)}.Page(params)
+}
+
+func PageCtx(ctx context.Context, params map[string]interface{}, user user.User) ([]IssuingProduct, string, Error.StarkErrors) {
+	//	Retrieve paged IssuingProduct structs
+	//
+	//	Same as Page, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.PageCtx(ctx, params)
+}
+
+func (c Client) Page(params map[string]interface{}) ([]IssuingProduct, string, Error.StarkErrors) {
+	//	Retrieve paged IssuingProduct structs
+	return c.PageCtx(context.Background(), params)
+}
+
+func (c Client) PageCtx(ctx context.Context, params map[string]interface{}) ([]IssuingProduct, string, Error.StarkErrors) {
+	//	Retrieve paged IssuingProduct structs
 	var issuingProducts []IssuingProduct
-	page, cursor, err := utils.Page(resource, params, user)
-	unmarshalError := json.Unmarshal(page, &issuingProducts)
-	if unmarshalError != nil {
+	page, cursor, err := c.Api.Page(ctx, resource, params)
+	if err.Errors != nil {
 		return issuingProducts, cursor, err
 	}
+	err = c.Api.Unmarshal(page, &issuingProducts)
 	return issuingProducts, cursor, err
 }
@@ -0,0 +1,186 @@
+package issuingpurchase
+
+import (
+	"fmt"
+	Error "github.com/starkinfra/core-go/starkcore/error"
+	"github.com/starkinfra/sdk-go/starkinfra/utils"
+)
+
+//	IssuingPurchase Authorization struct
+//
+//	Answer to an IssuingPurchase authorization request. Build it with Approve,
+//	ApprovePartial or Deny, and turn it into the JSON to be returned with
+//	Response, which refuses invalid answers before they reach the API.
+//
+//	Attributes:
+//	- Status [string]: Sub-issuer response to the authorization. ex: StatusApproved or StatusDenied
+//	- Reason [Reason]: Denial reason, required for denials and refused for approvals. ex: ReasonInsufficientBalance
+//	- Amount [pointer to int, default nil]: Amount in cents that was authorized, for partial approvals only. ex: 1234 (= R$ 12.34)
+//	- Tags [slice of strings, default nil]: Tags to be added to the IssuingPurchase. ex: []string{"tony", "stark"}
+
+type Authorization struct {
+	Status string
+	Reason Reason
+	Amount *int
+	Tags   []string
+}
+
+//	IssuingPurchase authorization Reason
+//
+//	Reason for denying an IssuingPurchase, one of the Reason* constants.
+
+type Reason string
+
+const StatusApproved = "approved"
+const StatusDenied = "denied"
+
+const ReasonOther Reason = "other"
+const ReasonBlocked Reason = "blocked"
+const ReasonLostCard Reason = "lostCard"
+const ReasonStolenCard Reason = "stolenCard"
+const ReasonInvalidPin Reason = "invalidPin"
+const ReasonInvalidCard Reason = "invalidCard"
+const ReasonCardExpired Reason = "cardExpired"
+const ReasonIssuerError Reason = "issuerError"
+const ReasonConcurrency Reason = "concurrency"
+const ReasonStandInDenial Reason = "standInDenial"
+const ReasonSubIssuerError Reason = "subIssuerError"
+const ReasonInvalidPurpose Reason = "invalidPurpose"
+const ReasonInvalidZipCode Reason = "invalidZipCode"
+const ReasonInvalidWalletId Reason = "invalidWalletId"
+const ReasonInconsistentCard Reason = "inconsistentCard"
+const ReasonSettlementFailed Reason = "settlementFailed"
+const ReasonCardRuleMismatch Reason = "cardRuleMismatch"
+const ReasonInvalidExpiration Reason = "invalidExpiration"
+const ReasonPrepaidInstallment Reason = "prepaidInstallment"
+const ReasonHolderRuleMismatch Reason = "holderRuleMismatch"
+const ReasonInsufficientBalance Reason = "insufficientBalance"
+const ReasonTooManyTransactions Reason = "tooManyTransactions"
+const ReasonInvalidSecurityCode Reason = "invalidSecurityCode"
+const ReasonInvalidPaymentMethod Reason = "invalidPaymentMethod"
+const ReasonConfirmationDeadline Reason = "confirmationDeadline"
+const ReasonWithdrawalAmountLimit Reason = "withdrawalAmountLimit"
+const ReasonInsufficientCardLimit Reason = "insufficientCardLimit"
+const ReasonInsufficientHolderLimit Reason = "insufficientHolderLimit"
+
+var reasons = map[Reason]bool{
+	ReasonOther:                   true,
+	ReasonBlocked:                 true,
+	ReasonLostCard:                true,
+	ReasonStolenCard:              true,
+	ReasonInvalidPin:              true,
+	ReasonInvalidCard:             true,
+	ReasonCardExpired:             true,
+	ReasonIssuerError:             true,
+	ReasonConcurrency:             true,
+	ReasonStandInDenial:           true,
+	ReasonSubIssuerError:          true,
+	ReasonInvalidPurpose:          true,
+	ReasonInvalidZipCode:          true,
+	ReasonInvalidWalletId:         true,
+	ReasonInconsistentCard:        true,
+	ReasonSettlementFailed:        true,
+	ReasonCardRuleMismatch:        true,
+	ReasonInvalidExpiration:       true,
+	ReasonPrepaidInstallment:      true,
+	ReasonHolderRuleMismatch:      true,
+	ReasonInsufficientBalance:     true,
+	ReasonTooManyTransactions:     true,
+	ReasonInvalidSecurityCode:     true,
+	ReasonInvalidPaymentMethod:    true,
+	ReasonConfirmationDeadline:    true,
+	ReasonWithdrawalAmountLimit:   true,
+	ReasonInsufficientCardLimit:   true,
+	ReasonInsufficientHolderLimit: true,
+}
+
+func Approve() Authorization {
+	//	Approve an IssuingPurchase for its whole amount
+	return Authorization{Status: StatusApproved}
+}
+
+func ApprovePartial(amount int) Authorization {
+	//	Approve part of an IssuingPurchase
+	//
+	//	Parameters (required):
+	//	- amount [int]: Amount in cents that was authorized, above 0 and up to the IssuingPurchase Amount. ex: 1234 (= R$ 12.34)
+	return Authorization{Status: StatusApproved, Amount: &amount}
+}
+
+func Deny(reason Reason) Authorization {
+	//	Deny an IssuingPurchase
+	//
+	//	Parameters (required):
+	//	- reason [Reason]: Denial reason. ex: ReasonInsufficientBalance
+	return Authorization{Status: StatusDenied, Reason: reason}
+}
+
+func (a Authorization) Validate() Error.StarkErrors {
+	//	Check the Authorization before it is sent
+	//
+	//	Return:
+	//	- inputError if the Status is unknown, a denial has no known Reason or carries an Amount,
+	//	  an approval has a Reason, a partial approval has an Amount that is not positive or a tag is empty
+	switch a.Status {
+	case StatusApproved:
+		if a.Reason != "" {
+			return utils.ValidationError(fmt.Sprintf("An approved IssuingPurchase takes no reason, got %v", a.Reason))
+		}
+		if a.Amount != nil && *a.Amount <= 0 {
+			return utils.ValidationError(fmt.Sprintf("The amount of a partial approval must be positive, got %v", *a.Amount))
+		}
+	case StatusDenied:
+		if !reasons[a.Reason] {
+			return utils.ValidationError(fmt.Sprintf("A denied IssuingPurchase needs one of the Reason constants, got %q", a.Reason))
+		}
+		if a.Amount != nil {
+			return utils.ValidationError(fmt.Sprintf("A denied IssuingPurchase takes no amount, got %v", *a.Amount))
+		}
+	default:
+		return utils.ValidationError(fmt.Sprintf("The status of an IssuingPurchase authorization must be %q or %q, got %q", StatusApproved, StatusDenied, a.Status))
+	}
+	for _, tag := range a.Tags {
+		if tag == "" {
+			return utils.ValidationError("The tags of an IssuingPurchase authorization cannot be empty")
+		}
+	}
+	return Error.StarkErrors{}
+}
+
+func (a Authorization) ValidateFor(purchase IssuingPurchase) Error.StarkErrors {
+	//	Check the Authorization against the IssuingPurchase it answers
+	//
+	//	Parameters (required):
+	//	- purchase [IssuingPurchase struct]: IssuingPurchase received in the authorization request
+	//
+	//	Return:
+	//	- inputError if Validate fails or a partial approval exceeds the IssuingPurchase Amount
+	if err := a.Validate(); err.Errors != nil {
+		return err
+	}
+	if a.Amount != nil && *a.Amount > purchase.Amount {
+		return utils.ValidationError(fmt.Sprintf("A partial approval cannot exceed the IssuingPurchase amount of %v, got %v", purchase.Amount, *a.Amount))
+	}
+	return Error.StarkErrors{}
+}
+
+func (a Authorization) Response() (string, Error.StarkErrors) {
+	//	Build the JSON string that must be returned to us on the IssuingPurchase request
+	//
+	//	Return:
+	//	- same JSON string as the Response function, or an inputError if Validate fails
+	if err := a.Validate(); err.Errors != nil {
+		return "", err
+	}
+	authorization := map[string]interface{}{"status": a.Status}
+	if a.Reason != "" {
+		authorization["reason"] = string(a.Reason)
+	}
+	if a.Amount != nil {
+		authorization["amount"] = *a.Amount
+	}
+	if len(a.Tags) > 0 {
+		authorization["tags"] = a.Tags
+	}
+	return Response(authorization), Error.StarkErrors{}
+}
@@ -1,6 +1,7 @@
 package issuingpurchase
 
 import (
+	"context"
 	"encoding/json"
 	Error "github.com/starkinfra/core-go/starkcore/error"
 	"github.com/starkinfra/core-go/starkcore/user/user"
@@ -57,52 +58,63 @@ import (
 //	- IsPartialAllowed [bool]: True i the merchant allows partial purchases. ex: False
 //	- CardTags [slice of strings]: Tags of the IssuingCard responsible for this purchase. ex: []string{"travel", "food"}
 //	- HolderTags [slice of strings]: Tags of the IssuingHolder responsible for this purchase. ex: []string{"technology", "john snow"]
+//	- Extra [map[string]json.RawMessage]: Fields of the IssuingPurchase sent by the API but not declared in this SDK version yet, keyed by their JSON name. ex: map[string]json.RawMessage{"newField": json.RawMessage(`"value"`)}
 
 type IssuingPurchase struct {
-	Id                     string                 `json:",omitempty"`
-	HolderName             string                 `json:",omitempty"`
-	CardId                 string                 `json:",omitempty"`
-	CardEnding             string                 `json:",omitempty"`
-	Purpose                string                 `json:",omitempty"`
-	InstallmentCount       int                    `json:",omitempty"`
-	Amount                 int                    `json:",omitempty"`
-	Tax                    int                    `json:",omitempty"`
-	IssuerAmount           int                    `json:",omitempty"`
-	IssuerCurrencyCode     string                 `json:",omitempty"`
-	IssuerCurrencySymbol   string                 `json:",omitempty"`
-	MerchantAmount         int                    `json:",omitempty"`
-	MerchantCurrencyCode   string                 `json:",omitempty"`
-	MerchantCurrencySymbol string                 `json:",omitempty"`
-	MerchantCategoryCode   string                 `json:",omitempty"`
-	MerchantCategoryType   string                 `json:",omitempty"`
-	MerchantCountryCode    string                 `json:",omitempty"`
-	AcquirerId             string                 `json:",omitempty"`
-	MerchantId             string                 `json:",omitempty"`
-	MerchantName           string                 `json:",omitempty"`
-	MerchantFee            int                    `json:",omitempty"`
-	WalletId               string                 `json:",omitempty"`
-	MethodCode             string                 `json:",omitempty"`
-	Score                  float64                `json:",omitempty"`
-	EndToEndId             string                 `json:",omitempty"`
-	Tags                   []string               `json:",omitempty"`
-	IssuingTransactionIds  []string               `json:",omitempty"`
-	Status                 string                 `json:",omitempty"`
-	Updated                *time.Time             `json:",omitempty"`
-	Created                *time.Time             `json:",omitempty"`
-	IsPartialAllowed       bool                   `json:",omitempty"`
-	CardTags               []string               `json:",omitempty"`
-	HolderTags             []string               `json:",omitempty"`
-	ProductId              string                 `json:",omitempty"`
-	Description            string                 `json:",omitempty"`
-	HolderId               string                 `json:",omitempty"`
-	ZipCode                string                 `json:",omitempty"`
-	Metadata               map[string]interface{} `json:",omitempty"`
-	MerchantCategoryNumber int                    `json:",omitempty"`
-	Confirmed              *time.Time             `json:",omitempty"`
+	Id                     string                     `json:",omitempty"`
+	HolderName             string                     `json:",omitempty"`
+	CardId                 string                     `json:",omitempty"`
+	CardEnding             string                     `json:",omitempty"`
+	Purpose                string                     `json:",omitempty"`
+	InstallmentCount       int                        `json:",omitempty"`
+	Amount                 int                        `json:",omitempty"`
+	Tax                    int                        `json:",omitempty"`
+	IssuerAmount           int                        `json:",omitempty"`
+	IssuerCurrencyCode     string                     `json:",omitempty"`
+	IssuerCurrencySymbol   string                     `json:",omitempty"`
+	MerchantAmount         int                        `json:",omitempty"`
+	MerchantCurrencyCode   string                     `json:",omitempty"`
+	MerchantCurrencySymbol string                     `json:",omitempty"`
+	MerchantCategoryCode   string                     `json:",omitempty"`
+	MerchantCategoryType   string                     `json:",omitempty"`
+	MerchantCountryCode    string                     `json:",omitempty"`
+	AcquirerId             string                     `json:",omitempty"`
+	MerchantId             string                     `json:",omitempty"`
+	MerchantName           string                     `json:",omitempty"`
+	MerchantFee            int                        `json:",omitempty"`
+	WalletId               string                     `json:",omitempty"`
+	MethodCode             string                     `json:",omitempty"`
+	Score                  float64                    `json:",omitempty"`
+	EndToEndId             string                     `json:",omitempty"`
+	Tags                   []string                   `json:",omitempty"`
+	IssuingTransactionIds  []string                   `json:",omitempty"`
+	Status                 string                     `json:",omitempty"`
+	Updated                *time.Time                 `json:",omitempty"`
+	Created                *time.Time                 `json:",omitempty"`
+	IsPartialAllowed       bool                       `json:",omitempty"`
+	CardTags               []string                   `json:",omitempty"`
+	HolderTags             []string                   `json:",omitempty"`
+	ProductId              string                     `json:",omitempty"`
+	Description            string                     `json:",omitempty"`
+	HolderId               string                     `json:",omitempty"`
+	ZipCode                string                     `json:",omitempty"`
+	Metadata               map[string]interface{}     `json:",omitempty"`
+	MerchantCategoryNumber int                        `json:",omitempty"`
+	Confirmed              *time.Time                 `json:",omitempty"`
+	Extra                  map[string]json.RawMessage `json:"-"`
 }
 
 var resource = map[string]string{"name": "IssuingPurchase"}
 
+//	IssuingPurchase Client struct
+//
+//	Runs the IssuingPurchase functions with the settings of its own utils.Client
+//	instead of starkinfra.User and the other package defaults.
+
+type Client struct {
+	Api *utils.Client
+}
+
 func Get(id string, user user.User) (IssuingPurchase, Error.StarkErrors) {
 	//	Retrieve a specific IssuingPurchase by its id
 	//
@@ -116,12 +128,29 @@ func Get(id string, user user.User) (IssuingPurchase, Error.StarkErrors) {
 	//
 	//	Return:
 	//	- issuingPurchase struct that corresponds to the given id.
+	return Client{Api: utils.Default(user)}.Get(id)
+}
+
+func GetCtx(ctx context.Context, id string, user user.User) (IssuingPurchase, Error.StarkErrors) {
+	//	Retrieve a specific IssuingPurchase by its id
+	//
+	//	Same as Get, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.GetCtx(ctx, id)
+}
+
+func (c Client) Get(id string) (IssuingPurchase, Error.StarkErrors) {
+	//	Retrieve a specific IssuingPurchase by its id
+	return c.GetCtx(context.Background(), id)
+}
+
+func (c Client) GetCtx(ctx context.Context, id string) (IssuingPurchase, Error.StarkErrors) {
+	//	Retrieve a specific IssuingPurchase by its id
 	var issuingPurchase IssuingPurchase
-	get, err := utils.Get(resource, id, nil, user)
-	unmarshalError := json.Unmarshal(get, &issuingPurchase)
-	if unmarshalError != nil {
+	get, err := c.Api.Get(ctx, resource, id, nil)
+	if err.Errors != nil {
 		return issuingPurchase, err
 	}
+	err = c.Api.Unmarshal(get, &issuingPurchase)
 	return issuingPurchase, err
 }
 
@@ -144,29 +173,133 @@ func Query(params map[string]interface{}, user user.User) (chan IssuingPurchase,
 	//
 	//	Return:
 	//	- channel of IssuingPurchase structs with updated attributes
-	var issuingPurchase IssuingPurchase
+	return Client{Api: utils.Default(user)}.Query(params)
+}
+
+func QueryCtx(ctx context.Context, params map[string]interface{}, user user.User) (chan IssuingPurchase, chan Error.StarkErrors) {
+	//	Retrieve IssuingPurchase structs
+	//
+	//	Same as Query, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.QueryCtx(ctx, params)
+}
+
+func (c Client) Query(params map[string]interface{}) (chan IssuingPurchase, chan Error.StarkErrors) {
+	//	Retrieve IssuingPurchase structs
+	return c.QueryCtx(context.Background(), params)
+}
+
+func (c Client) QueryCtx(ctx context.Context, params map[string]interface{}) (chan IssuingPurchase, chan Error.StarkErrors) {
+	//	Retrieve IssuingPurchase structs
 	purchases := make(chan IssuingPurchase)
 	purchasesError := make(chan Error.StarkErrors)
-	query, errorChannel := utils.Query(resource, params, user)
+	query, errorChannel := c.Api.Stream(ctx, resource, params)
 	go func() {
+		defer close(purchasesError)
+		defer close(purchases)
 		for content := range query {
-			contentByte, _ := json.Marshal(content)
-			err := json.Unmarshal(contentByte, &issuingPurchase)
-			if err != nil {
-				purchasesError <- Error.UnknownError(err.Error())
+			var issuingPurchase IssuingPurchase
+			err := c.Api.Unmarshal(content, &issuingPurchase)
+			if err.Errors != nil {
+				if !utils.SendError(ctx, purchasesError, err) {
+					return
+				}
 				continue
 			}
-			purchases <- issuingPurchase
+			select {
+			case purchases <- issuingPurchase:
+			case <-ctx.Done():
+				return
+			}
 		}
 		for err := range errorChannel {
-			purchasesError <- err
+			if !utils.SendError(ctx, purchasesError, err) {
+				return
+			}
 		}
-		close(purchases)
-		close(purchasesError)
 	}()
 	return purchases, purchasesError
 }
 
+//	IssuingPurchase Iterator struct
+//
+//	Returned by Iterate to walk through IssuingPurchase structs one at a time,
+//	requesting a new page only once the current one is exhausted. Check Err
+//	once Next returns false and Close it when leaving the loop early.
+
+type Iterator struct {
+	api   *utils.Client
+	pages *utils.Iterator
+	value IssuingPurchase
+	err   Error.StarkErrors
+}
+
+func Iterate(params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve IssuingPurchase structs
+	//
+	//	Same as Query, but returns an Iterator instead of a pair of channels
+	//
+	//	Example:
+	//	iterator := Iterate(params, nil)
+	//	defer iterator.Close()
+	//	for iterator.Next() {
+	//		fmt.Println(iterator.Value())
+	//	}
+	//	if err := iterator.Err(); err.Errors != nil {
+	//		...
+	//	}
+	return Client{Api: utils.Default(user)}.Iterate(params)
+}
+
+func IterateCtx(ctx context.Context, params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve IssuingPurchase structs
+	//
+	//	Same as Iterate, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.IterateCtx(ctx, params)
+}
+
+func (c Client) Iterate(params map[string]interface{}) *Iterator {
+	//	Retrieve IssuingPurchase structs
+	return c.IterateCtx(context.Background(), params)
+}
+
+func (c Client) IterateCtx(ctx context.Context, params map[string]interface{}) *Iterator {
+	//	Retrieve IssuingPurchase structs
+	return &Iterator{api: c.Api, pages: c.Api.Iterate(ctx, resource, params)}
+}
+
+func (i *Iterator) Next() bool {
+	//	Move to the next IssuingPurchase, returning false when there are no more or a request failed
+	var value IssuingPurchase
+	if i.err.Errors != nil || !i.pages.Next() {
+		return false
+	}
+	i.err = i.api.Unmarshal(i.pages.Value(), &value)
+	if i.err.Errors != nil {
+		i.pages.Close()
+		return false
+	}
+	i.value = value
+	return true
+}
+
+func (i *Iterator) Value() IssuingPurchase {
+	//	Current IssuingPurchase, set by the last call to Next
+	return i.value
+}
+
+func (i *Iterator) Err() Error.StarkErrors {
+	//	Error that stopped the iteration, if any
+	if i.err.Errors != nil {
+		return i.err
+	}
+	return i.pages.Err()
+}
+
+func (i *Iterator) Close() {
+	//	Stop the iteration, releasing the current page
+	i.pages.Close()
+}
+
 func Page(params map[string]interface{}, user user.User) ([]IssuingPurchase, string, Error.StarkErrors) {
 	//	Retrieve paged IssuingPurchase structs
 	//
@@ -189,12 +322,29 @@ func Page(params map[string]interface{}, user user.User) ([]IssuingPurchase, str
 	//	Return:
 	//	- slice of IssuingPurchase structs with updated attributes
 	//	- cursor to retrieve the next page of IssuingPurchase structs
+	return Client{Api: utils.Default(user)}.Page(params)
+}
+
+func PageCtx(ctx context.Context, params map[string]interface{}, user user.User) ([]IssuingPurchase, string, Error.StarkErrors) {
+	//	Retrieve paged IssuingPurchase structs
+	//
+	//	Same as Page, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.PageCtx(ctx, params)
+}
+
+func (c Client) Page(params map[string]interface{}) ([]IssuingPurchase, string, Error.StarkErrors) {
+	//	Retrieve paged IssuingPurchase structs
+	return c.PageCtx(context.Background(), params)
+}
+
+func (c Client) PageCtx(ctx context.Context, params map[string]interface{}) ([]IssuingPurchase, string, Error.StarkErrors) {
+	//	Retrieve paged IssuingPurchase structs
 	var issuingPurchases []IssuingPurchase
-	page, cursor, err := utils.Page(resource, params, user)
-	unmarshalError := json.Unmarshal(page, &issuingPurchases)
-	if unmarshalError != nil {
+	page, cursor, err := c.Api.Page(ctx, resource, params)
+	if err.Errors != nil {
 		return issuingPurchases, cursor, err
 	}
+	err = c.Api.Unmarshal(page, &issuingPurchases)
 	return issuingPurchases, cursor, err
 }
 
@@ -216,15 +366,32 @@ func Parse(content string, signature string, user user.User) (IssuingPurchase, E
 	//
 	//	Return:
 	//	- parsed IssuingPurchase struct
+	return Client{Api: utils.Default(user)}.Parse(content, signature)
+}
+
+func ParseCtx(ctx context.Context, content string, signature string, user user.User) (IssuingPurchase, Error.StarkErrors) {
+	//	Create single verified IssuingPurchase authorization request from a content string
+	//
+	//	Same as Parse, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.ParseCtx(ctx, content, signature)
+}
+
+func (c Client) Parse(content string, signature string) (IssuingPurchase, Error.StarkErrors) {
+	//	Create single verified IssuingPurchase authorization request from a content string
+	return c.ParseCtx(context.Background(), content, signature)
+}
+
+func (c Client) ParseCtx(ctx context.Context, content string, signature string) (IssuingPurchase, Error.StarkErrors) {
+	//	Create single verified IssuingPurchase authorization request from a content string
 	var issuingPurchase IssuingPurchase
-	parsed, err := utils.ParseAndVerify(content, signature, "", user)
+	parsed, err := c.Api.ParseAndVerify(ctx, content, signature, "")
 	if err.Errors != nil {
 		return issuingPurchase, err
 	}
 
-	unmarshalError := json.Unmarshal([]byte(parsed), &issuingPurchase)
-	if unmarshalError != nil {
-		return issuingPurchase, Error.UnknownError(unmarshalError.Error())
+	unmarshalError := c.Api.Unmarshal([]byte(parsed), &issuingPurchase)
+	if unmarshalError.Errors != nil {
+		return issuingPurchase, unmarshalError
 	}
 
 	return issuingPurchase, Error.StarkErrors{}
@@ -0,0 +1,38 @@
+package issuingpurchase
+
+import (
+	"github.com/starkinfra/sdk-go/starkinfra/utils"
+	"time"
+)
+
+//	IssuingPurchase QueryParams struct
+//
+//	Typed filters for Query, Iterate and Page. Fields left empty are not sent.
+//
+//	Attributes:
+//	- Limit [int, default nil]: Maximum number of structs to be retrieved. Unlimited if nil, except on Page, which returns up to 100. ex: 35
+//	- Cursor [string, default nil]: Cursor returned on the previous Page call. Only used by Page
+//	- Ids [slice of strings, default nil]: Purchase IDs
+//	- After [time.Time, default nil]: Date filter for structs created only after specified date. ex: time.Date(2022, 11, 10, 0, 0, 0, 0, time.UTC)
+//	- Before [time.Time, default nil]: Date filter for structs created only before specified date. ex: time.Date(2022, 11, 10, 0, 0, 0, 0, time.UTC)
+//	- EndToEndIds [slice of strings, default nil]: Central bank's unique transaction ID. ex: "E79457883202101262140HHX553UPqeq"
+//	- HolderIds [slice of strings, default nil]: Card holder IDs. ex: []string{"5656565656565656", "4545454545454545"}
+//	- CardIds [slice of strings, default nil]: Card  IDs. ex: []string{"5656565656565656", "4545454545454545"}
+//	- Status [slice of strings, default nil]: Filter for status of retrieved structs. ex: []string{"approved", "canceled", "denied", "confirmed", "voided"}
+
+type QueryParams struct {
+	Limit       int       `json:"limit,omitempty"`
+	Cursor      string    `json:"cursor,omitempty"`
+	Ids         []string  `json:"ids,omitempty"`
+	After       time.Time `json:"after,omitempty"`
+	Before      time.Time `json:"before,omitempty"`
+	EndToEndIds []string  `json:"endToEndIds,omitempty"`
+	HolderIds   []string  `json:"holderIds,omitempty"`
+	CardIds     []string  `json:"cardIds,omitempty"`
+	Status      []string  `json:"status,omitempty"`
+}
+
+func (p QueryParams) Map() map[string]interface{} {
+	//	Convert the QueryParams into the params map taken by Query, Iterate and Page
+	return utils.ParamsMap(p)
+}
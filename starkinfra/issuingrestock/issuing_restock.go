@@ -1,6 +1,7 @@
 package issuingrestock
 
 import (
+	"context"
 	"encoding/json"
 	Error "github.com/starkinfra/core-go/starkcore/error"
 	"github.com/starkinfra/core-go/starkcore/user/user"
@@ -25,19 +26,30 @@ import (
 //	- Status [string]: Current IssuingCard status. ex: "approved", "canceled", "denied", "confirmed", "voided"
 //	- Updated [time.Time]: Latest update datetime for the IssuingRestock. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC),
 //	- Created [time.Time]: Creation datetime for the IssuingRestock. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC),
+//	- Extra [map[string]json.RawMessage]: Fields of the IssuingRestock sent by the API but not declared in this SDK version yet, keyed by their JSON name. ex: map[string]json.RawMessage{"newField": json.RawMessage(`"value"`)}
 
 type IssuingRestock struct {
-	Count   int        `json:",omitempty"`
-	StockId string     `json:",omitempty"`
-	Tags    []string   `json:",omitempty"`
-	Id      string     `json:",omitempty"`
-	Status  string     `json:",omitempty"`
-	Updated *time.Time `json:",omitempty"`
-	Created *time.Time `json:",omitempty"`
+	Count   int                        `json:",omitempty"`
+	StockId string                     `json:",omitempty"`
+	Tags    []string                   `json:",omitempty"`
+	Id      string                     `json:",omitempty"`
+	Status  string                     `json:",omitempty"`
+	Updated *time.Time                 `json:",omitempty"`
+	Created *time.Time                 `json:",omitempty"`
+	Extra   map[string]json.RawMessage `json:"-"`
 }
 
 var resource = map[string]string{"name": "IssuingRestock"}
 
+//	IssuingRestock Client struct
+//
+//	Runs the IssuingRestock functions with the settings of its own utils.Client
+//	instead of starkinfra.User and the other package defaults.
+
+type Client struct {
+	Api *utils.Client
+}
+
 func Create(restocks []IssuingRestock, user user.User) ([]IssuingRestock, Error.StarkErrors) {
 	//	Create IssuingRestocks
 	//
@@ -51,11 +63,28 @@ func Create(restocks []IssuingRestock, user user.User) ([]IssuingRestock, Error.
 	//
 	//	Return:
 	//	- slice of IssuingRestock structs with updated attributes
-	create, err := utils.Multi(resource, restocks, nil, user)
-	unmarshalError := json.Unmarshal(create, &restocks)
-	if unmarshalError != nil {
+	return Client{Api: utils.Default(user)}.Create(restocks)
+}
+
+func CreateCtx(ctx context.Context, restocks []IssuingRestock, user user.User) ([]IssuingRestock, Error.StarkErrors) {
+	//	Create IssuingRestocks
+	//
+	//	Same as Create, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.CreateCtx(ctx, restocks)
+}
+
+func (c Client) Create(restocks []IssuingRestock) ([]IssuingRestock, Error.StarkErrors) {
+	//	Create IssuingRestocks
+	return c.CreateCtx(context.Background(), restocks)
+}
+
+func (c Client) CreateCtx(ctx context.Context, restocks []IssuingRestock) ([]IssuingRestock, Error.StarkErrors) {
+	//	Create IssuingRestocks
+	create, err := c.Api.Multi(ctx, resource, restocks, nil)
+	if err.Errors != nil {
 		return restocks, err
 	}
+	err = c.Api.Unmarshal(create, &restocks)
 	return restocks, err
 }
 
@@ -72,12 +101,29 @@ func Get(id string, user user.User) (IssuingRestock, Error.StarkErrors) {
 	//
 	//	Return:
 	//	- issuingRestock struct that corresponds to the given id.
+	return Client{Api: utils.Default(user)}.Get(id)
+}
+
+func GetCtx(ctx context.Context, id string, user user.User) (IssuingRestock, Error.StarkErrors) {
+	//	Retrieve a specific IssuingRestock by its id
+	//
+	//	Same as Get, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.GetCtx(ctx, id)
+}
+
+func (c Client) Get(id string) (IssuingRestock, Error.StarkErrors) {
+	//	Retrieve a specific IssuingRestock by its id
+	return c.GetCtx(context.Background(), id)
+}
+
+func (c Client) GetCtx(ctx context.Context, id string) (IssuingRestock, Error.StarkErrors) {
+	//	Retrieve a specific IssuingRestock by its id
 	var issuingRestock IssuingRestock
-	get, err := utils.Get(resource, id, nil, user)
-	unmarshalError := json.Unmarshal(get, &issuingRestock)
-	if unmarshalError != nil {
+	get, err := c.Api.Get(ctx, resource, id, nil)
+	if err.Errors != nil {
 		return issuingRestock, err
 	}
+	err = c.Api.Unmarshal(get, &issuingRestock)
 	return issuingRestock, err
 }
 
@@ -99,28 +145,132 @@ func Query(params map[string]interface{}, user user.User) (chan IssuingRestock,
 	//
 	//	Return:
 	//	- channel of IssuingRestock structs with updated attributes
-	var issuingRestock IssuingRestock
+	return Client{Api: utils.Default(user)}.Query(params)
+}
+
+func QueryCtx(ctx context.Context, params map[string]interface{}, user user.User) (chan IssuingRestock, chan Error.StarkErrors) {
+	//	Retrieve IssuingRestock structs
+	//
+	//	Same as Query, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.QueryCtx(ctx, params)
+}
+
+func (c Client) Query(params map[string]interface{}) (chan IssuingRestock, chan Error.StarkErrors) {
+	//	Retrieve IssuingRestock structs
+	return c.QueryCtx(context.Background(), params)
+}
+
+func (c Client) QueryCtx(ctx context.Context, params map[string]interface{}) (chan IssuingRestock, chan Error.StarkErrors) {
+	//	Retrieve IssuingRestock structs
 	restocks := make(chan IssuingRestock)
 	logsError := make(chan Error.StarkErrors)
-	query, errorChannel := utils.Query(resource, params, user)
+	query, errorChannel := c.Api.Stream(ctx, resource, params)
 	go func() {
+		defer close(logsError)
+		defer close(restocks)
 		for content := range query {
-			contentByte, _ := json.Marshal(content)
-			err := json.Unmarshal(contentByte, &issuingRestock)
-			if err != nil {
-				logsError <- Error.UnknownError(err.Error())
+			var issuingRestock IssuingRestock
+			err := c.Api.Unmarshal(content, &issuingRestock)
+			if err.Errors != nil {
+				if !utils.SendError(ctx, logsError, err) {
+					return
+				}
+			}
+			select {
+			case restocks <- issuingRestock:
+			case <-ctx.Done():
+				return
 			}
-			restocks <- issuingRestock
 		}
 		for err := range errorChannel {
-			logsError <- err
+			if !utils.SendError(ctx, logsError, err) {
+				return
+			}
 		}
-		close(restocks)
-		close(logsError)
 	}()
 	return restocks, logsError
 }
 
+//	IssuingRestock Iterator struct
+//
+//	Returned by Iterate to walk through IssuingRestock structs one at a time,
+//	requesting a new page only once the current one is exhausted. Check Err
+//	once Next returns false and Close it when leaving the loop early.
+
+type Iterator struct {
+	api   *utils.Client
+	pages *utils.Iterator
+	value IssuingRestock
+	err   Error.StarkErrors
+}
+
+func Iterate(params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve IssuingRestock structs
+	//
+	//	Same as Query, but returns an Iterator instead of a pair of channels
+	//
+	//	Example:
+	//	iterator := Iterate(params, nil)
+	//	defer iterator.Close()
+	//	for iterator.Next() {
+	//		fmt.Println(iterator.Value())
+	//	}
+	//	if err := iterator.Err(); err.Errors != nil {
+	//		...
+	//	}
+	return Client{Api: utils.Default(user)}.Iterate(params)
+}
+
+func IterateCtx(ctx context.Context, params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve IssuingRestock structs
+	//
+	//	Same as Iterate, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.IterateCtx(ctx, params)
+}
+
+func (c Client) Iterate(params map[string]interface{}) *Iterator {
+	//	Retrieve IssuingRestock structs
+	return c.IterateCtx(context.Background(), params)
+}
+
+func (c Client) IterateCtx(ctx context.Context, params map[string]interface{}) *Iterator {
+	//	Retrieve IssuingRestock structs
+	return &Iterator{api: c.Api, pages: c.Api.Iterate(ctx, resource, params)}
+}
+
+func (i *Iterator) Next() bool {
+	//	Move to the next IssuingRestock, returning false when there are no more or a request failed
+	var value IssuingRestock
+	if i.err.Errors != nil || !i.pages.Next() {
+		return false
+	}
+	i.err = i.api.Unmarshal(i.pages.Value(), &value)
+	if i.err.Errors != nil {
+		i.pages.Close()
+		return false
+	}
+	i.value = value
+	return true
+}
+
+func (i *Iterator) Value() IssuingRestock {
+	//	Current IssuingRestock, set by the last call to Next
+	return i.value
+}
+
+func (i *Iterator) Err() Error.StarkErrors {
+	//	Error that stopped the iteration, if any
+	if i.err.Errors != nil {
+		return i.err
+	}
+	return i.pages.Err()
+}
+
+func (i *Iterator) Close() {
+	//	Stop the iteration, releasing the current page
+	i.pages.Close()
+}
+
 func Page(params map[string]interface{}, user user.User) ([]IssuingRestock, string, Error.StarkErrors) {
 	//	Retrieve paged IssuingRestock structs
 	//
@@ -142,11 +292,28 @@ func Page(params map[string]interface{}, user user.User) ([]IssuingRestock, stri
 	//	Return:
 	//	- slice of IssuingRestock structs with updated attributes
 	//	- cursor to retrieve the next page of IssuingRestock structs
+	return Client{Api: utils.Default(user)}.Page(params)
+}
+
+func PageCtx(ctx context.Context, params map[string]interface{}, user user.User) ([]IssuingRestock, string, Error.StarkErrors) {
+	//	Retrieve paged IssuingRestock structs
+	//
+	//	Same as Page, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.PageCtx(ctx, params)
+}
+
+func (c Client) Page(params map[string]interface{}) ([]IssuingRestock, string, Error.StarkErrors) {
+	//	Retrieve paged IssuingRestock structs
+	return c.PageCtx(context.Background(), params)
+}
+
+func (c Client) PageCtx(ctx context.Context, params map[string]interface{}) ([]IssuingRestock, string, Error.StarkErrors) {
+	//	Retrieve paged IssuingRestock structs
 	var issuingRestocks []IssuingRestock
-	page, cursor, err := utils.Page(resource, params, user)
-	unmarshalError := json.Unmarshal(page, &issuingRestocks)
-	if unmarshalError != nil {
+	page, cursor, err := c.Api.Page(ctx, resource, params)
+	if err.Errors != nil {
 		return issuingRestocks, cursor, err
 	}
+	err = c.Api.Unmarshal(page, &issuingRestocks)
 	return issuingRestocks, cursor, err
 }
@@ -1,6 +1,7 @@
 package issuingrule
 
 import (
+	"encoding/json"
 	CardMethod "github.com/starkinfra/sdk-go/starkinfra/cardmethod"
 	MerchantCategory "github.com/starkinfra/sdk-go/starkinfra/merchantcategory"
 	MerchantCountry "github.com/starkinfra/sdk-go/starkinfra/merchantcountry"
@@ -28,6 +29,7 @@ import (
 //	- CurrencyName [string]: Currency name. ex: "Brazilian Real"
 //	- Schedule [string]: Optional schedule dictating when the rule can be used. Some examples: "everyday from 09:00 to 18:00 in America/Sao_Paulo" - every day, 09:00-18:00 Sao Paulo time; "every monday, wednesday, friday from 08:00 to 12:00 in America/Sao_Paulo" - only those weekdays, mornings; "every saturday, sunday" - weekends, all day, in UTC
 //	- Purposes [slice of strings]: Optional list of transaction purposes the rule applies to. Options: "purchase", "withdrawal", "verification". The rule then limits only purchases of those purposes; omit it to allow any purposes. Example: []string{"purchase", "verification"} if you want us to automatically deny withdrawal.
+//	- Extra [map[string]json.RawMessage]: Fields of the IssuingRule sent by the API but not declared in this SDK version yet, keyed by their JSON name. ex: map[string]json.RawMessage{"newField": json.RawMessage(`"value"`)}
 
 type IssuingRule struct {
 	Name           string                              `json:",omitempty"`
@@ -43,4 +45,5 @@ type IssuingRule struct {
 	CurrencyName   string                              `json:",omitempty"`
 	Schedule       string                              `json:",omitempty"`
 	Purposes       []string                            `json:",omitempty"`
+	Extra          map[string]json.RawMessage          `json:"-"`
 }
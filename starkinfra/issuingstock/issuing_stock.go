@@ -1,6 +1,7 @@
 package issuingstock
 
 import (
+	"context"
 	"encoding/json"
 	Error "github.com/starkinfra/core-go/starkcore/error"
 	"github.com/starkinfra/core-go/starkcore/user/user"
@@ -20,19 +21,30 @@ import (
 //	- EmbosserName [string]: Name of the embosser that holds this stock. ex: "Embosser 1"
 //	- Updated [time.Time]: Latest update datetime for the IssuingStock. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC),
 //	- Created [time.Time]: Creation datetime for the IssuingStock. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC),
+//	- Extra [map[string]json.RawMessage]: Fields of the IssuingStock sent by the API but not declared in this SDK version yet, keyed by their JSON name. ex: map[string]json.RawMessage{"newField": json.RawMessage(`"value"`)}
 
 type IssuingStock struct {
-	Id           string     `json:",omitempty"`
-	Balance      int        `json:",omitempty"`
-	DesignId     string     `json:",omitempty"`
-	EmbosserId   string     `json:",omitempty"`
-	EmbosserName string     `json:",omitempty"`
-	Updated      *time.Time `json:",omitempty"`
-	Created      *time.Time `json:",omitempty"`
+	Id           string                     `json:",omitempty"`
+	Balance      int                        `json:",omitempty"`
+	DesignId     string                     `json:",omitempty"`
+	EmbosserId   string                     `json:",omitempty"`
+	EmbosserName string                     `json:",omitempty"`
+	Updated      *time.Time                 `json:",omitempty"`
+	Created      *time.Time                 `json:",omitempty"`
+	Extra        map[string]json.RawMessage `json:"-"`
 }
 
 var resource = map[string]string{"name": "IssuingStock"}
 
+//	IssuingStock Client struct
+//
+//	Runs the IssuingStock functions with the settings of its own utils.Client
+//	instead of starkinfra.User and the other package defaults.
+
+type Client struct {
+	Api *utils.Client
+}
+
 func Get(id string, expand map[string]interface{}, user user.User) (IssuingStock, Error.StarkErrors) {
 	//	Retrieve a specific IssuingStock by its id
 	//
@@ -47,12 +59,29 @@ func Get(id string, expand map[string]interface{}, user user.User) (IssuingStock
 	//
 	//	Return:
 	//	- issuingStock struct that corresponds to the given id.
+	return Client{Api: utils.Default(user)}.Get(id, expand)
+}
+
+func GetCtx(ctx context.Context, id string, expand map[string]interface{}, user user.User) (IssuingStock, Error.StarkErrors) {
+	//	Retrieve a specific IssuingStock by its id
+	//
+	//	Same as Get, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.GetCtx(ctx, id, expand)
+}
+
+func (c Client) Get(id string, expand map[string]interface{}) (IssuingStock, Error.StarkErrors) {
+	//	Retrieve a specific IssuingStock by its id
+	return c.GetCtx(context.Background(), id, expand)
+}
+
+func (c Client) GetCtx(ctx context.Context, id string, expand map[string]interface{}) (IssuingStock, Error.StarkErrors) {
+	//	Retrieve a specific IssuingStock by its id
 	var issuingStock IssuingStock
-	get, err := utils.Get(resource, id, expand, user)
-	unmarshalError := json.Unmarshal(get, &issuingStock)
-	if unmarshalError != nil {
+	get, err := c.Api.Get(ctx, resource, id, expand)
+	if err.Errors != nil {
 		return issuingStock, err
 	}
+	err = c.Api.Unmarshal(get, &issuingStock)
 	return issuingStock, err
 }
 
@@ -74,29 +103,133 @@ func Query(params map[string]interface{}, user user.User) (chan IssuingStock, ch
 	//
 	//	Return:
 	//	- channel of IssuingStock structs with updated attributes
-	var issuingStock IssuingStock
+	return Client{Api: utils.Default(user)}.Query(params)
+}
+
+func QueryCtx(ctx context.Context, params map[string]interface{}, user user.User) (chan IssuingStock, chan Error.StarkErrors) {
+	//	Retrieve IssuingStock structs
+	//
+	//	Same as Query, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.QueryCtx(ctx, params)
+}
+
+func (c Client) Query(params map[string]interface{}) (chan IssuingStock, chan Error.StarkErrors) {
+	//	Retrieve IssuingStock structs
+	return c.QueryCtx(context.Background(), params)
+}
+
+func (c Client) QueryCtx(ctx context.Context, params map[string]interface{}) (chan IssuingStock, chan Error.StarkErrors) {
+	//	Retrieve IssuingStock structs
 	stocks := make(chan IssuingStock)
 	stocksError := make(chan Error.StarkErrors)
-	query, errorChannel := utils.Query(resource, params, user)
+	query, errorChannel := c.Api.Stream(ctx, resource, params)
 	go func() {
+		defer close(stocksError)
+		defer close(stocks)
 		for content := range query {
-			contentByte, _ := json.Marshal(content)
-			err := json.Unmarshal(contentByte, &issuingStock)
-			if err != nil {
-				stocksError <- Error.UnknownError(err.Error())
+			var issuingStock IssuingStock
+			err := c.Api.Unmarshal(content, &issuingStock)
+			if err.Errors != nil {
+				if !utils.SendError(ctx, stocksError, err) {
+					return
+				}
 				continue
 			}
-			stocks <- issuingStock
+			select {
+			case stocks <- issuingStock:
+			case <-ctx.Done():
+				return
+			}
 		}
 		for err := range errorChannel {
-			stocksError <- err
+			if !utils.SendError(ctx, stocksError, err) {
+				return
+			}
 		}
-		close(stocks)
-		close(stocksError)
 	}()
 	return stocks, stocksError
 }
 
+//	IssuingStock Iterator struct
+//
+//	Returned by Iterate to walk through IssuingStock structs one at a time,
+//	requesting a new page only once the current one is exhausted. Check Err
+//	once Next returns false and Close it when leaving the loop early.
+
+type Iterator struct {
+	api   *utils.Client
+	pages *utils.Iterator
+	value IssuingStock
+	err   Error.StarkErrors
+}
+
+func Iterate(params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve IssuingStock structs
+	//
+	//	Same as Query, but returns an Iterator instead of a pair of channels
+	//
+	//	Example:
+	//	iterator := Iterate(params, nil)
+	//	defer iterator.Close()
+	//	for iterator.Next() {
+	//		fmt.Println(iterator.Value())
+	//	}
+	//	if err := iterator.Err(); err.Errors != nil {
+	//		...
+	//	}
+	return Client{Api: utils.Default(user)}.Iterate(params)
+}
+
+func IterateCtx(ctx context.Context, params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve IssuingStock structs
+	//
+	//	Same as Iterate, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.IterateCtx(ctx, params)
+}
+
+func (c Client) Iterate(params map[string]interface{}) *Iterator {
+	//	Retrieve IssuingStock structs
+	return c.IterateCtx(context.Background(), params)
+}
+
+func (c Client) IterateCtx(ctx context.Context, params map[string]interface{}) *Iterator {
+	//	Retrieve IssuingStock structs
+	return &Iterator{api: c.Api, pages: c.Api.Iterate(ctx, resource, params)}
+}
+
+func (i *Iterator) Next() bool {
+	//	Move to the next IssuingStock, returning false when there are no more or a request failed
+	var value IssuingStock
+	if i.err.Errors != nil || !i.pages.Next() {
+		return false
+	}
+	i.err = i.api.Unmarshal(i.pages.Value(), &value)
+	if i.err.Errors != nil {
+		i.pages.Close()
+		return false
+	}
+	i.value = value
+	return true
+}
+
+func (i *Iterator) Value() IssuingStock {
+	//	Current IssuingStock, set by the last call to Next
+	return i.value
+}
+
+func (i *Iterator) Err() Error.StarkErrors {
+	//	Error that stopped the iteration, if any
+	if i.err.Errors != nil {
+		return i.err
+	}
+	return i.pages.Err()
+}
+
+func (i *Iterator) Close() {
+	//	Stop the iteration, releasing the current page
+	i.pages.Close()
+}
+
 func Page(params map[string]interface{}, user user.User) ([]IssuingStock, string, Error.StarkErrors) {
 	//	Retrieve paged IssuingStock structs
 	//
@@ -118,11 +251,28 @@ func Page(params map[string]interface{}, user user.User) ([]IssuingStock, string
 	//	Return:
 	//	- slice of IssuingStock structs with updated attributes
 	//	- cursor to retrieve the next page of IssuingStock structs
+	return Client{Api: utils.Default(user)}.Page(params)
+}
+
+func PageCtx(ctx context.Context, params map[string]interface{}, user user.User) ([]IssuingStock, string, Error.StarkErrors) {
+	//	Retrieve paged IssuingStock structs
+	//
+	//	Same as Page, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.PageCtx(ctx, params)
+}
+
+func (c Client) Page(params map[string]interface{}) ([]IssuingStock, string, Error.StarkErrors) {
+	//	Retrieve paged IssuingStock structs
+	return c.PageCtx(context.Background(), params)
+}
+
+func (c Client) PageCtx(ctx context.Context, params map[string]interface{}) ([]IssuingStock, string, Error.StarkErrors) {
+	//	Retrieve paged IssuingStock structs
 	var issuingStocks []IssuingStock
-	page, cursor, err := utils.Page(resource, params, user)
-	unmarshalError := json.Unmarshal(page, &issuingStocks)
-	if unmarshalError != nil {
+	page, cursor, err := c.Api.Page(ctx, resource, params)
+	if err.Errors != nil {
 		return issuingStocks, cursor, err
 	}
+	err = c.Api.Unmarshal(page, &issuingStocks)
 	return issuingStocks, cursor, err
 }
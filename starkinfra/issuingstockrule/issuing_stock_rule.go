@@ -1,6 +1,7 @@
 package issuingstockrule
 
 import (
+	"context"
 	"encoding/json"
 	Error "github.com/starkinfra/core-go/starkcore/error"
 	"github.com/starkinfra/core-go/starkcore/user/user"
@@ -28,21 +29,32 @@ import (
 //	- Status [string]: Current IssuingStockRule status. ex: "active", "canceled"
 //	- Updated [time.Time]: Latest update datetime for the IssuingStockRule. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC),
 //	- Created [time.Time]: Creation datetime for the IssuingStockRule. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC),
+//	- Extra [map[string]json.RawMessage]: Fields of the IssuingStockRule sent by the API but not declared in this SDK version yet, keyed by their JSON name. ex: map[string]json.RawMessage{"newField": json.RawMessage(`"value"`)}
 
 type IssuingStockRule struct {
-	MinimumBalance int        `json:",omitempty"`
-	StockId        string     `json:",omitempty"`
-	Tags           []string   `json:",omitempty"`
-	Emails         []string   `json:",omitempty"`
-	Phones         []string   `json:",omitempty"`
-	Id             string     `json:",omitempty"`
-	Status         string     `json:",omitempty"`
-	Updated        *time.Time `json:",omitempty"`
-	Created        *time.Time `json:",omitempty"`
+	MinimumBalance int                        `json:",omitempty"`
+	StockId        string                     `json:",omitempty"`
+	Tags           []string                   `json:",omitempty"`
+	Emails         []string                   `json:",omitempty"`
+	Phones         []string                   `json:",omitempty"`
+	Id             string                     `json:",omitempty"`
+	Status         string                     `json:",omitempty"`
+	Updated        *time.Time                 `json:",omitempty"`
+	Created        *time.Time                 `json:",omitempty"`
+	Extra          map[string]json.RawMessage `json:"-"`
 }
 
 var resource = map[string]string{"name": "IssuingStockRule"}
 
+//	IssuingStockRule Client struct
+//
+//	Runs the IssuingStockRule functions with the settings of its own utils.Client
+//	instead of starkinfra.User and the other package defaults.
+
+type Client struct {
+	Api *utils.Client
+}
+
 func Create(rules []IssuingStockRule, user user.User) ([]IssuingStockRule, Error.StarkErrors) {
 	//	Create IssuingStockRules
 	//
@@ -56,11 +68,28 @@ func Create(rules []IssuingStockRule, user user.User) ([]IssuingStockRule, Error
 	//
 	//	Return:
 	//	- slice of IssuingStockRule structs with updated attributes
-	create, err := utils.Multi(resource, rules, nil, user)
-	unmarshalError := json.Unmarshal(create, &rules)
-	if unmarshalError != nil {
+	return Client{Api: utils.Default(user)}.Create(rules)
+}
+
+func CreateCtx(ctx context.Context, rules []IssuingStockRule, user user.User) ([]IssuingStockRule, Error.StarkErrors) {
+	//	Create IssuingStockRules
+	//
+	//	Same as Create, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.CreateCtx(ctx, rules)
+}
+
+func (c Client) Create(rules []IssuingStockRule) ([]IssuingStockRule, Error.StarkErrors) {
+	//	Create IssuingStockRules
+	return c.CreateCtx(context.Background(), rules)
+}
+
+func (c Client) CreateCtx(ctx context.Context, rules []IssuingStockRule) ([]IssuingStockRule, Error.StarkErrors) {
+	//	Create IssuingStockRules
+	create, err := c.Api.Multi(ctx, resource, rules, nil)
+	if err.Errors != nil {
 		return rules, err
 	}
+	err = c.Api.Unmarshal(create, &rules)
 	return rules, err
 }
 
@@ -77,12 +106,29 @@ func Get(id string, user user.User) (IssuingStockRule, Error.StarkErrors) {
 	//
 	//	Return:
 	//	- issuingStockRule struct that corresponds to the given id.
+	return Client{Api: utils.Default(user)}.Get(id)
+}
+
+func GetCtx(ctx context.Context, id string, user user.User) (IssuingStockRule, Error.StarkErrors) {
+	//	Retrieve a specific IssuingStockRule by its id
+	//
+	//	Same as Get, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.GetCtx(ctx, id)
+}
+
+func (c Client) Get(id string) (IssuingStockRule, Error.StarkErrors) {
+	//	Retrieve a specific IssuingStockRule by its id
+	return c.GetCtx(context.Background(), id)
+}
+
+func (c Client) GetCtx(ctx context.Context, id string) (IssuingStockRule, Error.StarkErrors) {
+	//	Retrieve a specific IssuingStockRule by its id
 	var issuingStockRule IssuingStockRule
-	get, err := utils.Get(resource, id, nil, user)
-	unmarshalError := json.Unmarshal(get, &issuingStockRule)
-	if unmarshalError != nil {
+	get, err := c.Api.Get(ctx, resource, id, nil)
+	if err.Errors != nil {
 		return issuingStockRule, err
 	}
+	err = c.Api.Unmarshal(get, &issuingStockRule)
 	return issuingStockRule, err
 }
 
@@ -104,29 +150,133 @@ func Query(params map[string]interface{}, user user.User) (chan IssuingStockRule
 	//
 	//	Return:
 	//	- channel of IssuingStockRule structs with updated attributes
-	var issuingStockRule IssuingStockRule
+	return Client{Api: utils.Default(user)}.Query(params)
+}
+
+func QueryCtx(ctx context.Context, params map[string]interface{}, user user.User) (chan IssuingStockRule, chan Error.StarkErrors) {
+	//	Retrieve IssuingStockRule structs
+	//
+	//	Same as Query, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.QueryCtx(ctx, params)
+}
+
+func (c Client) Query(params map[string]interface{}) (chan IssuingStockRule, chan Error.StarkErrors) {
+	//	Retrieve IssuingStockRule structs
+	return c.QueryCtx(context.Background(), params)
+}
+
+func (c Client) QueryCtx(ctx context.Context, params map[string]interface{}) (chan IssuingStockRule, chan Error.StarkErrors) {
+	//	Retrieve IssuingStockRule structs
 	rules := make(chan IssuingStockRule)
 	rulesError := make(chan Error.StarkErrors)
-	query, errorChannel := utils.Query(resource, params, user)
+	query, errorChannel := c.Api.Stream(ctx, resource, params)
 	go func() {
+		defer close(rulesError)
+		defer close(rules)
 		for content := range query {
-			contentByte, _ := json.Marshal(content)
-			err := json.Unmarshal(contentByte, &issuingStockRule)
-			if err != nil {
-				rulesError <- Error.UnknownError(err.Error())
+			var issuingStockRule IssuingStockRule
+			err := c.Api.Unmarshal(content, &issuingStockRule)
+			if err.Errors != nil {
+				if !utils.SendError(ctx, rulesError, err) {
+					return
+				}
 				continue
 			}
-			rules <- issuingStockRule
+			select {
+			case rules <- issuingStockRule:
+			case <-ctx.Done():
+				return
+			}
 		}
 		for err := range errorChannel {
-			rulesError <- err
+			if !utils.SendError(ctx, rulesError, err) {
+				return
+			}
 		}
-		close(rules)
-		close(rulesError)
 	}()
 	return rules, rulesError
 }
 
+//	IssuingStockRule Iterator struct
+//
+//	Returned by Iterate to walk through IssuingStockRule structs one at a time,
+//	requesting a new page only once the current one is exhausted. Check Err
+//	once Next returns false and Close it when leaving the loop early.
+
+type Iterator struct {
+	api   *utils.Client
+	pages *utils.Iterator
+	value IssuingStockRule
+	err   Error.StarkErrors
+}
+
+func Iterate(params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve IssuingStockRule structs
+	//
+	//	Same as Query, but returns an Iterator instead of a pair of channels
+	//
+	//	Example:
+	//	iterator := Iterate(params, nil)
+	//	defer iterator.Close()
+	//	for iterator.Next() {
+	//		fmt.Println(iterator.Value())
+	//	}
+	//	if err := iterator.Err(); err.Errors != nil {
+	//		...
+	//	}
+	return Client{Api: utils.Default(user)}.Iterate(params)
+}
+
+func IterateCtx(ctx context.Context, params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve IssuingStockRule structs
+	//
+	//	Same as Iterate, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.IterateCtx(ctx, params)
+}
+
+func (c Client) Iterate(params map[string]interface{}) *Iterator {
+	//	Retrieve IssuingStockRule structs
+	return c.IterateCtx(context.Background(), params)
+}
+
+func (c Client) IterateCtx(ctx context.Context, params map[string]interface{}) *Iterator {
+	//	Retrieve IssuingStockRule structs
+	return &Iterator{api: c.Api, pages: c.Api.Iterate(ctx, resource, params)}
+}
+
+func (i *Iterator) Next() bool {
+	//	Move to the next IssuingStockRule, returning false when there are no more or a request failed
+	var value IssuingStockRule
+	if i.err.Errors != nil || !i.pages.Next() {
+		return false
+	}
+	i.err = i.api.Unmarshal(i.pages.Value(), &value)
+	if i.err.Errors != nil {
+		i.pages.Close()
+		return false
+	}
+	i.value = value
+	return true
+}
+
+func (i *Iterator) Value() IssuingStockRule {
+	//	Current IssuingStockRule, set by the last call to Next
+	return i.value
+}
+
+func (i *Iterator) Err() Error.StarkErrors {
+	//	Error that stopped the iteration, if any
+	if i.err.Errors != nil {
+		return i.err
+	}
+	return i.pages.Err()
+}
+
+func (i *Iterator) Close() {
+	//	Stop the iteration, releasing the current page
+	i.pages.Close()
+}
+
 func Page(params map[string]interface{}, user user.User) ([]IssuingStockRule, string, Error.StarkErrors) {
 	//	Retrieve paged IssuingStockRule structs
 	//
@@ -149,12 +299,29 @@ func Page(params map[string]interface{}, user user.User) ([]IssuingStockRule, st
 	//	- slice of IssuingStockRule structs with updated attributes
 	//	- cursor to retrieve the next page of IssuingStockRule structs
 	//
+	return Client{Api: utils.Default(user)}.Page(params)
+}
+
+func PageCtx(ctx context.Context, params map[string]interface{}, user user.User) ([]IssuingStockRule, string, Error.StarkErrors) {
+	//	Retrieve paged IssuingStockRule structs
+	//
+	//	Same as Page, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.PageCtx(ctx, params)
+}
+
+func (c Client) Page(params map[string]interface{}) ([]IssuingStockRule, string, Error.StarkErrors) {
+	//	Retrieve paged IssuingStockRule structs
+	return c.PageCtx(context.Background(), params)
+}
+
+func (c Client) PageCtx(ctx context.Context, params map[string]interface{}) ([]IssuingStockRule, string, Error.StarkErrors) {
+	//	Retrieve paged IssuingStockRule structs
 	var issuingStockRules []IssuingStockRule
-	page, cursor, err := utils.Page(resource, params, user)
-	unmarshalError := json.Unmarshal(page, &issuingStockRules)
-	if unmarshalError != nil {
+	page, cursor, err := c.Api.Page(ctx, resource, params)
+	if err.Errors != nil {
 		return issuingStockRules, cursor, err
 	}
+	err = c.Api.Unmarshal(page, &issuingStockRules)
 	return issuingStockRules, cursor, err
 }
 
@@ -177,12 +344,29 @@ func Update(id string, patchData map[string]interface{}, user user.User) (Issuin
 	//
 	//	Return:
 	//	- target IssuingStockRule with updated attributes
+	return Client{Api: utils.Default(user)}.Update(id, patchData)
+}
+
+func UpdateCtx(ctx context.Context, id string, patchData map[string]interface{}, user user.User) (IssuingStockRule, Error.StarkErrors) {
+	//	Update IssuingStockRule entity
+	//
+	//	Same as Update, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.UpdateCtx(ctx, id, patchData)
+}
+
+func (c Client) Update(id string, patchData map[string]interface{}) (IssuingStockRule, Error.StarkErrors) {
+	//	Update IssuingStockRule entity
+	return c.UpdateCtx(context.Background(), id, patchData)
+}
+
+func (c Client) UpdateCtx(ctx context.Context, id string, patchData map[string]interface{}) (IssuingStockRule, Error.StarkErrors) {
+	//	Update IssuingStockRule entity
 	var issuingStockRule IssuingStockRule
-	update, err := utils.Patch(resource, id, patchData, user)
-	unmarshalError := json.Unmarshal(update, &issuingStockRule)
-	if unmarshalError != nil {
+	update, err := c.Api.Patch(ctx, resource, id, patchData)
+	if err.Errors != nil {
 		return issuingStockRule, err
 	}
+	err = c.Api.Unmarshal(update, &issuingStockRule)
 	return issuingStockRule, err
 }
 
@@ -199,11 +383,28 @@ func Cancel(id string, user user.User) (IssuingStockRule, Error.StarkErrors) {
 	//
 	//	Return:
 	//	- canceled IssuingStockRule struct
+	return Client{Api: utils.Default(user)}.Cancel(id)
+}
+
+func CancelCtx(ctx context.Context, id string, user user.User) (IssuingStockRule, Error.StarkErrors) {
+	//	Cancel an IssuingStockRule entity
+	//
+	//	Same as Cancel, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.CancelCtx(ctx, id)
+}
+
+func (c Client) Cancel(id string) (IssuingStockRule, Error.StarkErrors) {
+	//	Cancel an IssuingStockRule entity
+	return c.CancelCtx(context.Background(), id)
+}
+
+func (c Client) CancelCtx(ctx context.Context, id string) (IssuingStockRule, Error.StarkErrors) {
+	//	Cancel an IssuingStockRule entity
 	var issuingStockRule IssuingStockRule
-	deleted, err := utils.Delete(resource, id, user)
-	unmarshalError := json.Unmarshal(deleted, &issuingStockRule)
-	if unmarshalError != nil {
+	deleted, err := c.Api.Delete(ctx, resource, id)
+	if err.Errors != nil {
 		return issuingStockRule, err
 	}
+	err = c.Api.Unmarshal(deleted, &issuingStockRule)
 	return issuingStockRule, err
 }
@@ -0,0 +1,222 @@
+package issuingtoken
+
+import (
+	"fmt"
+	Error "github.com/starkinfra/core-go/starkcore/error"
+	"github.com/starkinfra/sdk-go/starkinfra/utils"
+)
+
+//	IssuingToken Authorization struct
+//
+//	Answer to an IssuingToken authorization request. Build it with
+//	ApproveAuthorization or DenyAuthorization, and turn it into the JSON to be
+//	returned with Response, which refuses invalid answers before they reach the API.
+//
+//	Attributes:
+//	- Status [string]: Sub-issuer response to the authorization. ex: StatusApproved or StatusDenied
+//	- Reason [Reason]: Denial reason, required for denials and refused for approvals. ex: ReasonBlockedCard
+//	- ActivationMethods [slice of ActivationMethod structs]: Methods the holder may use to activate the token, required for approvals and refused for denials. ex: []ActivationMethod{{Type: "text", Value: "+5511989898989"}}
+//	- DesignId [string]: IssuingTokenDesign unique id, required for approvals and refused for denials. ex: "5656565656565656"
+//	- Tags [slice of strings, default nil]: Tags to be added to the IssuingToken. ex: []string{"tony", "stark"}
+
+type Authorization struct {
+	Status            string
+	Reason            Reason
+	ActivationMethods []ActivationMethod
+	DesignId          string
+	Tags              []string
+}
+
+//	IssuingToken ActivationMethod struct
+//
+//	Attributes:
+//	- Type [string]: Activation method type. ex: "text" or "email"
+//	- Value [string]: Where the activation code is sent. ex: "+5511989898989"
+
+type ActivationMethod struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+//	IssuingToken Activation struct
+//
+//	Answer to an IssuingToken activation request, confirming the activation
+//	code informed to the holder. Build it with ApproveActivation or
+//	DenyActivation, and turn it into the JSON to be returned with Response.
+//
+//	Attributes:
+//	- Status [string]: Sub-issuer response to the activation. ex: StatusApproved or StatusDenied
+//	- Reason [Reason]: Denial reason, required for denials and refused for approvals. ex: ReasonBruteForce
+//	- Tags [slice of strings, default nil]: Tags to be added to the IssuingToken. ex: []string{"tony", "stark"}
+
+type Activation struct {
+	Status string
+	Reason Reason
+	Tags   []string
+}
+
+//	IssuingToken authorization Reason
+//
+//	Reason for denying an IssuingToken authorization or activation, one of the Reason* constants.
+
+type Reason string
+
+const StatusApproved = "approved"
+const StatusDenied = "denied"
+
+const ReasonOther Reason = "other"
+const ReasonBruteForce Reason = "bruteForce"
+const ReasonSubIssuerError Reason = "subIssuerError"
+const ReasonLostCard Reason = "lostCard"
+const ReasonInvalidCard Reason = "invalidCard"
+const ReasonInvalidHolder Reason = "invalidHolder"
+const ReasonExpiredCard Reason = "expiredCard"
+const ReasonCanceledCard Reason = "canceledCard"
+const ReasonBlockedCard Reason = "blockedCard"
+const ReasonInvalidExpiration Reason = "invalidExpiration"
+const ReasonInvalidSecurityCode Reason = "invalidSecurityCode"
+const ReasonMissingTokenAuthorizationUrl Reason = "missingTokenAuthorizationUrl"
+const ReasonMaxCardTriesExceeded Reason = "maxCardTriesExceeded"
+const ReasonMaxWalletInstanceTriesExceeded Reason = "maxWalletInstanceTriesExceeded"
+
+var reasons = map[Reason]bool{
+	ReasonOther:                          true,
+	ReasonBruteForce:                     true,
+	ReasonSubIssuerError:                 true,
+	ReasonLostCard:                       true,
+	ReasonInvalidCard:                    true,
+	ReasonInvalidHolder:                  true,
+	ReasonExpiredCard:                    true,
+	ReasonCanceledCard:                   true,
+	ReasonBlockedCard:                    true,
+	ReasonInvalidExpiration:              true,
+	ReasonInvalidSecurityCode:            true,
+	ReasonMissingTokenAuthorizationUrl:   true,
+	ReasonMaxCardTriesExceeded:           true,
+	ReasonMaxWalletInstanceTriesExceeded: true,
+}
+
+func ApproveAuthorization(designId string, activationMethods ...ActivationMethod) Authorization {
+	//	Approve an IssuingToken authorization
+	//
+	//	Parameters (required):
+	//	- designId [string]: IssuingTokenDesign unique id. ex: "5656565656565656"
+	//	- activationMethods [ActivationMethod structs]: Methods the holder may use to activate the token, at least one. ex: ActivationMethod{Type: "text", Value: "+5511989898989"}
+	return Authorization{Status: StatusApproved, DesignId: designId, ActivationMethods: activationMethods}
+}
+
+func DenyAuthorization(reason Reason) Authorization {
+	//	Deny an IssuingToken authorization
+	//
+	//	Parameters (required):
+	//	- reason [Reason]: Denial reason. ex: ReasonBlockedCard
+	return Authorization{Status: StatusDenied, Reason: reason}
+}
+
+func ApproveActivation() Activation {
+	//	Approve an IssuingToken activation
+	return Activation{Status: StatusApproved}
+}
+
+func DenyActivation(reason Reason) Activation {
+	//	Deny an IssuingToken activation
+	//
+	//	Parameters (required):
+	//	- reason [Reason]: Denial reason. ex: ReasonBruteForce
+	return Activation{Status: StatusDenied, Reason: reason}
+}
+
+func (a Authorization) Validate() Error.StarkErrors {
+	//	Check the Authorization before it is sent
+	//
+	//	Return:
+	//	- inputError if the Status is unknown, a denial has no known Reason or carries activation settings,
+	//	  an approval has a Reason or lacks a DesignId or ActivationMethods, an ActivationMethod is incomplete or a tag is empty
+	if err := validate("authorization", a.Status, a.Reason, a.Tags); err.Errors != nil {
+		return err
+	}
+	if a.Status == StatusApproved && a.DesignId == "" {
+		return utils.ValidationError("An approved IssuingToken authorization needs a designId")
+	}
+	if a.Status == StatusApproved && len(a.ActivationMethods) == 0 {
+		return utils.ValidationError("An approved IssuingToken authorization needs at least one activation method")
+	}
+	if a.Status == StatusDenied && (len(a.ActivationMethods) > 0 || a.DesignId != "") {
+		return utils.ValidationError("A denied IssuingToken authorization takes no activationMethods or designId")
+	}
+	for _, method := range a.ActivationMethods {
+		if method.Type == "" || method.Value == "" {
+			return utils.ValidationError(fmt.Sprintf("IssuingToken activation methods need a type and a value, got %+v", method))
+		}
+	}
+	return Error.StarkErrors{}
+}
+
+func (a Authorization) Response() (string, Error.StarkErrors) {
+	//	Build the JSON string that must be returned to us on the IssuingToken authorization request
+	//
+	//	Return:
+	//	- same JSON string as the ResponseAuthorization function, or an inputError if Validate fails
+	if err := a.Validate(); err.Errors != nil {
+		return "", err
+	}
+	authorization := fields(a.Status, a.Reason, a.Tags)
+	if len(a.ActivationMethods) > 0 {
+		authorization["activationMethods"] = a.ActivationMethods
+	}
+	if a.DesignId != "" {
+		authorization["designId"] = a.DesignId
+	}
+	return ResponseAuthorization(authorization), Error.StarkErrors{}
+}
+
+func (a Activation) Validate() Error.StarkErrors {
+	//	Check the Activation before it is sent
+	//
+	//	Return:
+	//	- inputError if the Status is unknown, a denial has no known Reason, an approval has one or a tag is empty
+	return validate("activation", a.Status, a.Reason, a.Tags)
+}
+
+func (a Activation) Response() (string, Error.StarkErrors) {
+	//	Build the JSON string that must be returned to us on the IssuingToken activation request
+	//
+	//	Return:
+	//	- same JSON string as the ResponseActivation function, or an inputError if Validate fails
+	if err := a.Validate(); err.Errors != nil {
+		return "", err
+	}
+	return ResponseActivation(fields(a.Status, a.Reason, a.Tags)), Error.StarkErrors{}
+}
+
+func validate(kind string, status string, reason Reason, tags []string) Error.StarkErrors {
+	switch status {
+	case StatusApproved:
+		if reason != "" {
+			return utils.ValidationError(fmt.Sprintf("An approved IssuingToken %v takes no reason, got %v", kind, reason))
+		}
+	case StatusDenied:
+		if !reasons[reason] {
+			return utils.ValidationError(fmt.Sprintf("A denied IssuingToken %v needs one of the Reason constants, got %q", kind, reason))
+		}
+	default:
+		return utils.ValidationError(fmt.Sprintf("The status of an IssuingToken %v must be %q or %q, got %q", kind, StatusApproved, StatusDenied, status))
+	}
+	for _, tag := range tags {
+		if tag == "" {
+			return utils.ValidationError(fmt.Sprintf("The tags of an IssuingToken %v cannot be empty", kind))
+		}
+	}
+	return Error.StarkErrors{}
+}
+
+func fields(status string, reason Reason, tags []string) map[string]interface{} {
+	authorization := map[string]interface{}{"status": status}
+	if reason != "" {
+		authorization["reason"] = string(reason)
+	}
+	if len(tags) > 0 {
+		authorization["tags"] = tags
+	}
+	return authorization
+}
@@ -1,6 +1,7 @@
 package issuingtoken
 
 import (
+	"context"
 	"encoding/json"
 	Error "github.com/starkinfra/core-go/starkcore/error"
 	"github.com/starkinfra/core-go/starkcore/user/user"
@@ -34,31 +35,44 @@ import (
 //	- DeviceOsVersion [string]: Device operational system version used for tokenization. ex: "4.4.4"
 //	- DeviceImei [string]: Device imei used for tokenization. ex: "352099001761481"
 //	- WalletInstanceId [string]: Unique id referred to the wallet app in the current device. ex: "71583be4777eb89aaf0345eebeb82594f096615ed17862d0"
+//
+//	Attributes (return-only):
+//	- Extra [map[string]json.RawMessage]: Fields of the IssuingToken sent by the API but not declared in this SDK version yet, keyed by their JSON name. ex: map[string]json.RawMessage{"newField": json.RawMessage(`"value"`)}
 
 type IssuingToken struct {
-	Id                 string     `json:",omitempty"`
-	CardId             string     `json:",omitempty"`
-	WalletId           string     `json:",omitempty"`
-	WalletName         string     `json:",omitempty"`
-	MerchantId         string     `json:",omitempty"`
-	ExternalId         string     `json:",omitempty"`
-	Tags               []string   `json:",omitempty"`
-	Status             string     `json:",omitempty"`
-	ActivationCode     string     `json:",omitempty"`
-	MethodCode         string     `json:",omitempty"`
-	DeviceType         string     `json:",omitempty"`
-	DeviceName         string     `json:",omitempty"`
-	DeviceSerialNumber string     `json:",omitempty"`
-	DeviceOsName       string     `json:",omitempty"`
-	DeviceOsVersion    string     `json:",omitempty"`
-	DeviceImei         string     `json:",omitempty"`
-	WalletInstanceId   string     `json:",omitempty"`
-	Updated            *time.Time `json:",omitempty"`
-	Created            *time.Time `json:",omitempty"`
+	Id                 string                     `json:",omitempty"`
+	CardId             string                     `json:",omitempty"`
+	WalletId           string                     `json:",omitempty"`
+	WalletName         string                     `json:",omitempty"`
+	MerchantId         string                     `json:",omitempty"`
+	ExternalId         string                     `json:",omitempty"`
+	Tags               []string                   `json:",omitempty"`
+	Status             string                     `json:",omitempty"`
+	ActivationCode     string                     `json:",omitempty"`
+	MethodCode         string                     `json:",omitempty"`
+	DeviceType         string                     `json:",omitempty"`
+	DeviceName         string                     `json:",omitempty"`
+	DeviceSerialNumber string                     `json:",omitempty"`
+	DeviceOsName       string                     `json:",omitempty"`
+	DeviceOsVersion    string                     `json:",omitempty"`
+	DeviceImei         string                     `json:",omitempty"`
+	WalletInstanceId   string                     `json:",omitempty"`
+	Updated            *time.Time                 `json:",omitempty"`
+	Created            *time.Time                 `json:",omitempty"`
+	Extra              map[string]json.RawMessage `json:"-"`
 }
 
 var resource = map[string]string{"name": "IssuingToken"}
 
+//	IssuingToken Client struct
+//
+//	Runs the IssuingToken functions with the settings of its own utils.Client
+//	instead of starkinfra.User and the other package defaults.
+
+type Client struct {
+	Api *utils.Client
+}
+
 func Get(id string, user user.User) (IssuingToken, Error.StarkErrors) {
 	//	Retrieve a specific IssuingToken by its id
 	//
@@ -72,12 +86,29 @@ func Get(id string, user user.User) (IssuingToken, Error.StarkErrors) {
 	//
 	//	Return:
 	//	- issuingToken struct that corresponds to the given id.
+	return Client{Api: utils.Default(user)}.Get(id)
+}
+
+func GetCtx(ctx context.Context, id string, user user.User) (IssuingToken, Error.StarkErrors) {
+	//	Retrieve a specific IssuingToken by its id
+	//
+	//	Same as Get, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.GetCtx(ctx, id)
+}
+
+func (c Client) Get(id string) (IssuingToken, Error.StarkErrors) {
+	//	Retrieve a specific IssuingToken by its id
+	return c.GetCtx(context.Background(), id)
+}
+
+func (c Client) GetCtx(ctx context.Context, id string) (IssuingToken, Error.StarkErrors) {
+	//	Retrieve a specific IssuingToken by its id
 	var issuingToken IssuingToken
-	get, err := utils.Get(resource, id, nil, user)
-	unmarshalError := json.Unmarshal(get, &issuingToken)
-	if unmarshalError != nil {
+	get, err := c.Api.Get(ctx, resource, id, nil)
+	if err.Errors != nil {
 		return issuingToken, err
 	}
+	err = c.Api.Unmarshal(get, &issuingToken)
 	return issuingToken, err
 }
 
@@ -100,29 +131,133 @@ func Query(params map[string]interface{}, user user.User) (chan IssuingToken, ch
 	//
 	//	Return:
 	//	- channel of IssuingToken structs with updated attributes
-	var issuingToken IssuingToken
+	return Client{Api: utils.Default(user)}.Query(params)
+}
+
+func QueryCtx(ctx context.Context, params map[string]interface{}, user user.User) (chan IssuingToken, chan Error.StarkErrors) {
+	//	Retrieve IssuingTokens
+	//
+	//	Same as Query, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.QueryCtx(ctx, params)
+}
+
+func (c Client) Query(params map[string]interface{}) (chan IssuingToken, chan Error.StarkErrors) {
+	//	Retrieve IssuingTokens
+	return c.QueryCtx(context.Background(), params)
+}
+
+func (c Client) QueryCtx(ctx context.Context, params map[string]interface{}) (chan IssuingToken, chan Error.StarkErrors) {
+	//	Retrieve IssuingTokens
 	tokens := make(chan IssuingToken)
 	tokensError := make(chan Error.StarkErrors)
-	query, errorChannel := utils.Query(resource, params, user)
+	query, errorChannel := c.Api.Stream(ctx, resource, params)
 	go func() {
+		defer close(tokensError)
+		defer close(tokens)
 		for content := range query {
-			contentByte, _ := json.Marshal(content)
-			err := json.Unmarshal(contentByte, &issuingToken)
-			if err != nil {
-				tokensError <- Error.UnknownError(err.Error())
+			var issuingToken IssuingToken
+			err := c.Api.Unmarshal(content, &issuingToken)
+			if err.Errors != nil {
+				if !utils.SendError(ctx, tokensError, err) {
+					return
+				}
 				continue
 			}
-			tokens <- issuingToken
+			select {
+			case tokens <- issuingToken:
+			case <-ctx.Done():
+				return
+			}
 		}
 		for err := range errorChannel {
-			tokensError <- err
+			if !utils.SendError(ctx, tokensError, err) {
+				return
+			}
 		}
-		close(tokens)
-		close(tokensError)
 	}()
 	return tokens, tokensError
 }
 
+//	IssuingToken Iterator struct
+//
+//	Returned by Iterate to walk through IssuingToken structs one at a time,
+//	requesting a new page only once the current one is exhausted. Check Err
+//	once Next returns false and Close it when leaving the loop early.
+
+type Iterator struct {
+	api   *utils.Client
+	pages *utils.Iterator
+	value IssuingToken
+	err   Error.StarkErrors
+}
+
+func Iterate(params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve IssuingTokens
+	//
+	//	Same as Query, but returns an Iterator instead of a pair of channels
+	//
+	//	Example:
+	//	iterator := Iterate(params, nil)
+	//	defer iterator.Close()
+	//	for iterator.Next() {
+	//		fmt.Println(iterator.Value())
+	//	}
+	//	if err := iterator.Err(); err.Errors != nil {
+	//		...
+	//	}
+	return Client{Api: utils.Default(user)}.Iterate(params)
+}
+
+func IterateCtx(ctx context.Context, params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve IssuingTokens
+	//
+	//	Same as Iterate, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.IterateCtx(ctx, params)
+}
+
+func (c Client) Iterate(params map[string]interface{}) *Iterator {
+	//	Retrieve IssuingTokens
+	return c.IterateCtx(context.Background(), params)
+}
+
+func (c Client) IterateCtx(ctx context.Context, params map[string]interface{}) *Iterator {
+	//	Retrieve IssuingTokens
+	return &Iterator{api: c.Api, pages: c.Api.Iterate(ctx, resource, params)}
+}
+
+func (i *Iterator) Next() bool {
+	//	Move to the next IssuingToken, returning false when there are no more or a request failed
+	var value IssuingToken
+	if i.err.Errors != nil || !i.pages.Next() {
+		return false
+	}
+	i.err = i.api.Unmarshal(i.pages.Value(), &value)
+	if i.err.Errors != nil {
+		i.pages.Close()
+		return false
+	}
+	i.value = value
+	return true
+}
+
+func (i *Iterator) Value() IssuingToken {
+	//	Current IssuingToken, set by the last call to Next
+	return i.value
+}
+
+func (i *Iterator) Err() Error.StarkErrors {
+	//	Error that stopped the iteration, if any
+	if i.err.Errors != nil {
+		return i.err
+	}
+	return i.pages.Err()
+}
+
+func (i *Iterator) Close() {
+	//	Stop the iteration, releasing the current page
+	i.pages.Close()
+}
+
 func Page(params map[string]interface{}, user user.User) ([]IssuingToken, string, Error.StarkErrors) {
 	//	Retrieve paged IssuingTokens
 	//
@@ -145,12 +280,29 @@ func Page(params map[string]interface{}, user user.User) ([]IssuingToken, string
 	//	Return:
 	//	- slice of IssuingToken structs with updated attributes
 	//	- cursor to retrieve the next page of IssuingToken structs
+	return Client{Api: utils.Default(user)}.Page(params)
+}
+
+func PageCtx(ctx context.Context, params map[string]interface{}, user user.User) ([]IssuingToken, string, Error.StarkErrors) {
+	//	Retrieve paged IssuingTokens
+	//
+	//	Same as Page, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.PageCtx(ctx, params)
+}
+
+func (c Client) Page(params map[string]interface{}) ([]IssuingToken, string, Error.StarkErrors) {
+	//	Retrieve paged IssuingTokens
+	return c.PageCtx(context.Background(), params)
+}
+
+func (c Client) PageCtx(ctx context.Context, params map[string]interface{}) ([]IssuingToken, string, Error.StarkErrors) {
+	//	Retrieve paged IssuingTokens
 	var issuingTokens []IssuingToken
-	page, cursor, err := utils.Page(resource, params, user)
-	unmarshalError := json.Unmarshal(page, &issuingTokens)
-	if unmarshalError != nil {
+	page, cursor, err := c.Api.Page(ctx, resource, params)
+	if err.Errors != nil {
 		return issuingTokens, cursor, err
 	}
+	err = c.Api.Unmarshal(page, &issuingTokens)
 	return issuingTokens, cursor, err
 }
 
@@ -171,12 +323,29 @@ func Update(id string, patchData map[string]interface{}, user user.User) (Issuin
 	//
 	//	Return:
 	//	- target IssuingToken with updated attributes
+	return Client{Api: utils.Default(user)}.Update(id, patchData)
+}
+
+func UpdateCtx(ctx context.Context, id string, patchData map[string]interface{}, user user.User) (IssuingToken, Error.StarkErrors) {
+	//	Update IssuingToken entity
+	//
+	//	Same as Update, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.UpdateCtx(ctx, id, patchData)
+}
+
+func (c Client) Update(id string, patchData map[string]interface{}) (IssuingToken, Error.StarkErrors) {
+	//	Update IssuingToken entity
+	return c.UpdateCtx(context.Background(), id, patchData)
+}
+
+func (c Client) UpdateCtx(ctx context.Context, id string, patchData map[string]interface{}) (IssuingToken, Error.StarkErrors) {
+	//	Update IssuingToken entity
 	var issuingToken IssuingToken
-	update, err := utils.Patch(resource, id, patchData, user)
-	unmarshalError := json.Unmarshal(update, &issuingToken)
-	if unmarshalError != nil {
+	update, err := c.Api.Patch(ctx, resource, id, patchData)
+	if err.Errors != nil {
 		return issuingToken, err
 	}
+	err = c.Api.Unmarshal(update, &issuingToken)
 	return issuingToken, err
 }
 
@@ -193,12 +362,29 @@ func Cancel(id string, user user.User) (IssuingToken, Error.StarkErrors) {
 	//
 	//	Return:
 	//	- canceled IssuingToken struct
+	return Client{Api: utils.Default(user)}.Cancel(id)
+}
+
+func CancelCtx(ctx context.Context, id string, user user.User) (IssuingToken, Error.StarkErrors) {
+	//	Cancel an IssuingToken entity
+	//
+	//	Same as Cancel, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.CancelCtx(ctx, id)
+}
+
+func (c Client) Cancel(id string) (IssuingToken, Error.StarkErrors) {
+	//	Cancel an IssuingToken entity
+	return c.CancelCtx(context.Background(), id)
+}
+
+func (c Client) CancelCtx(ctx context.Context, id string) (IssuingToken, Error.StarkErrors) {
+	//	Cancel an IssuingToken entity
 	var issuingToken IssuingToken
-	deleted, err := utils.Delete(resource, id, user)
-	unmarshalError := json.Unmarshal(deleted, &issuingToken)
-	if unmarshalError != nil {
+	deleted, err := c.Api.Delete(ctx, resource, id)
+	if err.Errors != nil {
 		return issuingToken, err
 	}
+	err = c.Api.Unmarshal(deleted, &issuingToken)
 	return issuingToken, err
 }
 
@@ -218,15 +404,32 @@ func Parse(content string, signature string, user user.User) (IssuingToken, Erro
 	//
 	//	Return:
 	//	- parsed IssuingToken struct
+	return Client{Api: utils.Default(user)}.Parse(content, signature)
+}
+
+func ParseCtx(ctx context.Context, content string, signature string, user user.User) (IssuingToken, Error.StarkErrors) {
+	//	Create a single verified IssuingToken request from a content string
+	//
+	//	Same as Parse, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.ParseCtx(ctx, content, signature)
+}
+
+func (c Client) Parse(content string, signature string) (IssuingToken, Error.StarkErrors) {
+	//	Create a single verified IssuingToken request from a content string
+	return c.ParseCtx(context.Background(), content, signature)
+}
+
+func (c Client) ParseCtx(ctx context.Context, content string, signature string) (IssuingToken, Error.StarkErrors) {
+	//	Create a single verified IssuingToken request from a content string
 	var issuingToken IssuingToken
-	parsed, err := utils.ParseAndVerify(content, signature, "", user)
+	parsed, err := c.Api.ParseAndVerify(ctx, content, signature, "")
 	if err.Errors != nil {
 		return issuingToken, err
 	}
 
-	unmarshalError := json.Unmarshal([]byte(parsed), &issuingToken)
-	if unmarshalError != nil {
-		return issuingToken, Error.UnknownError(unmarshalError.Error())
+	unmarshalError := c.Api.Unmarshal([]byte(parsed), &issuingToken)
+	if unmarshalError.Errors != nil {
+		return issuingToken, unmarshalError
 	}
 
 	return issuingToken, Error.StarkErrors{}
@@ -1,6 +1,7 @@
 package issuingtokendesign
 
 import (
+	"context"
 	"encoding/json"
 	Error "github.com/starkinfra/core-go/starkcore/error"
 	"github.com/starkinfra/core-go/starkcore/user/user"
@@ -18,16 +19,27 @@ import (
 //	- Name [string]: design name. ex: "Stark Bank - White Metal"
 //	- Created [time.Time]: creation datetime for the IssuingTokenDesign. ex: time.Date(2020, 3, 10, 10, 30, 0, 0, time.UTC),
 //	- Updated [time.Time]: latest update datetime for the IssuingTokenDesign. ex: time.Date(2020, 3, 10, 10, 30, 0, 0, time.UTC),
+//	- Extra [map[string]json.RawMessage]: Fields of the IssuingTokenDesign sent by the API but not declared in this SDK version yet, keyed by their JSON name. ex: map[string]json.RawMessage{"newField": json.RawMessage(`"value"`)}
 
 type IssuingTokenDesign struct {
-	Id      string     `json:",omitempty"`
-	Name    string     `json:",omitempty"`
-	Created *time.Time `json:",omitempty"`
-	Updated *time.Time `json:",omitempty"`
+	Id      string                     `json:",omitempty"`
+	Name    string                     `json:",omitempty"`
+	Created *time.Time                 `json:",omitempty"`
+	Updated *time.Time                 `json:",omitempty"`
+	Extra   map[string]json.RawMessage `json:"-"`
 }
 
 var resource = map[string]string{"name": "IssuingTokenDesign"}
 
+//	IssuingTokenDesign Client struct
+//
+//	Runs the IssuingTokenDesign functions with the settings of its own utils.Client
+//	instead of starkinfra.User and the other package defaults.
+
+type Client struct {
+	Api *utils.Client
+}
+
 func Get(id string, user user.User) (IssuingTokenDesign, Error.StarkErrors) {
 	//	Retrieve a specific IssuingTokenDesign by its id
 	//
@@ -41,12 +53,29 @@ func Get(id string, user user.User) (IssuingTokenDesign, Error.StarkErrors) {
 	//
 	//	Return:
 	//	- issuingTokenDesign struct that corresponds to the given id.
+	return Client{Api: utils.Default(user)}.Get(id)
+}
+
+func GetCtx(ctx context.Context, id string, user user.User) (IssuingTokenDesign, Error.StarkErrors) {
+	//	Retrieve a specific IssuingTokenDesign by its id
+	//
+	//	Same as Get, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.GetCtx(ctx, id)
+}
+
+func (c Client) Get(id string) (IssuingTokenDesign, Error.StarkErrors) {
+	//	Retrieve a specific IssuingTokenDesign by its id
+	return c.GetCtx(context.Background(), id)
+}
+
+func (c Client) GetCtx(ctx context.Context, id string) (IssuingTokenDesign, Error.StarkErrors) {
+	//	Retrieve a specific IssuingTokenDesign by its id
 	var issuingTokenDesign IssuingTokenDesign
-	get, err := utils.Get(resource, id, nil, user)
-	unmarshalError := json.Unmarshal(get, &issuingTokenDesign)
-	if unmarshalError != nil {
+	get, err := c.Api.Get(ctx, resource, id, nil)
+	if err.Errors != nil {
 		return issuingTokenDesign, err
 	}
+	err = c.Api.Unmarshal(get, &issuingTokenDesign)
 	return issuingTokenDesign, err
 }
 
@@ -63,29 +92,133 @@ func Query(params map[string]interface{}, user user.User) (chan IssuingTokenDesi
 	//
 	//	Return:
 	//	- channel of IssuingTokenDesign structs with updated attributes
-	var issuingTokenDesign IssuingTokenDesign
+	return Client{Api: utils.Default(user)}.Query(params)
+}
+
+func QueryCtx(ctx context.Context, params map[string]interface{}, user user.User) (chan IssuingTokenDesign, chan Error.StarkErrors) {
+	//	Retrieve IssuingTokenDesigns
+	//
+	//	Same as Query, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.QueryCtx(ctx, params)
+}
+
+func (c Client) Query(params map[string]interface{}) (chan IssuingTokenDesign, chan Error.StarkErrors) {
+	//	Retrieve IssuingTokenDesigns
+	return c.QueryCtx(context.Background(), params)
+}
+
+func (c Client) QueryCtx(ctx context.Context, params map[string]interface{}) (chan IssuingTokenDesign, chan Error.StarkErrors) {
+	//	Retrieve IssuingTokenDesigns
 	designs := make(chan IssuingTokenDesign)
 	designsError := make(chan Error.StarkErrors)
-	query, errorChannel := utils.Query(resource, params, user)
+	query, errorChannel := c.Api.Stream(ctx, resource, params)
 	go func() {
+		defer close(designsError)
+		defer close(designs)
 		for content := range query {
-			contentByte, _ := json.Marshal(content)
-			err := json.Unmarshal(contentByte, &issuingTokenDesign)
-			if err != nil {
-				designsError <- Error.UnknownError(err.Error())
+			var issuingTokenDesign IssuingTokenDesign
+			err := c.Api.Unmarshal(content, &issuingTokenDesign)
+			if err.Errors != nil {
+				if !utils.SendError(ctx, designsError, err) {
+					return
+				}
 				continue
 			}
-			designs <- issuingTokenDesign
+			select {
+			case designs <- issuingTokenDesign:
+			case <-ctx.Done():
+				return
+			}
 		}
 		for err := range errorChannel {
-			designsError <- err
+			if !utils.SendError(ctx, designsError, err) {
+				return
+			}
 		}
-		close(designs)
-		close(designsError)
 	}()
 	return designs, designsError
 }
 
+//	IssuingTokenDesign Iterator struct
+//
+//	Returned by Iterate to walk through IssuingTokenDesign structs one at a time,
+//	requesting a new page only once the current one is exhausted. Check Err
+//	once Next returns false and Close it when leaving the loop early.
+
+type Iterator struct {
+	api   *utils.Client
+	pages *utils.Iterator
+	value IssuingTokenDesign
+	err   Error.StarkErrors
+}
+
+func Iterate(params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve IssuingTokenDesigns
+	//
+	//	Same as Query, but returns an Iterator instead of a pair of channels
+	//
+	//	Example:
+	//	iterator := Iterate(params, nil)
+	//	defer iterator.Close()
+	//	for iterator.Next() {
+	//		fmt.Println(iterator.Value())
+	//	}
+	//	if err := iterator.Err(); err.Errors != nil {
+	//		...
+	//	}
+	return Client{Api: utils.Default(user)}.Iterate(params)
+}
+
+func IterateCtx(ctx context.Context, params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve IssuingTokenDesigns
+	//
+	//	Same as Iterate, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.IterateCtx(ctx, params)
+}
+
+func (c Client) Iterate(params map[string]interface{}) *Iterator {
+	//	Retrieve IssuingTokenDesigns
+	return c.IterateCtx(context.Background(), params)
+}
+
+func (c Client) IterateCtx(ctx context.Context, params map[string]interface{}) *Iterator {
+	//	Retrieve IssuingTokenDesigns
+	return &Iterator{api: c.Api, pages: c.Api.Iterate(ctx, resource, params)}
+}
+
+func (i *Iterator) Next() bool {
+	//	Move to the next IssuingTokenDesign, returning false when there are no more or a request failed
+	var value IssuingTokenDesign
+	if i.err.Errors != nil || !i.pages.Next() {
+		return false
+	}
+	i.err = i.api.Unmarshal(i.pages.Value(), &value)
+	if i.err.Errors != nil {
+		i.pages.Close()
+		return false
+	}
+	i.value = value
+	return true
+}
+
+func (i *Iterator) Value() IssuingTokenDesign {
+	//	Current IssuingTokenDesign, set by the last call to Next
+	return i.value
+}
+
+func (i *Iterator) Err() Error.StarkErrors {
+	//	Error that stopped the iteration, if any
+	if i.err.Errors != nil {
+		return i.err
+	}
+	return i.pages.Err()
+}
+
+func (i *Iterator) Close() {
+	//	Stop the iteration, releasing the current page
+	i.pages.Close()
+}
+
 func Page(params map[string]interface{}, user user.User) ([]IssuingTokenDesign, string, Error.StarkErrors) {
 	//	Retrieve paged IssuingTokenDesign structs
 	//
@@ -102,12 +235,29 @@ func Page(params map[string]interface{}, user user.User) ([]IssuingTokenDesign,
 	//	Return:
 	//	- slice of IssuingTokenDesign structs with updated attributes
 	//	- cursor to retrieve the next page of IssuingTokenDesign structs
+	return Client{Api: utils.Default(user)}.Page(params)
+}
+
+func PageCtx(ctx context.Context, params map[string]interface{}, user user.User) ([]IssuingTokenDesign, string, Error.StarkErrors) {
+	//	Retrieve paged IssuingTokenDesign structs
+	//
+	//	Same as Page, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.PageCtx(ctx, params)
+}
+
+func (c Client) Page(params map[string]interface{}) ([]IssuingTokenDesign, string, Error.StarkErrors) {
+	//	Retrieve paged IssuingTokenDesign structs
+	return c.PageCtx(context.Background(), params)
+}
+
+func (c Client) PageCtx(ctx context.Context, params map[string]interface{}) ([]IssuingTokenDesign, string, Error.StarkErrors) {
+	//	Retrieve paged IssuingTokenDesign structs
 	var issuingTokenDesigns []IssuingTokenDesign
-	page, cursor, err := utils.Page(resource, params, user)
-	unmarshalError := json.Unmarshal(page, &issuingTokenDesigns)
-	if unmarshalError != nil {
+	page, cursor, err := c.Api.Page(ctx, resource, params)
+	if err.Errors != nil {
 		return issuingTokenDesigns, cursor, err
 	}
+	err = c.Api.Unmarshal(page, &issuingTokenDesigns)
 	return issuingTokenDesigns, cursor, err
 }
 
@@ -124,5 +274,22 @@ func Pdf(id string, user user.User) ([]byte, Error.StarkErrors) {
 	//
 	//	Return:
 	//	- IssuingTokenDesign pdf file
-	return utils.GetContent(resource, id, nil, user, "pdf")
+	return Client{Api: utils.Default(user)}.Pdf(id)
+}
+
+func PdfCtx(ctx context.Context, id string, user user.User) ([]byte, Error.StarkErrors) {
+	//	Retrieve a specific IssuingTokenDesign pdf file
+	//
+	//	Same as Pdf, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.PdfCtx(ctx, id)
+}
+
+func (c Client) Pdf(id string) ([]byte, Error.StarkErrors) {
+	//	Retrieve a specific IssuingTokenDesign pdf file
+	return c.PdfCtx(context.Background(), id)
+}
+
+func (c Client) PdfCtx(ctx context.Context, id string) ([]byte, Error.StarkErrors) {
+	//	Retrieve a specific IssuingTokenDesign pdf file
+	return c.Api.GetContent(ctx, resource, id, nil, "pdf")
 }
@@ -1,6 +1,7 @@
 package issuingtokenrequest
 
 import (
+	"context"
 	"encoding/json"
 	Error "github.com/starkinfra/core-go/starkcore/error"
 	"github.com/starkinfra/core-go/starkcore/user/user"
@@ -20,18 +21,29 @@ import (
 //	- Content [string]: token request content. ex: "eyJwdWJsaWNLZXlGaW5nZXJwcmludCI6ICJlNTNiZThjZTRhYWQxNWU2OWNmMjExOTA5Mjk4YzJkOTE0O..."
 //	- Signature [string]: token request signature. ex: "eyJwdWJsaWNLZXlGaW5nZXJwcmludCI6ICJlNTNiZThjZTRhYWQxNWU2OWNmMjExOTA5Mjk4YzJkOTE0O..."
 //	- Metadata [map[string]interface{}]: map used to store additional information about the IssuingTokenRequest object.
+//	- Extra [map[string]json.RawMessage]: Fields of the IssuingTokenRequest sent by the API but not declared in this SDK version yet, keyed by their JSON name. ex: map[string]json.RawMessage{"newField": json.RawMessage(`"value"`)}
 
 type IssuingTokenRequest struct {
-	CardId     string                 `json:",omitempty"`
-	WalletId   string                 `json:",omitempty"`
-	MethodCode string                 `json:",omitempty"`
-	Content    string                 `json:",omitempty"`
-	Signature  string                 `json:",omitempty"`
-	Metadata   map[string]interface{} `json:",omitempty"`
+	CardId     string                     `json:",omitempty"`
+	WalletId   string                     `json:",omitempty"`
+	MethodCode string                     `json:",omitempty"`
+	Content    string                     `json:",omitempty"`
+	Signature  string                     `json:",omitempty"`
+	Metadata   map[string]interface{}     `json:",omitempty"`
+	Extra      map[string]json.RawMessage `json:"-"`
 }
 
 var resource = map[string]string{"name": "IssuingTokenRequest"}
 
+//	IssuingTokenRequest Client struct
+//
+//	Runs the IssuingTokenRequest functions with the settings of its own utils.Client
+//	instead of starkinfra.User and the other package defaults.
+
+type Client struct {
+	Api *utils.Client
+}
+
 func Create(request IssuingTokenRequest, user user.User) (IssuingTokenRequest, Error.StarkErrors) {
 	//	Create an IssuingTokenRequest
 	//
@@ -45,10 +57,27 @@ func Create(request IssuingTokenRequest, user user.User) (IssuingTokenRequest, E
 	//
 	//	Return:
 	//	- IssuingTokenRequest struct with updated attributes
-	create, err := utils.Single(resource, request, user)
-	unmarshalError := json.Unmarshal(create, &request)
-	if unmarshalError != nil {
+	return Client{Api: utils.Default(user)}.Create(request)
+}
+
+func CreateCtx(ctx context.Context, request IssuingTokenRequest, user user.User) (IssuingTokenRequest, Error.StarkErrors) {
+	//	Create an IssuingTokenRequest
+	//
+	//	Same as Create, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.CreateCtx(ctx, request)
+}
+
+func (c Client) Create(request IssuingTokenRequest) (IssuingTokenRequest, Error.StarkErrors) {
+	//	Create an IssuingTokenRequest
+	return c.CreateCtx(context.Background(), request)
+}
+
+func (c Client) CreateCtx(ctx context.Context, request IssuingTokenRequest) (IssuingTokenRequest, Error.StarkErrors) {
+	//	Create an IssuingTokenRequest
+	create, err := c.Api.Single(ctx, resource, request)
+	if err.Errors != nil {
 		return request, err
 	}
+	err = c.Api.Unmarshal(create, &request)
 	return request, err
 }
@@ -1,6 +1,7 @@
 package issuingtransaction
 
 import (
+	"context"
 	"encoding/json"
 	Error "github.com/starkinfra/core-go/starkcore/error"
 	"github.com/starkinfra/core-go/starkcore/user/user"
@@ -20,19 +21,30 @@ import (
 //	- Source [string]: Source of the transaction. ex: "issuing-purchase/5656565656565656"
 //	- Tags [string]: Slice of strings inherited from the source resource. ex: []string{"tony", "stark"}
 //	- Created [time.Time]: Creation datetime for the IssuingTransaction. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC),
+//	- Extra [map[string]json.RawMessage]: Fields of the IssuingTransaction sent by the API but not declared in this SDK version yet, keyed by their JSON name. ex: map[string]json.RawMessage{"newField": json.RawMessage(`"value"`)}
 
 type IssuingTransaction struct {
-	Id          string     `json:",omitempty"`
-	Amount      int        `json:",omitempty"`
-	Balance     int        `json:",omitempty"`
-	Description string     `json:",omitempty"`
-	Source      string     `json:",omitempty"`
-	Tags        []string   `json:",omitempty"`
-	Created     *time.Time `json:",omitempty"`
+	Id          string                     `json:",omitempty"`
+	Amount      int                        `json:",omitempty"`
+	Balance     int                        `json:",omitempty"`
+	Description string                     `json:",omitempty"`
+	Source      string                     `json:",omitempty"`
+	Tags        []string                   `json:",omitempty"`
+	Created     *time.Time                 `json:",omitempty"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 var resource = map[string]string{"name": "IssuingTransaction"}
 
+//	IssuingTransaction Client struct
+//
+//	Runs the IssuingTransaction functions with the settings of its own utils.Client
+//	instead of starkinfra.User and the other package defaults.
+
+type Client struct {
+	Api *utils.Client
+}
+
 func Get(id string, user user.User) (IssuingTransaction, Error.StarkErrors) {
 	//	Retrieve a specific IssuingTransaction by its id
 	//
@@ -46,12 +58,29 @@ func Get(id string, user user.User) (IssuingTransaction, Error.StarkErrors) {
 	//
 	//	Return:
 	//	- issuingTransaction struct that corresponds to the given id.
+	return Client{Api: utils.Default(user)}.Get(id)
+}
+
+func GetCtx(ctx context.Context, id string, user user.User) (IssuingTransaction, Error.StarkErrors) {
+	//	Retrieve a specific IssuingTransaction by its id
+	//
+	//	Same as Get, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.GetCtx(ctx, id)
+}
+
+func (c Client) Get(id string) (IssuingTransaction, Error.StarkErrors) {
+	//	Retrieve a specific IssuingTransaction by its id
+	return c.GetCtx(context.Background(), id)
+}
+
+func (c Client) GetCtx(ctx context.Context, id string) (IssuingTransaction, Error.StarkErrors) {
+	//	Retrieve a specific IssuingTransaction by its id
 	var issuingTransaction IssuingTransaction
-	get, err := utils.Get(resource, id, nil, user)
-	unmarshalError := json.Unmarshal(get, &issuingTransaction)
-	if unmarshalError != nil {
+	get, err := c.Api.Get(ctx, resource, id, nil)
+	if err.Errors != nil {
 		return issuingTransaction, err
 	}
+	err = c.Api.Unmarshal(get, &issuingTransaction)
 	return issuingTransaction, err
 }
 
@@ -73,29 +102,133 @@ func Query(params map[string]interface{}, user user.User) (chan IssuingTransacti
 	//
 	//	Return:
 	//	- channel of IssuingTransaction structs with updated attributes
-	var issuingTransaction IssuingTransaction
+	return Client{Api: utils.Default(user)}.Query(params)
+}
+
+func QueryCtx(ctx context.Context, params map[string]interface{}, user user.User) (chan IssuingTransaction, chan Error.StarkErrors) {
+	//	Retrieve IssuingTransaction structs
+	//
+	//	Same as Query, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.QueryCtx(ctx, params)
+}
+
+func (c Client) Query(params map[string]interface{}) (chan IssuingTransaction, chan Error.StarkErrors) {
+	//	Retrieve IssuingTransaction structs
+	return c.QueryCtx(context.Background(), params)
+}
+
+func (c Client) QueryCtx(ctx context.Context, params map[string]interface{}) (chan IssuingTransaction, chan Error.StarkErrors) {
+	//	Retrieve IssuingTransaction structs
 	transactions := make(chan IssuingTransaction)
 	transactionsError := make(chan Error.StarkErrors)
-	query, errorChannel := utils.Query(resource, params, user)
+	query, errorChannel := c.Api.Stream(ctx, resource, params)
 	go func() {
+		defer close(transactionsError)
+		defer close(transactions)
 		for content := range query {
-			contentByte, _ := json.Marshal(content)
-			err := json.Unmarshal(contentByte, &issuingTransaction)
-			if err != nil {
-				transactionsError <- Error.UnknownError(err.Error())
+			var issuingTransaction IssuingTransaction
+			err := c.Api.Unmarshal(content, &issuingTransaction)
+			if err.Errors != nil {
+				if !utils.SendError(ctx, transactionsError, err) {
+					return
+				}
 				continue
 			}
-			transactions <- issuingTransaction
+			select {
+			case transactions <- issuingTransaction:
+			case <-ctx.Done():
+				return
+			}
 		}
 		for err := range errorChannel {
-			transactionsError <- err
+			if !utils.SendError(ctx, transactionsError, err) {
+				return
+			}
 		}
-		close(transactions)
-		close(transactionsError)
 	}()
 	return transactions, transactionsError
 }
 
+//	IssuingTransaction Iterator struct
+//
+//	Returned by Iterate to walk through IssuingTransaction structs one at a time,
+//	requesting a new page only once the current one is exhausted. Check Err
+//	once Next returns false and Close it when leaving the loop early.
+
+type Iterator struct {
+	api   *utils.Client
+	pages *utils.Iterator
+	value IssuingTransaction
+	err   Error.StarkErrors
+}
+
+func Iterate(params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve IssuingTransaction structs
+	//
+	//	Same as Query, but returns an Iterator instead of a pair of channels
+	//
+	//	Example:
+	//	iterator := Iterate(params, nil)
+	//	defer iterator.Close()
+	//	for iterator.Next() {
+	//		fmt.Println(iterator.Value())
+	//	}
+	//	if err := iterator.Err(); err.Errors != nil {
+	//		...
+	//	}
+	return Client{Api: utils.Default(user)}.Iterate(params)
+}
+
+func IterateCtx(ctx context.Context, params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve IssuingTransaction structs
+	//
+	//	Same as Iterate, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.IterateCtx(ctx, params)
+}
+
+func (c Client) Iterate(params map[string]interface{}) *Iterator {
+	//	Retrieve IssuingTransaction structs
+	return c.IterateCtx(context.Background(), params)
+}
+
+func (c Client) IterateCtx(ctx context.Context, params map[string]interface{}) *Iterator {
+	//	Retrieve IssuingTransaction structs
+	return &Iterator{api: c.Api, pages: c.Api.Iterate(ctx, resource, params)}
+}
+
+func (i *Iterator) Next() bool {
+	//	Move to the next IssuingTransaction, returning false when there are no more or a request failed
+	var value IssuingTransaction
+	if i.err.Errors != nil || !i.pages.Next() {
+		return false
+	}
+	i.err = i.api.Unmarshal(i.pages.Value(), &value)
+	if i.err.Errors != nil {
+		i.pages.Close()
+		return false
+	}
+	i.value = value
+	return true
+}
+
+func (i *Iterator) Value() IssuingTransaction {
+	//	Current IssuingTransaction, set by the last call to Next
+	return i.value
+}
+
+func (i *Iterator) Err() Error.StarkErrors {
+	//	Error that stopped the iteration, if any
+	if i.err.Errors != nil {
+		return i.err
+	}
+	return i.pages.Err()
+}
+
+func (i *Iterator) Close() {
+	//	Stop the iteration, releasing the current page
+	i.pages.Close()
+}
+
 func Page(params map[string]interface{}, user user.User) ([]IssuingTransaction, string, Error.StarkErrors) {
 	//	Retrieve paged IssuingTransaction structs
 	//
@@ -117,11 +250,28 @@ func Page(params map[string]interface{}, user user.User) ([]IssuingTransaction,
 	//	Return:
 	//	- slice of IssuingTransaction structs with updated attributes
 	//	- cursor to retrieve the next page of IssuingPurchase structs
+	return Client{Api: utils.Default(user)}.Page(params)
+}
+
+func PageCtx(ctx context.Context, params map[string]interface{}, user user.User) ([]IssuingTransaction, string, Error.StarkErrors) {
+	//	Retrieve paged IssuingTransaction structs
+	//
+	//	Same as Page, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.PageCtx(ctx, params)
+}
+
+func (c Client) Page(params map[string]interface{}) ([]IssuingTransaction, string, Error.StarkErrors) {
+	//	Retrieve paged IssuingTransaction structs
+	return c.PageCtx(context.Background(), params)
+}
+
+func (c Client) PageCtx(ctx context.Context, params map[string]interface{}) ([]IssuingTransaction, string, Error.StarkErrors) {
+	//	Retrieve paged IssuingTransaction structs
 	var issuingTransactions []IssuingTransaction
-	page, cursor, err := utils.Page(resource, params, user)
-	unmarshalError := json.Unmarshal(page, &issuingTransactions)
-	if unmarshalError != nil {
+	page, cursor, err := c.Api.Page(ctx, resource, params)
+	if err.Errors != nil {
 		return issuingTransactions, cursor, err
 	}
+	err = c.Api.Unmarshal(page, &issuingTransactions)
 	return issuingTransactions, cursor, err
 }
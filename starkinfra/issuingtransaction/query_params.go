@@ -0,0 +1,36 @@
+package issuingtransaction
+
+import (
+	"github.com/starkinfra/sdk-go/starkinfra/utils"
+	"time"
+)
+
+//	IssuingTransaction QueryParams struct
+//
+//	Typed filters for Query, Iterate and Page. Fields left empty are not sent.
+//
+//	Attributes:
+//	- Limit [int, default nil]: Maximum number of structs to be retrieved. Unlimited if nil, except on Page, which returns up to 100. ex: 35
+//	- Cursor [string, default nil]: Cursor returned on the previous Page call. Only used by Page
+//	- Before [time.Time, default nil]: Date filter for structs created only before specified date. ex: time.Date(2022, 11, 10, 0, 0, 0, 0, time.UTC)
+//	- After [time.Time, default nil]: Date filter for structs created only after specified date. ex: time.Date(2022, 11, 10, 0, 0, 0, 0, time.UTC)
+//	- Tags [slice of strings, default nil]: Tags to filter retrieved structs. ex: []string{"tony", "stark"}
+//	- ExternalIds [slice of strings, default nil]: External IDs. ex: []string{"5656565656565656", "4545454545454545"}
+//	- Status [string, default nil]: Filter for status of retrieved structs. ex: "approved", "canceled", "denied", "confirmed" or "voided"
+//	- Ids [slice of strings, default nil]: Purchase IDs
+
+type QueryParams struct {
+	Limit       int       `json:"limit,omitempty"`
+	Cursor      string    `json:"cursor,omitempty"`
+	Before      time.Time `json:"before,omitempty"`
+	After       time.Time `json:"after,omitempty"`
+	Tags        []string  `json:"tags,omitempty"`
+	ExternalIds []string  `json:"externalIds,omitempty"`
+	Status      string    `json:"status,omitempty"`
+	Ids         []string  `json:"ids,omitempty"`
+}
+
+func (p QueryParams) Map() map[string]interface{} {
+	//	Convert the QueryParams into the params map taken by Query, Iterate and Page
+	return utils.ParamsMap(p)
+}
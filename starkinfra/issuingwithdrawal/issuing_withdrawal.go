@@ -1,6 +1,7 @@
 package issuingwithdrawal
 
 import (
+	"context"
 	"encoding/json"
 	Error "github.com/starkinfra/core-go/starkcore/error"
 	"github.com/starkinfra/core-go/starkcore/user/user"
@@ -27,21 +28,32 @@ import (
 //	- IssuingTransactionId [string]: Issuing ledger transaction ids linked to this IssuingWithdrawal
 //	- Updated [time.Time]: Latest update datetime for the IssuingWithdrawal. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC),
 //	- Created [time.Time]: Creation datetime for the IssuingWithdrawal. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC),
+//	- Extra [map[string]json.RawMessage]: Fields of the IssuingWithdrawal sent by the API but not declared in this SDK version yet, keyed by their JSON name. ex: map[string]json.RawMessage{"newField": json.RawMessage(`"value"`)}
 
 type IssuingWithdrawal struct {
-	Amount               int        `json:",omitempty"`
-	ExternalId           string     `json:",omitempty"`
-	Description          string     `json:",omitempty"`
-	Tags                 []string   `json:",omitempty"`
-	Id                   string     `json:",omitempty"`
-	TransactionId        string     `json:",omitempty"`
-	IssuingTransactionId string     `json:",omitempty"`
-	Updated              *time.Time `json:",omitempty"`
-	Created              *time.Time `json:",omitempty"`
+	Amount               int                        `json:",omitempty"`
+	ExternalId           string                     `json:",omitempty"`
+	Description          string                     `json:",omitempty"`
+	Tags                 []string                   `json:",omitempty"`
+	Id                   string                     `json:",omitempty"`
+	TransactionId        string                     `json:",omitempty"`
+	IssuingTransactionId string                     `json:",omitempty"`
+	Updated              *time.Time                 `json:",omitempty"`
+	Created              *time.Time                 `json:",omitempty"`
+	Extra                map[string]json.RawMessage `json:"-"`
 }
 
 var resource = map[string]string{"name": "IssuingWithdrawal"}
 
+//	IssuingWithdrawal Client struct
+//
+//	Runs the IssuingWithdrawal functions with the settings of its own utils.Client
+//	instead of starkinfra.User and the other package defaults.
+
+type Client struct {
+	Api *utils.Client
+}
+
 func Create(withdrawal IssuingWithdrawal, user user.User) (IssuingWithdrawal, Error.StarkErrors) {
 	//	Create an IssuingWithdrawal
 	//
@@ -55,11 +67,28 @@ func Create(withdrawal IssuingWithdrawal, user user.User) (IssuingWithdrawal, Er
 	//
 	//	Return:
 	//	- issuingWithdrawal struct with updated attributes
-	create, err := utils.Single(resource, withdrawal, user)
-	unmarshalError := json.Unmarshal(create, &withdrawal)
-	if unmarshalError != nil {
+	return Client{Api: utils.Default(user)}.Create(withdrawal)
+}
+
+func CreateCtx(ctx context.Context, withdrawal IssuingWithdrawal, user user.User) (IssuingWithdrawal, Error.StarkErrors) {
+	//	Create an IssuingWithdrawal
+	//
+	//	Same as Create, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.CreateCtx(ctx, withdrawal)
+}
+
+func (c Client) Create(withdrawal IssuingWithdrawal) (IssuingWithdrawal, Error.StarkErrors) {
+	//	Create an IssuingWithdrawal
+	return c.CreateCtx(context.Background(), withdrawal)
+}
+
+func (c Client) CreateCtx(ctx context.Context, withdrawal IssuingWithdrawal) (IssuingWithdrawal, Error.StarkErrors) {
+	//	Create an IssuingWithdrawal
+	create, err := c.Api.Single(ctx, resource, withdrawal)
+	if err.Errors != nil {
 		return withdrawal, err
 	}
+	err = c.Api.Unmarshal(create, &withdrawal)
 	return withdrawal, err
 }
 
@@ -76,12 +105,29 @@ func Get(id string, user user.User) (IssuingWithdrawal, Error.StarkErrors) {
 	//
 	//	Return:
 	//	- issuingWithdrawal struct that corresponds to the given id.
+	return Client{Api: utils.Default(user)}.Get(id)
+}
+
+func GetCtx(ctx context.Context, id string, user user.User) (IssuingWithdrawal, Error.StarkErrors) {
+	//	Retrieve a specific IssuingWithdrawal by its id
+	//
+	//	Same as Get, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.GetCtx(ctx, id)
+}
+
+func (c Client) Get(id string) (IssuingWithdrawal, Error.StarkErrors) {
+	//	Retrieve a specific IssuingWithdrawal by its id
+	return c.GetCtx(context.Background(), id)
+}
+
+func (c Client) GetCtx(ctx context.Context, id string) (IssuingWithdrawal, Error.StarkErrors) {
+	//	Retrieve a specific IssuingWithdrawal by its id
 	var issuingWithdrawal IssuingWithdrawal
-	get, err := utils.Get(resource, id, nil, user)
-	unmarshalError := json.Unmarshal(get, &issuingWithdrawal)
-	if unmarshalError != nil {
+	get, err := c.Api.Get(ctx, resource, id, nil)
+	if err.Errors != nil {
 		return issuingWithdrawal, err
 	}
+	err = c.Api.Unmarshal(get, &issuingWithdrawal)
 	return issuingWithdrawal, err
 }
 
@@ -101,29 +147,133 @@ func Query(params map[string]interface{}, user user.User) (chan IssuingWithdrawa
 	//
 	//	Return:
 	//	- channel of IssuingWithdrawal structs with updated attributes
-	var issuingWithdrawal IssuingWithdrawal
+	return Client{Api: utils.Default(user)}.Query(params)
+}
+
+func QueryCtx(ctx context.Context, params map[string]interface{}, user user.User) (chan IssuingWithdrawal, chan Error.StarkErrors) {
+	//	Retrieve IssuingWithdrawal structs
+	//
+	//	Same as Query, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.QueryCtx(ctx, params)
+}
+
+func (c Client) Query(params map[string]interface{}) (chan IssuingWithdrawal, chan Error.StarkErrors) {
+	//	Retrieve IssuingWithdrawal structs
+	return c.QueryCtx(context.Background(), params)
+}
+
+func (c Client) QueryCtx(ctx context.Context, params map[string]interface{}) (chan IssuingWithdrawal, chan Error.StarkErrors) {
+	//	Retrieve IssuingWithdrawal structs
 	withdrawals := make(chan IssuingWithdrawal)
 	withdrawalsError := make(chan Error.StarkErrors)
-	query, errorChannel := utils.Query(resource, params, user)
+	query, errorChannel := c.Api.Stream(ctx, resource, params)
 	go func() {
+		defer close(withdrawalsError)
+		defer close(withdrawals)
 		for content := range query {
-			contentByte, _ := json.Marshal(content)
-			err := json.Unmarshal(contentByte, &issuingWithdrawal)
-			if err != nil {
-				withdrawalsError <- Error.UnknownError(err.Error())
+			var issuingWithdrawal IssuingWithdrawal
+			err := c.Api.Unmarshal(content, &issuingWithdrawal)
+			if err.Errors != nil {
+				if !utils.SendError(ctx, withdrawalsError, err) {
+					return
+				}
 				continue
 			}
-			withdrawals <- issuingWithdrawal
+			select {
+			case withdrawals <- issuingWithdrawal:
+			case <-ctx.Done():
+				return
+			}
 		}
 		for err := range errorChannel {
-			withdrawalsError <- err
+			if !utils.SendError(ctx, withdrawalsError, err) {
+				return
+			}
 		}
-		close(withdrawals)
-		close(withdrawalsError)
 	}()
 	return withdrawals, withdrawalsError
 }
 
+//	IssuingWithdrawal Iterator struct
+//
+//	Returned by Iterate to walk through IssuingWithdrawal structs one at a time,
+//	requesting a new page only once the current one is exhausted. Check Err
+//	once Next returns false and Close it when leaving the loop early.
+
+type Iterator struct {
+	api   *utils.Client
+	pages *utils.Iterator
+	value IssuingWithdrawal
+	err   Error.StarkErrors
+}
+
+func Iterate(params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve IssuingWithdrawal structs
+	//
+	//	Same as Query, but returns an Iterator instead of a pair of channels
+	//
+	//	Example:
+	//	iterator := Iterate(params, nil)
+	//	defer iterator.Close()
+	//	for iterator.Next() {
+	//		fmt.Println(iterator.Value())
+	//	}
+	//	if err := iterator.Err(); err.Errors != nil {
+	//		...
+	//	}
+	return Client{Api: utils.Default(user)}.Iterate(params)
+}
+
+func IterateCtx(ctx context.Context, params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve IssuingWithdrawal structs
+	//
+	//	Same as Iterate, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.IterateCtx(ctx, params)
+}
+
+func (c Client) Iterate(params map[string]interface{}) *Iterator {
+	//	Retrieve IssuingWithdrawal structs
+	return c.IterateCtx(context.Background(), params)
+}
+
+func (c Client) IterateCtx(ctx context.Context, params map[string]interface{}) *Iterator {
+	//	Retrieve IssuingWithdrawal structs
+	return &Iterator{api: c.Api, pages: c.Api.Iterate(ctx, resource, params)}
+}
+
+func (i *Iterator) Next() bool {
+	//	Move to the next IssuingWithdrawal, returning false when there are no more or a request failed
+	var value IssuingWithdrawal
+	if i.err.Errors != nil || !i.pages.Next() {
+		return false
+	}
+	i.err = i.api.Unmarshal(i.pages.Value(), &value)
+	if i.err.Errors != nil {
+		i.pages.Close()
+		return false
+	}
+	i.value = value
+	return true
+}
+
+func (i *Iterator) Value() IssuingWithdrawal {
+	//	Current IssuingWithdrawal, set by the last call to Next
+	return i.value
+}
+
+func (i *Iterator) Err() Error.StarkErrors {
+	//	Error that stopped the iteration, if any
+	if i.err.Errors != nil {
+		return i.err
+	}
+	return i.pages.Err()
+}
+
+func (i *Iterator) Close() {
+	//	Stop the iteration, releasing the current page
+	i.pages.Close()
+}
+
 func Page(params map[string]interface{}, user user.User) ([]IssuingWithdrawal, string, Error.StarkErrors) {
 	//	Retrieve paged IssuingWithdrawal structs
 	//
@@ -143,11 +293,28 @@ func Page(params map[string]interface{}, user user.User) ([]IssuingWithdrawal, s
 	//	Return:
 	//	- slice of IssuingWithdrawal structs with updated attributes
 	//	- cursor to retrieve the next page of IssuingWithdrawal structs
+	return Client{Api: utils.Default(user)}.Page(params)
+}
+
+func PageCtx(ctx context.Context, params map[string]interface{}, user user.User) ([]IssuingWithdrawal, string, Error.StarkErrors) {
+	//	Retrieve paged IssuingWithdrawal structs
+	//
+	//	Same as Page, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.PageCtx(ctx, params)
+}
+
+func (c Client) Page(params map[string]interface{}) ([]IssuingWithdrawal, string, Error.StarkErrors) {
+	//	Retrieve paged IssuingWithdrawal structs
+	return c.PageCtx(context.Background(), params)
+}
+
+func (c Client) PageCtx(ctx context.Context, params map[string]interface{}) ([]IssuingWithdrawal, string, Error.StarkErrors) {
+	//	Retrieve paged IssuingWithdrawal structs
 	var issuingWithdrawals []IssuingWithdrawal
-	page, cursor, err := utils.Page(resource, params, user)
-	unmarshalError := json.Unmarshal(page, &issuingWithdrawals)
-	if unmarshalError != nil {
+	page, cursor, err := c.Api.Page(ctx, resource, params)
+	if err.Errors != nil {
 		return issuingWithdrawals, cursor, err
 	}
+	err = c.Api.Unmarshal(page, &issuingWithdrawals)
 	return issuingWithdrawals, cursor, err
 }
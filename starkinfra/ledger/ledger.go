@@ -1,6 +1,7 @@
 package ledger
 
 import (
+	"context"
 	"encoding/json"
 	Error "github.com/starkinfra/core-go/starkcore/error"
 	"github.com/starkinfra/core-go/starkcore/user/user"
@@ -25,19 +26,30 @@ import (
 //	- Id [string]: Unique id returned when the Ledger is created. ex: "5656565656565656"
 //	- Created [time.Time]: Creation datetime for the Ledger. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC),
 //	- Updated [time.Time]: Latest update datetime for the Ledger. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC),
+//	- Extra [map[string]json.RawMessage]: Fields of the Ledger sent by the API but not declared in this SDK version yet, keyed by their JSON name. ex: map[string]json.RawMessage{"newField": json.RawMessage(`"value"`)}
 
 type Ledger struct {
-	ExternalId string                 `json:",omitempty"`
-	Rules      []Rule                 `json:",omitempty"`
-	Tags       []string               `json:",omitempty"`
-	Metadata   map[string]interface{} `json:",omitempty"`
-	Id         string                 `json:",omitempty"`
-	Created    *time.Time             `json:",omitempty"`
-	Updated    *time.Time             `json:",omitempty"`
+	ExternalId string                     `json:",omitempty"`
+	Rules      []Rule                     `json:",omitempty"`
+	Tags       []string                   `json:",omitempty"`
+	Metadata   map[string]interface{}     `json:",omitempty"`
+	Id         string                     `json:",omitempty"`
+	Created    *time.Time                 `json:",omitempty"`
+	Updated    *time.Time                 `json:",omitempty"`
+	Extra      map[string]json.RawMessage `json:"-"`
 }
 
 var resource = map[string]string{"name": "Ledger"}
 
+//	Ledger Client struct
+//
+//	Runs the Ledger functions with the settings of its own utils.Client
+//	instead of starkinfra.User and the other package defaults.
+
+type Client struct {
+	Api *utils.Client
+}
+
 func Create(ledgers []Ledger, user user.User) ([]Ledger, Error.StarkErrors) {
 	//	Create Ledgers
 	//
@@ -51,11 +63,28 @@ func Create(ledgers []Ledger, user user.User) ([]Ledger, Error.StarkErrors) {
 	//
 	//	Return:
 	//	- slice of Ledger structs with updated attributes
-	create, err := utils.Multi(resource, ledgers, nil, user)
-	unmarshalError := json.Unmarshal(create, &ledgers)
-	if unmarshalError != nil {
+	return Client{Api: utils.Default(user)}.Create(ledgers)
+}
+
+func CreateCtx(ctx context.Context, ledgers []Ledger, user user.User) ([]Ledger, Error.StarkErrors) {
+	//	Create Ledgers
+	//
+	//	Same as Create, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.CreateCtx(ctx, ledgers)
+}
+
+func (c Client) Create(ledgers []Ledger) ([]Ledger, Error.StarkErrors) {
+	//	Create Ledgers
+	return c.CreateCtx(context.Background(), ledgers)
+}
+
+func (c Client) CreateCtx(ctx context.Context, ledgers []Ledger) ([]Ledger, Error.StarkErrors) {
+	//	Create Ledgers
+	create, err := c.Api.Multi(ctx, resource, ledgers, nil)
+	if err.Errors != nil {
 		return ledgers, err
 	}
+	err = c.Api.Unmarshal(create, &ledgers)
 	return ledgers, err
 }
 
@@ -72,12 +101,29 @@ func Get(id string, user user.User) (Ledger, Error.StarkErrors) {
 	//
 	//	Return:
 	//	- Ledger struct that corresponds to the given id.
+	return Client{Api: utils.Default(user)}.Get(id)
+}
+
+func GetCtx(ctx context.Context, id string, user user.User) (Ledger, Error.StarkErrors) {
+	//	Retrieve a specific Ledger by its id
+	//
+	//	Same as Get, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.GetCtx(ctx, id)
+}
+
+func (c Client) Get(id string) (Ledger, Error.StarkErrors) {
+	//	Retrieve a specific Ledger by its id
+	return c.GetCtx(context.Background(), id)
+}
+
+func (c Client) GetCtx(ctx context.Context, id string) (Ledger, Error.StarkErrors) {
+	//	Retrieve a specific Ledger by its id
 	var ledger Ledger
-	get, err := utils.Get(resource, id, nil, user)
-	unmarshalError := json.Unmarshal(get, &ledger)
-	if unmarshalError != nil {
+	get, err := c.Api.Get(ctx, resource, id, nil)
+	if err.Errors != nil {
 		return ledger, err
 	}
+	err = c.Api.Unmarshal(get, &ledger)
 	return ledger, err
 }
 
@@ -98,29 +144,133 @@ func Query(params map[string]interface{}, user user.User) (chan Ledger, chan Err
 	//
 	//	Return:
 	//	- channel of Ledger structs with updated attributes
-	var ledger Ledger
+	return Client{Api: utils.Default(user)}.Query(params)
+}
+
+func QueryCtx(ctx context.Context, params map[string]interface{}, user user.User) (chan Ledger, chan Error.StarkErrors) {
+	//	Retrieve Ledger structs
+	//
+	//	Same as Query, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.QueryCtx(ctx, params)
+}
+
+func (c Client) Query(params map[string]interface{}) (chan Ledger, chan Error.StarkErrors) {
+	//	Retrieve Ledger structs
+	return c.QueryCtx(context.Background(), params)
+}
+
+func (c Client) QueryCtx(ctx context.Context, params map[string]interface{}) (chan Ledger, chan Error.StarkErrors) {
+	//	Retrieve Ledger structs
 	ledgers := make(chan Ledger)
 	ledgersError := make(chan Error.StarkErrors)
-	query, errorChannel := utils.Query(resource, params, user)
+	query, errorChannel := c.Api.Stream(ctx, resource, params)
 	go func() {
+		defer close(ledgersError)
+		defer close(ledgers)
 		for content := range query {
-			contentByte, _ := json.Marshal(content)
-			err := json.Unmarshal(contentByte, &ledger)
-			if err != nil {
-				ledgersError <- Error.UnknownError(err.Error())
+			var ledger Ledger
+			err := c.Api.Unmarshal(content, &ledger)
+			if err.Errors != nil {
+				if !utils.SendError(ctx, ledgersError, err) {
+					return
+				}
 				continue
 			}
-			ledgers <- ledger
+			select {
+			case ledgers <- ledger:
+			case <-ctx.Done():
+				return
+			}
 		}
 		for err := range errorChannel {
-			ledgersError <- err
+			if !utils.SendError(ctx, ledgersError, err) {
+				return
+			}
 		}
-		close(ledgers)
-		close(ledgersError)
 	}()
 	return ledgers, ledgersError
 }
 
+//	Ledger Iterator struct
+//
+//	Returned by Iterate to walk through Ledger structs one at a time,
+//	requesting a new page only once the current one is exhausted. Check Err
+//	once Next returns false and Close it when leaving the loop early.
+
+type Iterator struct {
+	api   *utils.Client
+	pages *utils.Iterator
+	value Ledger
+	err   Error.StarkErrors
+}
+
+func Iterate(params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve Ledger structs
+	//
+	//	Same as Query, but returns an Iterator instead of a pair of channels
+	//
+	//	Example:
+	//	iterator := Iterate(params, nil)
+	//	defer iterator.Close()
+	//	for iterator.Next() {
+	//		fmt.Println(iterator.Value())
+	//	}
+	//	if err := iterator.Err(); err.Errors != nil {
+	//		...
+	//	}
+	return Client{Api: utils.Default(user)}.Iterate(params)
+}
+
+func IterateCtx(ctx context.Context, params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve Ledger structs
+	//
+	//	Same as Iterate, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.IterateCtx(ctx, params)
+}
+
+func (c Client) Iterate(params map[string]interface{}) *Iterator {
+	//	Retrieve Ledger structs
+	return c.IterateCtx(context.Background(), params)
+}
+
+func (c Client) IterateCtx(ctx context.Context, params map[string]interface{}) *Iterator {
+	//	Retrieve Ledger structs
+	return &Iterator{api: c.Api, pages: c.Api.Iterate(ctx, resource, params)}
+}
+
+func (i *Iterator) Next() bool {
+	//	Move to the next Ledger, returning false when there are no more or a request failed
+	var value Ledger
+	if i.err.Errors != nil || !i.pages.Next() {
+		return false
+	}
+	i.err = i.api.Unmarshal(i.pages.Value(), &value)
+	if i.err.Errors != nil {
+		i.pages.Close()
+		return false
+	}
+	i.value = value
+	return true
+}
+
+func (i *Iterator) Value() Ledger {
+	//	Current Ledger, set by the last call to Next
+	return i.value
+}
+
+func (i *Iterator) Err() Error.StarkErrors {
+	//	Error that stopped the iteration, if any
+	if i.err.Errors != nil {
+		return i.err
+	}
+	return i.pages.Err()
+}
+
+func (i *Iterator) Close() {
+	//	Stop the iteration, releasing the current page
+	i.pages.Close()
+}
+
 func Page(params map[string]interface{}, user user.User) ([]Ledger, string, Error.StarkErrors) {
 	//	Retrieve paged Ledgers
 	//
@@ -141,12 +291,29 @@ func Page(params map[string]interface{}, user user.User) ([]Ledger, string, Erro
 	//	Return:
 	//	- slice of Ledger structs with updated attributes
 	//	- cursor to retrieve the next page of Ledger structs
+	return Client{Api: utils.Default(user)}.Page(params)
+}
+
+func PageCtx(ctx context.Context, params map[string]interface{}, user user.User) ([]Ledger, string, Error.StarkErrors) {
+	//	Retrieve paged Ledgers
+	//
+	//	Same as Page, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.PageCtx(ctx, params)
+}
+
+func (c Client) Page(params map[string]interface{}) ([]Ledger, string, Error.StarkErrors) {
+	//	Retrieve paged Ledgers
+	return c.PageCtx(context.Background(), params)
+}
+
+func (c Client) PageCtx(ctx context.Context, params map[string]interface{}) ([]Ledger, string, Error.StarkErrors) {
+	//	Retrieve paged Ledgers
 	var ledgers []Ledger
-	page, cursor, err := utils.Page(resource, params, user)
-	unmarshalError := json.Unmarshal(page, &ledgers)
-	if unmarshalError != nil {
+	page, cursor, err := c.Api.Page(ctx, resource, params)
+	if err.Errors != nil {
 		return ledgers, cursor, err
 	}
+	err = c.Api.Unmarshal(page, &ledgers)
 	return ledgers, cursor, err
 }
 
@@ -168,11 +335,28 @@ func Update(id string, patchData map[string]interface{}, user user.User) (Ledger
 	//
 	//	Return:
 	//	- target Ledger with updated attributes
+	return Client{Api: utils.Default(user)}.Update(id, patchData)
+}
+
+func UpdateCtx(ctx context.Context, id string, patchData map[string]interface{}, user user.User) (Ledger, Error.StarkErrors) {
+	//	Update Ledger entity
+	//
+	//	Same as Update, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.UpdateCtx(ctx, id, patchData)
+}
+
+func (c Client) Update(id string, patchData map[string]interface{}) (Ledger, Error.StarkErrors) {
+	//	Update Ledger entity
+	return c.UpdateCtx(context.Background(), id, patchData)
+}
+
+func (c Client) UpdateCtx(ctx context.Context, id string, patchData map[string]interface{}) (Ledger, Error.StarkErrors) {
+	//	Update Ledger entity
 	var ledger Ledger
-	update, err := utils.Patch(resource, id, patchData, user)
-	unmarshalError := json.Unmarshal(update, &ledger)
-	if unmarshalError != nil {
+	update, err := c.Api.Patch(ctx, resource, id, patchData)
+	if err.Errors != nil {
 		return ledger, err
 	}
+	err = c.Api.Unmarshal(update, &ledger)
 	return ledger, err
 }
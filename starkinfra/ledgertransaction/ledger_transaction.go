@@ -1,6 +1,7 @@
 package ledgertransaction
 
 import (
+	"context"
 	"encoding/json"
 	Error "github.com/starkinfra/core-go/starkcore/error"
 	"github.com/starkinfra/core-go/starkcore/user/user"
@@ -30,23 +31,34 @@ import (
 //	- Id [string]: Unique id returned when the LedgerTransaction is created. ex: "5656565656565656"
 //	- Balance [int]: Ledger's balance after the transaction. ex: 11234
 //	- Created [time.Time]: Creation datetime for the LedgerTransaction. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC),
+//	- Extra [map[string]json.RawMessage]: Fields of the LedgerTransaction sent by the API but not declared in this SDK version yet, keyed by their JSON name. ex: map[string]json.RawMessage{"newField": json.RawMessage(`"value"`)}
 
 type LedgerTransaction struct {
-	Amount     int                    `json:",omitempty"`
-	LedgerId   string                 `json:",omitempty"`
-	ExternalId string                 `json:",omitempty"`
-	Source     string                 `json:",omitempty"`
-	Fee        int                    `json:",omitempty"`
-	Rules      []Ledger.Rule          `json:",omitempty"`
-	Metadata   map[string]interface{} `json:",omitempty"`
-	Tags       []string               `json:",omitempty"`
-	Id         string                 `json:",omitempty"`
-	Balance    int                    `json:",omitempty"`
-	Created    *time.Time             `json:",omitempty"`
+	Amount     int                        `json:",omitempty"`
+	LedgerId   string                     `json:",omitempty"`
+	ExternalId string                     `json:",omitempty"`
+	Source     string                     `json:",omitempty"`
+	Fee        int                        `json:",omitempty"`
+	Rules      []Ledger.Rule              `json:",omitempty"`
+	Metadata   map[string]interface{}     `json:",omitempty"`
+	Tags       []string                   `json:",omitempty"`
+	Id         string                     `json:",omitempty"`
+	Balance    int                        `json:",omitempty"`
+	Created    *time.Time                 `json:",omitempty"`
+	Extra      map[string]json.RawMessage `json:"-"`
 }
 
 var resource = map[string]string{"name": "LedgerTransaction"}
 
+//	LedgerTransaction Client struct
+//
+//	Runs the LedgerTransaction functions with the settings of its own utils.Client
+//	instead of starkinfra.User and the other package defaults.
+
+type Client struct {
+	Api *utils.Client
+}
+
 func Create(transactions []LedgerTransaction, user user.User) ([]LedgerTransaction, Error.StarkErrors) {
 	//	Create LedgerTransactions
 	//
@@ -60,11 +72,28 @@ func Create(transactions []LedgerTransaction, user user.User) ([]LedgerTransacti
 	//
 	//	Return:
 	//	- slice of LedgerTransaction structs with updated attributes
-	create, err := utils.Multi(resource, transactions, nil, user)
-	unmarshalError := json.Unmarshal(create, &transactions)
-	if unmarshalError != nil {
+	return Client{Api: utils.Default(user)}.Create(transactions)
+}
+
+func CreateCtx(ctx context.Context, transactions []LedgerTransaction, user user.User) ([]LedgerTransaction, Error.StarkErrors) {
+	//	Create LedgerTransactions
+	//
+	//	Same as Create, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.CreateCtx(ctx, transactions)
+}
+
+func (c Client) Create(transactions []LedgerTransaction) ([]LedgerTransaction, Error.StarkErrors) {
+	//	Create LedgerTransactions
+	return c.CreateCtx(context.Background(), transactions)
+}
+
+func (c Client) CreateCtx(ctx context.Context, transactions []LedgerTransaction) ([]LedgerTransaction, Error.StarkErrors) {
+	//	Create LedgerTransactions
+	create, err := c.Api.Multi(ctx, resource, transactions, nil)
+	if err.Errors != nil {
 		return transactions, err
 	}
+	err = c.Api.Unmarshal(create, &transactions)
 	return transactions, err
 }
 
@@ -81,12 +110,29 @@ func Get(id string, user user.User) (LedgerTransaction, Error.StarkErrors) {
 	//
 	//	Return:
 	//	- LedgerTransaction struct that corresponds to the given id.
+	return Client{Api: utils.Default(user)}.Get(id)
+}
+
+func GetCtx(ctx context.Context, id string, user user.User) (LedgerTransaction, Error.StarkErrors) {
+	//	Retrieve a specific LedgerTransaction by its id
+	//
+	//	Same as Get, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.GetCtx(ctx, id)
+}
+
+func (c Client) Get(id string) (LedgerTransaction, Error.StarkErrors) {
+	//	Retrieve a specific LedgerTransaction by its id
+	return c.GetCtx(context.Background(), id)
+}
+
+func (c Client) GetCtx(ctx context.Context, id string) (LedgerTransaction, Error.StarkErrors) {
+	//	Retrieve a specific LedgerTransaction by its id
 	var transaction LedgerTransaction
-	get, err := utils.Get(resource, id, nil, user)
-	unmarshalError := json.Unmarshal(get, &transaction)
-	if unmarshalError != nil {
+	get, err := c.Api.Get(ctx, resource, id, nil)
+	if err.Errors != nil {
 		return transaction, err
 	}
+	err = c.Api.Unmarshal(get, &transaction)
 	return transaction, err
 }
 
@@ -110,29 +156,133 @@ func Query(params map[string]interface{}, user user.User) (chan LedgerTransactio
 	//
 	//	Return:
 	//	- channel of LedgerTransaction structs with updated attributes
-	var transaction LedgerTransaction
+	return Client{Api: utils.Default(user)}.Query(params)
+}
+
+func QueryCtx(ctx context.Context, params map[string]interface{}, user user.User) (chan LedgerTransaction, chan Error.StarkErrors) {
+	//	Retrieve LedgerTransaction structs
+	//
+	//	Same as Query, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.QueryCtx(ctx, params)
+}
+
+func (c Client) Query(params map[string]interface{}) (chan LedgerTransaction, chan Error.StarkErrors) {
+	//	Retrieve LedgerTransaction structs
+	return c.QueryCtx(context.Background(), params)
+}
+
+func (c Client) QueryCtx(ctx context.Context, params map[string]interface{}) (chan LedgerTransaction, chan Error.StarkErrors) {
+	//	Retrieve LedgerTransaction structs
 	transactions := make(chan LedgerTransaction)
 	transactionsError := make(chan Error.StarkErrors)
-	query, errorChannel := utils.Query(resource, params, user)
+	query, errorChannel := c.Api.Stream(ctx, resource, params)
 	go func() {
+		defer close(transactionsError)
+		defer close(transactions)
 		for content := range query {
-			contentByte, _ := json.Marshal(content)			
-			err := json.Unmarshal(contentByte, &transaction)
-			if err != nil {
-				transactionsError <- Error.UnknownError(err.Error())
+			var transaction LedgerTransaction
+			err := c.Api.Unmarshal(content, &transaction)
+			if err.Errors != nil {
+				if !utils.SendError(ctx, transactionsError, err) {
+					return
+				}
 				continue
 			}
-			transactions <- transaction
+			select {
+			case transactions <- transaction:
+			case <-ctx.Done():
+				return
+			}
 		}
 		for err := range errorChannel {
-			transactionsError <- err
+			if !utils.SendError(ctx, transactionsError, err) {
+				return
+			}
 		}
-		close(transactions)
-		close(transactionsError)
 	}()
 	return transactions, transactionsError
 }
 
+//	LedgerTransaction Iterator struct
+//
+//	Returned by Iterate to walk through LedgerTransaction structs one at a time,
+//	requesting a new page only once the current one is exhausted. Check Err
+//	once Next returns false and Close it when leaving the loop early.
+
+type Iterator struct {
+	api   *utils.Client
+	pages *utils.Iterator
+	value LedgerTransaction
+	err   Error.StarkErrors
+}
+
+func Iterate(params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve LedgerTransaction structs
+	//
+	//	Same as Query, but returns an Iterator instead of a pair of channels
+	//
+	//	Example:
+	//	iterator := Iterate(params, nil)
+	//	defer iterator.Close()
+	//	for iterator.Next() {
+	//		fmt.Println(iterator.Value())
+	//	}
+	//	if err := iterator.Err(); err.Errors != nil {
+	//		...
+	//	}
+	return Client{Api: utils.Default(user)}.Iterate(params)
+}
+
+func IterateCtx(ctx context.Context, params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve LedgerTransaction structs
+	//
+	//	Same as Iterate, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.IterateCtx(ctx, params)
+}
+
+func (c Client) Iterate(params map[string]interface{}) *Iterator {
+	//	Retrieve LedgerTransaction structs
+	return c.IterateCtx(context.Background(), params)
+}
+
+func (c Client) IterateCtx(ctx context.Context, params map[string]interface{}) *Iterator {
+	//	Retrieve LedgerTransaction structs
+	return &Iterator{api: c.Api, pages: c.Api.Iterate(ctx, resource, params)}
+}
+
+func (i *Iterator) Next() bool {
+	//	Move to the next LedgerTransaction, returning false when there are no more or a request failed
+	var value LedgerTransaction
+	if i.err.Errors != nil || !i.pages.Next() {
+		return false
+	}
+	i.err = i.api.Unmarshal(i.pages.Value(), &value)
+	if i.err.Errors != nil {
+		i.pages.Close()
+		return false
+	}
+	i.value = value
+	return true
+}
+
+func (i *Iterator) Value() LedgerTransaction {
+	//	Current LedgerTransaction, set by the last call to Next
+	return i.value
+}
+
+func (i *Iterator) Err() Error.StarkErrors {
+	//	Error that stopped the iteration, if any
+	if i.err.Errors != nil {
+		return i.err
+	}
+	return i.pages.Err()
+}
+
+func (i *Iterator) Close() {
+	//	Stop the iteration, releasing the current page
+	i.pages.Close()
+}
+
 func Page(params map[string]interface{}, user user.User) ([]LedgerTransaction, string, Error.StarkErrors) {
 	//	Retrieve paged LedgerTransactions
 	//
@@ -156,11 +306,28 @@ func Page(params map[string]interface{}, user user.User) ([]LedgerTransaction, s
 	//	Return:
 	//	- slice of LedgerTransaction structs with updated attributes
 	//	- cursor to retrieve the next page of LedgerTransaction structs
+	return Client{Api: utils.Default(user)}.Page(params)
+}
+
+func PageCtx(ctx context.Context, params map[string]interface{}, user user.User) ([]LedgerTransaction, string, Error.StarkErrors) {
+	//	Retrieve paged LedgerTransactions
+	//
+	//	Same as Page, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.PageCtx(ctx, params)
+}
+
+func (c Client) Page(params map[string]interface{}) ([]LedgerTransaction, string, Error.StarkErrors) {
+	//	Retrieve paged LedgerTransactions
+	return c.PageCtx(context.Background(), params)
+}
+
+func (c Client) PageCtx(ctx context.Context, params map[string]interface{}) ([]LedgerTransaction, string, Error.StarkErrors) {
+	//	Retrieve paged LedgerTransactions
 	var transactions []LedgerTransaction
-	page, cursor, err := utils.Page(resource, params, user)
-	unmarshalError := json.Unmarshal(page, &transactions)
-	if unmarshalError != nil {
+	page, cursor, err := c.Api.Page(ctx, resource, params)
+	if err.Errors != nil {
 		return transactions, cursor, err
 	}
+	err = c.Api.Unmarshal(page, &transactions)
 	return transactions, cursor, err
 }
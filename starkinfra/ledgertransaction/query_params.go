@@ -0,0 +1,38 @@
+package ledgertransaction
+
+import (
+	"github.com/starkinfra/sdk-go/starkinfra/utils"
+	"time"
+)
+
+//	LedgerTransaction QueryParams struct
+//
+//	Typed filters for Query, Iterate and Page. Fields left empty are not sent.
+//
+//	Attributes:
+//	- Limit [int, default nil]: Maximum number of structs to be retrieved. Unlimited if nil, except on Page, which returns up to 100. ex: 35
+//	- Cursor [string, default nil]: Cursor returned on the previous Page call. Only used by Page
+//	- LedgerId [string, default nil]: Id of the Ledger containing the transaction. Either ledgerId or ids must be provided. If both are sent, the query will be filtered by both. ex: "5656565656565656"
+//	- Ids [slice of strings, default nil]: Slice of ids to filter retrieved structs. Either ledgerId or ids must be provided. If both are sent, the query will be filtered by both. ex: []string{"5656565656565656", "4545454545454545"}
+//	- Flow [string, default nil]: Direction of the transaction. ex: "in" or "out"
+//	- Tags [slice of strings, default nil]: Tags to filter retrieved structs. ex: []string{"transfer/123", "savings"}
+//	- ExternalIds [slice of strings, default nil]: Slice of external ids to filter retrieved structs. ex: []string{"my-internal-id-123456", "my-internal-id-654321"}
+//	- After [time.Time, default nil]: Date filter for structs created only after specified date. ex: time.Date(2022, 11, 10, 0, 0, 0, 0, time.UTC)
+//	- Before [time.Time, default nil]: Date filter for structs created only before specified date. ex: time.Date(2022, 11, 10, 0, 0, 0, 0, time.UTC)
+
+type QueryParams struct {
+	Limit       int       `json:"limit,omitempty"`
+	Cursor      string    `json:"cursor,omitempty"`
+	LedgerId    string    `json:"ledgerId,omitempty"`
+	Ids         []string  `json:"ids,omitempty"`
+	Flow        string    `json:"flow,omitempty"`
+	Tags        []string  `json:"tags,omitempty"`
+	ExternalIds []string  `json:"externalIds,omitempty"`
+	After       time.Time `json:"after,omitempty"`
+	Before      time.Time `json:"before,omitempty"`
+}
+
+func (p QueryParams) Map() map[string]interface{} {
+	//	Convert the QueryParams into the params map taken by Query, Iterate and Page
+	return utils.ParamsMap(p)
+}
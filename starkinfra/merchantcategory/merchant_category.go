@@ -1,6 +1,7 @@
 package merchantcategory
 
 import (
+	"context"
 	"encoding/json"
 	Error "github.com/starkinfra/core-go/starkcore/error"
 	"github.com/starkinfra/core-go/starkcore/user/user"
@@ -22,17 +23,28 @@ import (
 //	- Name [string]: Category's name. ex: "Veterinary services", "Fast food restaurants"
 //  - Number [string]: Category's number. ex: "742", "5814"
 //  - Group [string]: Category's group. ex: "pets", "food"
+//	- Extra [map[string]json.RawMessage]: Fields of the MerchantCategory sent by the API but not declared in this SDK version yet, keyed by their JSON name. ex: map[string]json.RawMessage{"newField": json.RawMessage(`"value"`)}
 
 type MerchantCategory struct {
-	Code   string `json:",omitempty"`
-	Type   string `json:",omitempty"`
-	Name   string `json:",omitempty"`
-	Number string `json:",omitempty"`
-	Group  string `json:",omitempty"`
+	Code   string                     `json:",omitempty"`
+	Type   string                     `json:",omitempty"`
+	Name   string                     `json:",omitempty"`
+	Number string                     `json:",omitempty"`
+	Group  string                     `json:",omitempty"`
+	Extra  map[string]json.RawMessage `json:"-"`
 }
 
 var resource = map[string]string{"name": "MerchantCategory"}
 
+//	MerchantCategory Client struct
+//
+//	Runs the MerchantCategory functions with the settings of its own utils.Client
+//	instead of starkinfra.User and the other package defaults.
+
+type Client struct {
+	Api *utils.Client
+}
+
 func Query(params map[string]interface{}, user user.User) (chan MerchantCategory, chan Error.StarkErrors) {
 	//	Retrieve MerchantCategory structs
 	//
@@ -45,25 +57,129 @@ func Query(params map[string]interface{}, user user.User) (chan MerchantCategory
 	//
 	//	Return:
 	//	- channel of MerchantCategory structs with updated attributes
-	var merchantCategory MerchantCategory
+	return Client{Api: utils.Default(user)}.Query(params)
+}
+
+func QueryCtx(ctx context.Context, params map[string]interface{}, user user.User) (chan MerchantCategory, chan Error.StarkErrors) {
+	//	Retrieve MerchantCategory structs
+	//
+	//	Same as Query, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.QueryCtx(ctx, params)
+}
+
+func (c Client) Query(params map[string]interface{}) (chan MerchantCategory, chan Error.StarkErrors) {
+	//	Retrieve MerchantCategory structs
+	return c.QueryCtx(context.Background(), params)
+}
+
+func (c Client) QueryCtx(ctx context.Context, params map[string]interface{}) (chan MerchantCategory, chan Error.StarkErrors) {
+	//	Retrieve MerchantCategory structs
 	categories := make(chan MerchantCategory)
 	categoriesError := make(chan Error.StarkErrors)
-	query, errorChannel := utils.Query(resource, params, user)
+	query, errorChannel := c.Api.Stream(ctx, resource, params)
 	go func() {
+		defer close(categoriesError)
+		defer close(categories)
 		for content := range query {
-			contentByte, _ := json.Marshal(content)
-			err := json.Unmarshal(contentByte, &merchantCategory)
-			if err != nil {
-				categoriesError <- Error.UnknownError(err.Error())
+			var merchantCategory MerchantCategory
+			err := c.Api.Unmarshal(content, &merchantCategory)
+			if err.Errors != nil {
+				if !utils.SendError(ctx, categoriesError, err) {
+					return
+				}
 				continue
 			}
-			categories <- merchantCategory
+			select {
+			case categories <- merchantCategory:
+			case <-ctx.Done():
+				return
+			}
 		}
 		for err := range errorChannel {
-			categoriesError <- err
+			if !utils.SendError(ctx, categoriesError, err) {
+				return
+			}
 		}
-		close(categories)
-		close(categoriesError)
 	}()
 	return categories, categoriesError
 }
+
+//	MerchantCategory Iterator struct
+//
+//	Returned by Iterate to walk through MerchantCategory structs one at a time,
+//	requesting a new page only once the current one is exhausted. Check Err
+//	once Next returns false and Close it when leaving the loop early.
+
+type Iterator struct {
+	api   *utils.Client
+	pages *utils.Iterator
+	value MerchantCategory
+	err   Error.StarkErrors
+}
+
+func Iterate(params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve MerchantCategory structs
+	//
+	//	Same as Query, but returns an Iterator instead of a pair of channels
+	//
+	//	Example:
+	//	iterator := Iterate(params, nil)
+	//	defer iterator.Close()
+	//	for iterator.Next() {
+	//		fmt.Println(iterator.Value())
+	//	}
+	//	if err := iterator.Err(); err.Errors != nil {
+	//		...
+	//	}
+	return Client{Api: utils.Default(user)}.Iterate(params)
+}
+
+func IterateCtx(ctx context.Context, params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve MerchantCategory structs
+	//
+	//	Same as Iterate, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.IterateCtx(ctx, params)
+}
+
+func (c Client) Iterate(params map[string]interface{}) *Iterator {
+	//	Retrieve MerchantCategory structs
+	return c.IterateCtx(context.Background(), params)
+}
+
+func (c Client) IterateCtx(ctx context.Context, params map[string]interface{}) *Iterator {
+	//	Retrieve MerchantCategory structs
+	return &Iterator{api: c.Api, pages: c.Api.Iterate(ctx, resource, params)}
+}
+
+func (i *Iterator) Next() bool {
+	//	Move to the next MerchantCategory, returning false when there are no more or a request failed
+	var value MerchantCategory
+	if i.err.Errors != nil || !i.pages.Next() {
+		return false
+	}
+	i.err = i.api.Unmarshal(i.pages.Value(), &value)
+	if i.err.Errors != nil {
+		i.pages.Close()
+		return false
+	}
+	i.value = value
+	return true
+}
+
+func (i *Iterator) Value() MerchantCategory {
+	//	Current MerchantCategory, set by the last call to Next
+	return i.value
+}
+
+func (i *Iterator) Err() Error.StarkErrors {
+	//	Error that stopped the iteration, if any
+	if i.err.Errors != nil {
+		return i.err
+	}
+	return i.pages.Err()
+}
+
+func (i *Iterator) Close() {
+	//	Stop the iteration, releasing the current page
+	i.pages.Close()
+}
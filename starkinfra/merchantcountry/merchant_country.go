@@ -1,6 +1,7 @@
 package merchantcountry
 
 import (
+	"context"
 	"encoding/json"
 	Error "github.com/starkinfra/core-go/starkcore/error"
 	"github.com/starkinfra/core-go/starkcore/user/user"
@@ -18,16 +19,27 @@ import (
 //	- Name [string]: Country's name. ex: "Brazil"
 //  - Number [string]: Country's number. ex: "076"
 //  - ShortCode [string]: Country's short code. ex: "BR"
+//	- Extra [map[string]json.RawMessage]: Fields of the MerchantCountry sent by the API but not declared in this SDK version yet, keyed by their JSON name. ex: map[string]json.RawMessage{"newField": json.RawMessage(`"value"`)}
 
 type MerchantCountry struct {
-	Code      string `json:",omitempty"`
-	Name      string `json:",omitempty"`
-	Number    string `json:",omitempty"`
-	ShortCode string `json:",omitempty"`
+	Code      string                     `json:",omitempty"`
+	Name      string                     `json:",omitempty"`
+	Number    string                     `json:",omitempty"`
+	ShortCode string                     `json:",omitempty"`
+	Extra     map[string]json.RawMessage `json:"-"`
 }
 
 var resource = map[string]string{"name": "MerchantCountry"}
 
+//	MerchantCountry Client struct
+//
+//	Runs the MerchantCountry functions with the settings of its own utils.Client
+//	instead of starkinfra.User and the other package defaults.
+
+type Client struct {
+	Api *utils.Client
+}
+
 func Query(params map[string]interface{}, user user.User) (chan MerchantCountry, chan Error.StarkErrors) {
 	//	Retrieve MerchantCountry structs
 	//
@@ -40,25 +52,129 @@ func Query(params map[string]interface{}, user user.User) (chan MerchantCountry,
 	//
 	//	Return:
 	//	- channel of MerchantCountry structs with updated attributes
-	var merchantCountry MerchantCountry
+	return Client{Api: utils.Default(user)}.Query(params)
+}
+
+func QueryCtx(ctx context.Context, params map[string]interface{}, user user.User) (chan MerchantCountry, chan Error.StarkErrors) {
+	//	Retrieve MerchantCountry structs
+	//
+	//	Same as Query, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.QueryCtx(ctx, params)
+}
+
+func (c Client) Query(params map[string]interface{}) (chan MerchantCountry, chan Error.StarkErrors) {
+	//	Retrieve MerchantCountry structs
+	return c.QueryCtx(context.Background(), params)
+}
+
+func (c Client) QueryCtx(ctx context.Context, params map[string]interface{}) (chan MerchantCountry, chan Error.StarkErrors) {
+	//	Retrieve MerchantCountry structs
 	countries := make(chan MerchantCountry)
 	countriesError := make(chan Error.StarkErrors)
-	query, errorChannel := utils.Query(resource, params, user)
+	query, errorChannel := c.Api.Stream(ctx, resource, params)
 	go func() {
+		defer close(countriesError)
+		defer close(countries)
 		for content := range query {
-			contentByte, _ := json.Marshal(content)
-			err := json.Unmarshal(contentByte, &merchantCountry)
-			if err != nil {
-				countriesError <- Error.UnknownError(err.Error())
+			var merchantCountry MerchantCountry
+			err := c.Api.Unmarshal(content, &merchantCountry)
+			if err.Errors != nil {
+				if !utils.SendError(ctx, countriesError, err) {
+					return
+				}
 				continue
 			}
-			countries <- merchantCountry
+			select {
+			case countries <- merchantCountry:
+			case <-ctx.Done():
+				return
+			}
 		}
 		for err := range errorChannel {
-			countriesError <- err
+			if !utils.SendError(ctx, countriesError, err) {
+				return
+			}
 		}
-		close(countries)
-		close(countriesError)
 	}()
 	return countries, countriesError
 }
+
+//	MerchantCountry Iterator struct
+//
+//	Returned by Iterate to walk through MerchantCountry structs one at a time,
+//	requesting a new page only once the current one is exhausted. Check Err
+//	once Next returns false and Close it when leaving the loop early.
+
+type Iterator struct {
+	api   *utils.Client
+	pages *utils.Iterator
+	value MerchantCountry
+	err   Error.StarkErrors
+}
+
+func Iterate(params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve MerchantCountry structs
+	//
+	//	Same as Query, but returns an Iterator instead of a pair of channels
+	//
+	//	Example:
+	//	iterator := Iterate(params, nil)
+	//	defer iterator.Close()
+	//	for iterator.Next() {
+	//		fmt.Println(iterator.Value())
+	//	}
+	//	if err := iterator.Err(); err.Errors != nil {
+	//		...
+	//	}
+	return Client{Api: utils.Default(user)}.Iterate(params)
+}
+
+func IterateCtx(ctx context.Context, params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve MerchantCountry structs
+	//
+	//	Same as Iterate, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.IterateCtx(ctx, params)
+}
+
+func (c Client) Iterate(params map[string]interface{}) *Iterator {
+	//	Retrieve MerchantCountry structs
+	return c.IterateCtx(context.Background(), params)
+}
+
+func (c Client) IterateCtx(ctx context.Context, params map[string]interface{}) *Iterator {
+	//	Retrieve MerchantCountry structs
+	return &Iterator{api: c.Api, pages: c.Api.Iterate(ctx, resource, params)}
+}
+
+func (i *Iterator) Next() bool {
+	//	Move to the next MerchantCountry, returning false when there are no more or a request failed
+	var value MerchantCountry
+	if i.err.Errors != nil || !i.pages.Next() {
+		return false
+	}
+	i.err = i.api.Unmarshal(i.pages.Value(), &value)
+	if i.err.Errors != nil {
+		i.pages.Close()
+		return false
+	}
+	i.value = value
+	return true
+}
+
+func (i *Iterator) Value() MerchantCountry {
+	//	Current MerchantCountry, set by the last call to Next
+	return i.value
+}
+
+func (i *Iterator) Err() Error.StarkErrors {
+	//	Error that stopped the iteration, if any
+	if i.err.Errors != nil {
+		return i.err
+	}
+	return i.pages.Err()
+}
+
+func (i *Iterator) Close() {
+	//	Stop the iteration, releasing the current page
+	i.pages.Close()
+}
@@ -1,6 +1,7 @@
 package pixbalance
 
 import (
+	"context"
 	"encoding/json"
 	Error "github.com/starkinfra/core-go/starkcore/error"
 	"github.com/starkinfra/core-go/starkcore/user/user"
@@ -20,16 +21,27 @@ import (
 //	- Amount [int]: Current balance amount of the Workspace in cents. ex: 200 (= R$ 2.00)
 //	- Currency [string]: Currency of the current Workspace. Expect others to be added eventually. ex: "BRL"
 //	- Updated [time.Time]: Latest update datetime for the balance. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC),
+//	- Extra [map[string]json.RawMessage]: Fields of the PixBalance sent by the API but not declared in this SDK version yet, keyed by their JSON name. ex: map[string]json.RawMessage{"newField": json.RawMessage(`"value"`)}
 
 type PixBalance struct {
-	Id       string     `json:",omitempty"`
-	Amount   int        `json:",omitempty"`
-	Currency string     `json:",omitempty"`
-	Updated  *time.Time `json:",omitempty"`
+	Id       string                     `json:",omitempty"`
+	Amount   int                        `json:",omitempty"`
+	Currency string                     `json:",omitempty"`
+	Updated  *time.Time                 `json:",omitempty"`
+	Extra    map[string]json.RawMessage `json:"-"`
 }
 
 var resource = map[string]string{"name": "PixBalance"}
 
+//	PixBalance Client struct
+//
+//	Runs the PixBalance functions with the settings of its own utils.Client
+//	instead of starkinfra.User and the other package defaults.
+
+type Client struct {
+	Api *utils.Client
+}
+
 func Get(params map[string]interface{}, user user.User) (PixBalance, Error.StarkErrors) {
 	//	Retrieve the PixBalance struct
 	//
@@ -42,25 +54,29 @@ func Get(params map[string]interface{}, user user.User) (PixBalance, Error.Stark
 	//
 	//	Return:
 	//	- pixBalance struct with updated attributes
+	return Client{Api: utils.Default(user)}.Get(params)
+}
+
+func GetCtx(ctx context.Context, params map[string]interface{}, user user.User) (PixBalance, Error.StarkErrors) {
+	//	Retrieve the PixBalance struct
+	//
+	//	Same as Get, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.GetCtx(ctx, params)
+}
+
+func (c Client) Get(params map[string]interface{}) (PixBalance, Error.StarkErrors) {
+	//	Retrieve the PixBalance struct
+	return c.GetCtx(context.Background(), params)
+}
+
+func (c Client) GetCtx(ctx context.Context, params map[string]interface{}) (PixBalance, Error.StarkErrors) {
+	//	Retrieve the PixBalance struct
 	var pixBalance PixBalance
-	balance := make(chan PixBalance)
-	balanceError := make(chan Error.StarkErrors)
-	query, errorChannel := utils.Query(resource, params, user)
-	go func() {
-		for content := range query {
-			contentByte, _ := json.Marshal(content)
-			err := json.Unmarshal(contentByte, &pixBalance)
-			if err != nil {
-				balanceError <- Error.UnknownError(err.Error())
-				continue
-			}
-			balance <- pixBalance
-		}
-		for err := range errorChannel {
-			balanceError <- err
-		}
-		close(balance)
-		close(balanceError)
-	}()
-	return <-balance, <-balanceError
+	balances := c.Api.Iterate(ctx, resource, params)
+	defer balances.Close()
+	if !balances.Next() {
+		return pixBalance, balances.Err()
+	}
+	err := c.Api.Unmarshal(balances.Value(), &pixBalance)
+	return pixBalance, err
 }
@@ -1,6 +1,7 @@
 package pixchargeback
 
 import (
+	"context"
 	"encoding/json"
 	Error "github.com/starkinfra/core-go/starkcore/error"
 	"github.com/starkinfra/core-go/starkcore/user/user"
@@ -45,35 +46,46 @@ import (
 //	- ReversalTaxId [string]: Tax ID for the reversal transaction. ex: "01234567890"
 //	- Created [time.Time]: Creation datetime for the PixChargeback. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC),
 //	- Updated [time.Time]: Latest update datetime for the PixChargeback. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC),
+//	- Extra [map[string]json.RawMessage]: Fields of the PixChargeback sent by the API but not declared in this SDK version yet, keyed by their JSON name. ex: map[string]json.RawMessage{"newField": json.RawMessage(`"value"`)}
 
 type PixChargeback struct {
-	Id                    string     `json:",omitempty"`
-	Amount                int        `json:",omitempty"`
-	ReferenceId           string     `json:",omitempty"`
-	Reason                string     `json:",omitempty"`
-	Description           string     `json:",omitempty"`
-	Tags                  []string   `json:",omitempty"`
-	Analysis              string     `json:",omitempty"`
-	SenderBankCode        string     `json:",omitempty"`
-	ReceiverBankCode      string     `json:",omitempty"`
-	RejectionReason       string     `json:",omitempty"`
-	ReversalReferenceId   string     `json:",omitempty"`
-	Result                string     `json:",omitempty"`
-	Flow                  string     `json:",omitempty"`
-	Status                string     `json:",omitempty"`
-	DisputeId             string     `json:",omitempty"`
-	IsMonitoringRequired  bool       `json:",omitempty"`
-	ReversalAccountNumber string     `json:",omitempty"`
-	ReversalAccountType   string     `json:",omitempty"`
-	ReversalBankCode      string     `json:",omitempty"`
-	ReversalBranchCode    string     `json:",omitempty"`
-	ReversalTaxId         string     `json:",omitempty"`
-	Created               *time.Time `json:",omitempty"`
-	Updated               *time.Time `json:",omitempty"`
+	Id                    string                     `json:",omitempty"`
+	Amount                int                        `json:",omitempty"`
+	ReferenceId           string                     `json:",omitempty"`
+	Reason                string                     `json:",omitempty"`
+	Description           string                     `json:",omitempty"`
+	Tags                  []string                   `json:",omitempty"`
+	Analysis              string                     `json:",omitempty"`
+	SenderBankCode        string                     `json:",omitempty"`
+	ReceiverBankCode      string                     `json:",omitempty"`
+	RejectionReason       string                     `json:",omitempty"`
+	ReversalReferenceId   string                     `json:",omitempty"`
+	Result                string                     `json:",omitempty"`
+	Flow                  string                     `json:",omitempty"`
+	Status                string                     `json:",omitempty"`
+	DisputeId             string                     `json:",omitempty"`
+	IsMonitoringRequired  bool                       `json:",omitempty"`
+	ReversalAccountNumber string                     `json:",omitempty"`
+	ReversalAccountType   string                     `json:",omitempty"`
+	ReversalBankCode      string                     `json:",omitempty"`
+	ReversalBranchCode    string                     `json:",omitempty"`
+	ReversalTaxId         string                     `json:",omitempty"`
+	Created               *time.Time                 `json:",omitempty"`
+	Updated               *time.Time                 `json:",omitempty"`
+	Extra                 map[string]json.RawMessage `json:"-"`
 }
 
 var resource = map[string]string{"name": "PixChargeback"}
 
+//	PixChargeback Client struct
+//
+//	Runs the PixChargeback functions with the settings of its own utils.Client
+//	instead of starkinfra.User and the other package defaults.
+
+type Client struct {
+	Api *utils.Client
+}
+
 func Create(chargebacks []PixChargeback, user user.User) ([]PixChargeback, Error.StarkErrors) {
 	//	Create PixChargeback structs
 	//
@@ -87,11 +99,28 @@ func Create(chargebacks []PixChargeback, user user.User) ([]PixChargeback, Error
 	//
 	//	Return:
 	//	- slice of PixChargeback structs with updated attributes
-	create, err := utils.Multi(resource, chargebacks, nil, user)
-	unmarshalError := json.Unmarshal(create, &chargebacks)
-	if unmarshalError != nil {
+	return Client{Api: utils.Default(user)}.Create(chargebacks)
+}
+
+func CreateCtx(ctx context.Context, chargebacks []PixChargeback, user user.User) ([]PixChargeback, Error.StarkErrors) {
+	//	Create PixChargeback structs
+	//
+	//	Same as Create, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.CreateCtx(ctx, chargebacks)
+}
+
+func (c Client) Create(chargebacks []PixChargeback) ([]PixChargeback, Error.StarkErrors) {
+	//	Create PixChargeback structs
+	return c.CreateCtx(context.Background(), chargebacks)
+}
+
+func (c Client) CreateCtx(ctx context.Context, chargebacks []PixChargeback) ([]PixChargeback, Error.StarkErrors) {
+	//	Create PixChargeback structs
+	create, err := c.Api.Multi(ctx, resource, chargebacks, nil)
+	if err.Errors != nil {
 		return chargebacks, err
 	}
+	err = c.Api.Unmarshal(create, &chargebacks)
 	return chargebacks, err
 }
 
@@ -108,12 +137,29 @@ func Get(id string, user user.User) (PixChargeback, Error.StarkErrors) {
 	//
 	//	Return:
 	//	- pixChargeback struct that corresponds to the given id.
+	return Client{Api: utils.Default(user)}.Get(id)
+}
+
+func GetCtx(ctx context.Context, id string, user user.User) (PixChargeback, Error.StarkErrors) {
+	//	Retrieve a PixChargeback struct
+	//
+	//	Same as Get, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.GetCtx(ctx, id)
+}
+
+func (c Client) Get(id string) (PixChargeback, Error.StarkErrors) {
+	//	Retrieve a PixChargeback struct
+	return c.GetCtx(context.Background(), id)
+}
+
+func (c Client) GetCtx(ctx context.Context, id string) (PixChargeback, Error.StarkErrors) {
+	//	Retrieve a PixChargeback struct
 	var pixChargeback PixChargeback
-	get, err := utils.Get(resource, id, nil, user)
-	unmarshalError := json.Unmarshal(get, &pixChargeback)
-	if unmarshalError != nil {
+	get, err := c.Api.Get(ctx, resource, id, nil)
+	if err.Errors != nil {
 		return pixChargeback, err
 	}
+	err = c.Api.Unmarshal(get, &pixChargeback)
 	return pixChargeback, err
 }
 
@@ -136,29 +182,133 @@ func Query(params map[string]interface{}, user user.User) (chan PixChargeback, c
 	//
 	//	Return:
 	//	- channel of PixChargeback structs with updated attributes
-	var pixChargeback PixChargeback
+	return Client{Api: utils.Default(user)}.Query(params)
+}
+
+func QueryCtx(ctx context.Context, params map[string]interface{}, user user.User) (chan PixChargeback, chan Error.StarkErrors) {
+	//	Retrieve PixChargeback structs
+	//
+	//	Same as Query, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.QueryCtx(ctx, params)
+}
+
+func (c Client) Query(params map[string]interface{}) (chan PixChargeback, chan Error.StarkErrors) {
+	//	Retrieve PixChargeback structs
+	return c.QueryCtx(context.Background(), params)
+}
+
+func (c Client) QueryCtx(ctx context.Context, params map[string]interface{}) (chan PixChargeback, chan Error.StarkErrors) {
+	//	Retrieve PixChargeback structs
 	chargebacks := make(chan PixChargeback)
 	chargebacksError := make(chan Error.StarkErrors)
-	query, errorChannel := utils.Query(resource, params, user)
+	query, errorChannel := c.Api.Stream(ctx, resource, params)
 	go func() {
+		defer close(chargebacksError)
+		defer close(chargebacks)
 		for content := range query {
-			contentByte, _ := json.Marshal(content)
-			err := json.Unmarshal(contentByte, &pixChargeback)
-			if err != nil {
-				chargebacksError <- Error.UnknownError(err.Error())
+			var pixChargeback PixChargeback
+			err := c.Api.Unmarshal(content, &pixChargeback)
+			if err.Errors != nil {
+				if !utils.SendError(ctx, chargebacksError, err) {
+					return
+				}
 				continue
 			}
-			chargebacks <- pixChargeback
+			select {
+			case chargebacks <- pixChargeback:
+			case <-ctx.Done():
+				return
+			}
 		}
 		for err := range errorChannel {
-			chargebacksError <- err
+			if !utils.SendError(ctx, chargebacksError, err) {
+				return
+			}
 		}
-		close(chargebacks)
-		close(chargebacksError)
 	}()
 	return chargebacks, chargebacksError
 }
 
+//	PixChargeback Iterator struct
+//
+//	Returned by Iterate to walk through PixChargeback structs one at a time,
+//	requesting a new page only once the current one is exhausted. Check Err
+//	once Next returns false and Close it when leaving the loop early.
+
+type Iterator struct {
+	api   *utils.Client
+	pages *utils.Iterator
+	value PixChargeback
+	err   Error.StarkErrors
+}
+
+func Iterate(params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve PixChargeback structs
+	//
+	//	Same as Query, but returns an Iterator instead of a pair of channels
+	//
+	//	Example:
+	//	iterator := Iterate(params, nil)
+	//	defer iterator.Close()
+	//	for iterator.Next() {
+	//		fmt.Println(iterator.Value())
+	//	}
+	//	if err := iterator.Err(); err.Errors != nil {
+	//		...
+	//	}
+	return Client{Api: utils.Default(user)}.Iterate(params)
+}
+
+func IterateCtx(ctx context.Context, params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve PixChargeback structs
+	//
+	//	Same as Iterate, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.IterateCtx(ctx, params)
+}
+
+func (c Client) Iterate(params map[string]interface{}) *Iterator {
+	//	Retrieve PixChargeback structs
+	return c.IterateCtx(context.Background(), params)
+}
+
+func (c Client) IterateCtx(ctx context.Context, params map[string]interface{}) *Iterator {
+	//	Retrieve PixChargeback structs
+	return &Iterator{api: c.Api, pages: c.Api.Iterate(ctx, resource, params)}
+}
+
+func (i *Iterator) Next() bool {
+	//	Move to the next PixChargeback, returning false when there are no more or a request failed
+	var value PixChargeback
+	if i.err.Errors != nil || !i.pages.Next() {
+		return false
+	}
+	i.err = i.api.Unmarshal(i.pages.Value(), &value)
+	if i.err.Errors != nil {
+		i.pages.Close()
+		return false
+	}
+	i.value = value
+	return true
+}
+
+func (i *Iterator) Value() PixChargeback {
+	//	Current PixChargeback, set by the last call to Next
+	return i.value
+}
+
+func (i *Iterator) Err() Error.StarkErrors {
+	//	Error that stopped the iteration, if any
+	if i.err.Errors != nil {
+		return i.err
+	}
+	return i.pages.Err()
+}
+
+func (i *Iterator) Close() {
+	//	Stop the iteration, releasing the current page
+	i.pages.Close()
+}
+
 func Page(params map[string]interface{}, user user.User) ([]PixChargeback, string, Error.StarkErrors) {
 	//	Retrieve paged PixChargeback structs
 	//
@@ -181,12 +331,29 @@ func Page(params map[string]interface{}, user user.User) ([]PixChargeback, strin
 	//	Return:
 	//	- slice of PixChargeback structs with updated attributes
 	//	- cursor to retrieve the next page of PixChargeback structs
+	return Client{Api: utils.Default(user)}.Page(params)
+}
+
+func PageCtx(ctx context.Context, params map[string]interface{}, user user.User) ([]PixChargeback, string, Error.StarkErrors) {
+	//	Retrieve paged PixChargeback structs
+	//
+	//	Same as Page, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.PageCtx(ctx, params)
+}
+
+func (c Client) Page(params map[string]interface{}) ([]PixChargeback, string, Error.StarkErrors) {
+	//	Retrieve paged PixChargeback structs
+	return c.PageCtx(context.Background(), params)
+}
+
+func (c Client) PageCtx(ctx context.Context, params map[string]interface{}) ([]PixChargeback, string, Error.StarkErrors) {
+	//	Retrieve paged PixChargeback structs
 	var pixChargebacks []PixChargeback
-	page, cursor, err := utils.Page(resource, params, user)
-	unmarshalError := json.Unmarshal(page, &pixChargebacks)
-	if unmarshalError != nil {
+	page, cursor, err := c.Api.Page(ctx, resource, params)
+	if err.Errors != nil {
 		return pixChargebacks, cursor, err
 	}
+	err = c.Api.Unmarshal(page, &pixChargebacks)
 	return pixChargebacks, cursor, err
 }
 
@@ -210,12 +377,29 @@ func Update(id string, patchData map[string]interface{}, user user.User) (PixCha
 	//
 	//	Return:
 	//	- pixChargeback struct with updated attributes
+	return Client{Api: utils.Default(user)}.Update(id, patchData)
+}
+
+func UpdateCtx(ctx context.Context, id string, patchData map[string]interface{}, user user.User) (PixChargeback, Error.StarkErrors) {
+	//	Update PixChargeback entity
+	//
+	//	Same as Update, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.UpdateCtx(ctx, id, patchData)
+}
+
+func (c Client) Update(id string, patchData map[string]interface{}) (PixChargeback, Error.StarkErrors) {
+	//	Update PixChargeback entity
+	return c.UpdateCtx(context.Background(), id, patchData)
+}
+
+func (c Client) UpdateCtx(ctx context.Context, id string, patchData map[string]interface{}) (PixChargeback, Error.StarkErrors) {
+	//	Update PixChargeback entity
 	var pixChargeback PixChargeback
-	update, err := utils.Patch(resource, id, patchData, user)
-	unmarshalError := json.Unmarshal(update, &pixChargeback)
-	if unmarshalError != nil {
+	update, err := c.Api.Patch(ctx, resource, id, patchData)
+	if err.Errors != nil {
 		return pixChargeback, err
 	}
+	err = c.Api.Unmarshal(update, &pixChargeback)
 	return pixChargeback, err
 }
 
@@ -232,11 +416,28 @@ func Cancel(id string, user user.User) (PixChargeback, Error.StarkErrors) {
 	//
 	//	Return:
 	//	- canceled PixChargeback struct
+	return Client{Api: utils.Default(user)}.Cancel(id)
+}
+
+func CancelCtx(ctx context.Context, id string, user user.User) (PixChargeback, Error.StarkErrors) {
+	//	Cancel a PixChargeback entity
+	//
+	//	Same as Cancel, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.CancelCtx(ctx, id)
+}
+
+func (c Client) Cancel(id string) (PixChargeback, Error.StarkErrors) {
+	//	Cancel a PixChargeback entity
+	return c.CancelCtx(context.Background(), id)
+}
+
+func (c Client) CancelCtx(ctx context.Context, id string) (PixChargeback, Error.StarkErrors) {
+	//	Cancel a PixChargeback entity
 	var pixChargeback PixChargeback
-	deleted, err := utils.Delete(resource, id, user)
-	unmarshalError := json.Unmarshal(deleted, &pixChargeback)
-	if unmarshalError != nil {
+	deleted, err := c.Api.Delete(ctx, resource, id)
+	if err.Errors != nil {
 		return pixChargeback, err
 	}
+	err = c.Api.Unmarshal(deleted, &pixChargeback)
 	return pixChargeback, err
 }
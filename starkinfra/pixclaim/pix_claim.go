@@ -1,6 +1,7 @@
 package pixclaim
 
 import (
+	"context"
 	"encoding/json"
 	Error "github.com/starkinfra/core-go/starkcore/error"
 	"github.com/starkinfra/core-go/starkcore/user/user"
@@ -38,29 +39,40 @@ import (
 //  - ClaimedBankCode [string]: BankCode of the account donating the PixKey. ex: "20018183"
 //  - Created [time.Time]: Creation datetime for the PixClaim. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC),
 //  - Updated [time.Time]: Update datetime for the PixClaim. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC),
+//	- Extra [map[string]json.RawMessage]: Fields of the PixClaim sent by the API but not declared in this SDK version yet, keyed by their JSON name. ex: map[string]json.RawMessage{"newField": json.RawMessage(`"value"`)}
 
 type PixClaim struct {
-	AccountCreated  string     `json:",omitempty"`
-	AccountNumber   string     `json:",omitempty"`
-	AccountType     string     `json:",omitempty"`
-	BranchCode      string     `json:",omitempty"`
-	Name            string     `json:",omitempty"`
-	TaxId           string     `json:",omitempty"`
-	KeyId           string     `json:",omitempty"`
-	Tags            []string   `json:",omitempty"`
-	Id              string     `json:",omitempty"`
-	Status          string     `json:",omitempty"`
-	Type            string     `json:",omitempty"`
-	KeyType         string     `json:",omitempty"`
-	Flow            string     `json:",omitempty"`
-	ClaimerBankCode string     `json:",omitempty"`
-	ClaimedBankCode string     `json:",omitempty"`
-	Created         *time.Time `json:",omitempty"`
-	Updated         *time.Time `json:",omitempty"`
+	AccountCreated  string                     `json:",omitempty"`
+	AccountNumber   string                     `json:",omitempty"`
+	AccountType     string                     `json:",omitempty"`
+	BranchCode      string                     `json:",omitempty"`
+	Name            string                     `json:",omitempty"`
+	TaxId           string                     `json:",omitempty"`
+	KeyId           string                     `json:",omitempty"`
+	Tags            []string                   `json:",omitempty"`
+	Id              string                     `json:",omitempty"`
+	Status          string                     `json:",omitempty"`
+	Type            string                     `json:",omitempty"`
+	KeyType         string                     `json:",omitempty"`
+	Flow            string                     `json:",omitempty"`
+	ClaimerBankCode string                     `json:",omitempty"`
+	ClaimedBankCode string                     `json:",omitempty"`
+	Created         *time.Time                 `json:",omitempty"`
+	Updated         *time.Time                 `json:",omitempty"`
+	Extra           map[string]json.RawMessage `json:"-"`
 }
 
 var resource = map[string]string{"name": "PixClaim"}
 
+//	PixClaim Client struct
+//
+//	Runs the PixClaim functions with the settings of its own utils.Client
+//	instead of starkinfra.User and the other package defaults.
+
+type Client struct {
+	Api *utils.Client
+}
+
 func Create(claim PixClaim, user user.User) (PixClaim, Error.StarkErrors) {
 	//	Create a PixClaim struct
 	//
@@ -75,11 +87,28 @@ func Create(claim PixClaim, user user.User) (PixClaim, Error.StarkErrors) {
 	//
 	//	Return:
 	//	- pixClaim struct with updated attributes.
-	create, err := utils.Single(resource, claim, user)
-	unmarshalError := json.Unmarshal(create, &claim)
-	if unmarshalError != nil {
+	return Client{Api: utils.Default(user)}.Create(claim)
+}
+
+func CreateCtx(ctx context.Context, claim PixClaim, user user.User) (PixClaim, Error.StarkErrors) {
+	//	Create a PixClaim struct
+	//
+	//	Same as Create, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.CreateCtx(ctx, claim)
+}
+
+func (c Client) Create(claim PixClaim) (PixClaim, Error.StarkErrors) {
+	//	Create a PixClaim struct
+	return c.CreateCtx(context.Background(), claim)
+}
+
+func (c Client) CreateCtx(ctx context.Context, claim PixClaim) (PixClaim, Error.StarkErrors) {
+	//	Create a PixClaim struct
+	create, err := c.Api.Single(ctx, resource, claim)
+	if err.Errors != nil {
 		return claim, err
 	}
+	err = c.Api.Unmarshal(create, &claim)
 	return claim, err
 }
 
@@ -96,12 +125,29 @@ func Get(id string, user user.User) (PixClaim, Error.StarkErrors) {
 	//
 	//	Return:
 	//	- pixClaim struct that corresponds to the given id.
+	return Client{Api: utils.Default(user)}.Get(id)
+}
+
+func GetCtx(ctx context.Context, id string, user user.User) (PixClaim, Error.StarkErrors) {
+	//	Retrieve a PixClaim struct
+	//
+	//	Same as Get, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.GetCtx(ctx, id)
+}
+
+func (c Client) Get(id string) (PixClaim, Error.StarkErrors) {
+	//	Retrieve a PixClaim struct
+	return c.GetCtx(context.Background(), id)
+}
+
+func (c Client) GetCtx(ctx context.Context, id string) (PixClaim, Error.StarkErrors) {
+	//	Retrieve a PixClaim struct
 	var pixClaim PixClaim
-	get, err := utils.Get(resource, id, nil, user)
-	unmarshalError := json.Unmarshal(get, &pixClaim)
-	if unmarshalError != nil {
+	get, err := c.Api.Get(ctx, resource, id, nil)
+	if err.Errors != nil {
 		return pixClaim, err
 	}
+	err = c.Api.Unmarshal(get, &pixClaim)
 	return pixClaim, err
 }
 
@@ -126,29 +172,133 @@ func Query(params map[string]interface{}, user user.User) (chan PixClaim, chan E
 	//
 	//	Return:
 	//	- channel of PixClaim structs with updated attributes
-	var pixClaim PixClaim
+	return Client{Api: utils.Default(user)}.Query(params)
+}
+
+func QueryCtx(ctx context.Context, params map[string]interface{}, user user.User) (chan PixClaim, chan Error.StarkErrors) {
+	//	Retrieve PixClaim structs
+	//
+	//	Same as Query, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.QueryCtx(ctx, params)
+}
+
+func (c Client) Query(params map[string]interface{}) (chan PixClaim, chan Error.StarkErrors) {
+	//	Retrieve PixClaim structs
+	return c.QueryCtx(context.Background(), params)
+}
+
+func (c Client) QueryCtx(ctx context.Context, params map[string]interface{}) (chan PixClaim, chan Error.StarkErrors) {
+	//	Retrieve PixClaim structs
 	claims := make(chan PixClaim)
 	claimsError := make(chan Error.StarkErrors)
-	query, errorChannel := utils.Query(resource, params, user)
+	query, errorChannel := c.Api.Stream(ctx, resource, params)
 	go func() {
+		defer close(claimsError)
+		defer close(claims)
 		for content := range query {
-			contentByte, _ := json.Marshal(content)
-			err := json.Unmarshal(contentByte, &pixClaim)
-			if err != nil {
-				claimsError <- Error.UnknownError(err.Error())
+			var pixClaim PixClaim
+			err := c.Api.Unmarshal(content, &pixClaim)
+			if err.Errors != nil {
+				if !utils.SendError(ctx, claimsError, err) {
+					return
+				}
 				continue
 			}
-			claims <- pixClaim
+			select {
+			case claims <- pixClaim:
+			case <-ctx.Done():
+				return
+			}
 		}
 		for err := range errorChannel {
-			claimsError <- err
+			if !utils.SendError(ctx, claimsError, err) {
+				return
+			}
 		}
-		close(claims)
-		close(claimsError)
 	}()
 	return claims, claimsError
 }
 
+//	PixClaim Iterator struct
+//
+//	Returned by Iterate to walk through PixClaim structs one at a time,
+//	requesting a new page only once the current one is exhausted. Check Err
+//	once Next returns false and Close it when leaving the loop early.
+
+type Iterator struct {
+	api   *utils.Client
+	pages *utils.Iterator
+	value PixClaim
+	err   Error.StarkErrors
+}
+
+func Iterate(params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve PixClaim structs
+	//
+	//	Same as Query, but returns an Iterator instead of a pair of channels
+	//
+	//	Example:
+	//	iterator := Iterate(params, nil)
+	//	defer iterator.Close()
+	//	for iterator.Next() {
+	//		fmt.Println(iterator.Value())
+	//	}
+	//	if err := iterator.Err(); err.Errors != nil {
+	//		...
+	//	}
+	return Client{Api: utils.Default(user)}.Iterate(params)
+}
+
+func IterateCtx(ctx context.Context, params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve PixClaim structs
+	//
+	//	Same as Iterate, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.IterateCtx(ctx, params)
+}
+
+func (c Client) Iterate(params map[string]interface{}) *Iterator {
+	//	Retrieve PixClaim structs
+	return c.IterateCtx(context.Background(), params)
+}
+
+func (c Client) IterateCtx(ctx context.Context, params map[string]interface{}) *Iterator {
+	//	Retrieve PixClaim structs
+	return &Iterator{api: c.Api, pages: c.Api.Iterate(ctx, resource, params)}
+}
+
+func (i *Iterator) Next() bool {
+	//	Move to the next PixClaim, returning false when there are no more or a request failed
+	var value PixClaim
+	if i.err.Errors != nil || !i.pages.Next() {
+		return false
+	}
+	i.err = i.api.Unmarshal(i.pages.Value(), &value)
+	if i.err.Errors != nil {
+		i.pages.Close()
+		return false
+	}
+	i.value = value
+	return true
+}
+
+func (i *Iterator) Value() PixClaim {
+	//	Current PixClaim, set by the last call to Next
+	return i.value
+}
+
+func (i *Iterator) Err() Error.StarkErrors {
+	//	Error that stopped the iteration, if any
+	if i.err.Errors != nil {
+		return i.err
+	}
+	return i.pages.Err()
+}
+
+func (i *Iterator) Close() {
+	//	Stop the iteration, releasing the current page
+	i.pages.Close()
+}
+
 func Page(params map[string]interface{}, user user.User) ([]PixClaim, string, Error.StarkErrors) {
 	//	Retrieve paged PixClaim structs
 	//
@@ -173,12 +323,29 @@ func Page(params map[string]interface{}, user user.User) ([]PixClaim, string, Er
 	//	Return:
 	//	- slice of PixClaim structs with updated attributes
 	//  - Cursor to retrieve the next page of PixClaim structs
+	return Client{Api: utils.Default(user)}.Page(params)
+}
+
+func PageCtx(ctx context.Context, params map[string]interface{}, user user.User) ([]PixClaim, string, Error.StarkErrors) {
+	//	Retrieve paged PixClaim structs
+	//
+	//	Same as Page, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.PageCtx(ctx, params)
+}
+
+func (c Client) Page(params map[string]interface{}) ([]PixClaim, string, Error.StarkErrors) {
+	//	Retrieve paged PixClaim structs
+	return c.PageCtx(context.Background(), params)
+}
+
+func (c Client) PageCtx(ctx context.Context, params map[string]interface{}) ([]PixClaim, string, Error.StarkErrors) {
+	//	Retrieve paged PixClaim structs
 	var pixClaims []PixClaim
-	page, cursor, err := utils.Page(resource, params, user)
-	unmarshalError := json.Unmarshal(page, &pixClaims)
-	if unmarshalError != nil {
+	page, cursor, err := c.Api.Page(ctx, resource, params)
+	if err.Errors != nil {
 		return pixClaims, cursor, err
 	}
+	err = c.Api.Unmarshal(page, &pixClaims)
 	return pixClaims, cursor, err
 }
 
@@ -200,11 +367,28 @@ func Update(id string, patchData map[string]interface{}, user user.User) (PixCla
 	//
 	//	Return:
 	//	- pixClaim with updated attributes
+	return Client{Api: utils.Default(user)}.Update(id, patchData)
+}
+
+func UpdateCtx(ctx context.Context, id string, patchData map[string]interface{}, user user.User) (PixClaim, Error.StarkErrors) {
+	//	Update PixClaim entity
+	//
+	//	Same as Update, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.UpdateCtx(ctx, id, patchData)
+}
+
+func (c Client) Update(id string, patchData map[string]interface{}) (PixClaim, Error.StarkErrors) {
+	//	Update PixClaim entity
+	return c.UpdateCtx(context.Background(), id, patchData)
+}
+
+func (c Client) UpdateCtx(ctx context.Context, id string, patchData map[string]interface{}) (PixClaim, Error.StarkErrors) {
+	//	Update PixClaim entity
 	var pixClaim PixClaim
-	update, err := utils.Patch(resource, id, patchData, user)
-	unmarshalError := json.Unmarshal(update, &pixClaim)
-	if unmarshalError != nil {
+	update, err := c.Api.Patch(ctx, resource, id, patchData)
+	if err.Errors != nil {
 		return pixClaim, err
 	}
+	err = c.Api.Unmarshal(update, &pixClaim)
 	return pixClaim, err
 }
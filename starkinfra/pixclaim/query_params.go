@@ -0,0 +1,42 @@
+package pixclaim
+
+import (
+	"github.com/starkinfra/sdk-go/starkinfra/utils"
+	"time"
+)
+
+//	PixClaim QueryParams struct
+//
+//	Typed filters for Query, Iterate and Page. Fields left empty are not sent.
+//
+//	Attributes:
+//	- Limit [int, default nil]: Maximum number of structs to be retrieved. Unlimited if nil, except on Page, which returns up to 100. ex: 35
+//	- Cursor [string, default nil]: Cursor returned on the previous Page call. Only used by Page
+//	- After [time.Time, default nil]: Date filter for structs created only after specified date. ex: time.Date(2020, 3, 10, 0, 0, 0, 0, time.UTC)
+//	- Before [time.Time, default nil]: Date filter for structs created only before specified date. ex: time.Date(2022, 11, 10, 0, 0, 0, 0, time.UTC)
+//	- Status [slice of strings, default nil]: Filter for status of retrieved structs. ex: []string{"created", "failed", "delivered", "confirmed", "success", "canceled"}
+//	- Ids [slice of strings, default nil]: Slice of ids to filter retrieved structs. ex: []string{"5656565656565656", "4545454545454545"}
+//	- Type [string, default nil]: Filter for the type of retrieved PixClaims. Options: "ownership" or "portability".
+//	- KeyType [string, default nil]: Filter for the PixKey type of retrieved PixClaims. Options: "cpf", "cnpj", "phone", "email" and "evp"
+//	- KeyId [string, default nil]: Filter PixClaims linked to a specific PixKey id. ex: "+5511989898989"
+//	- Flow [string, default nil]: Direction of the Pix Claim. Options: "in" if you received the PixClaim or "out" if you created the PixClaim.
+//	- Tags [slice of strings, default nil]: Slice of strings to filter retrieved structs. ex: []string{"travel", "food"}
+
+type QueryParams struct {
+	Limit   int       `json:"limit,omitempty"`
+	Cursor  string    `json:"cursor,omitempty"`
+	After   time.Time `json:"after,omitempty"`
+	Before  time.Time `json:"before,omitempty"`
+	Status  []string  `json:"status,omitempty"`
+	Ids     []string  `json:"ids,omitempty"`
+	Type    string    `json:"type,omitempty"`
+	KeyType string    `json:"keyType,omitempty"`
+	KeyId   string    `json:"keyId,omitempty"`
+	Flow    string    `json:"flow,omitempty"`
+	Tags    []string  `json:"tags,omitempty"`
+}
+
+func (p QueryParams) Map() map[string]interface{} {
+	//	Convert the QueryParams into the params map taken by Query, Iterate and Page
+	return utils.ParamsMap(p)
+}
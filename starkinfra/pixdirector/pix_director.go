@@ -1,6 +1,7 @@
 package pixdirector
 
 import (
+	"context"
 	"encoding/json"
 	Error "github.com/starkinfra/core-go/starkcore/error"
 	"github.com/starkinfra/core-go/starkcore/user/user"
@@ -25,20 +26,31 @@ import (
 //
 //	Attributes (return-only):
 //	- Status [string]: Current PixDirector status. ex: "success"
+//	- Extra [map[string]json.RawMessage]: Fields of the PixDirector sent by the API but not declared in this SDK version yet, keyed by their JSON name. ex: map[string]json.RawMessage{"newField": json.RawMessage(`"value"`)}
 
 type PixDirector struct {
-	Name       string   `json:",omitempty"`
-	TaxId      string   `json:",omitempty"`
-	Phone      string   `json:",omitempty"`
-	Email      string   `json:",omitempty"`
-	Password   string   `json:",omitempty"`
-	TeamEmail  string   `json:",omitempty"`
-	TeamPhones []string `json:",omitempty"`
-	Status     string   `json:",omitempty"`
+	Name       string                     `json:",omitempty"`
+	TaxId      string                     `json:",omitempty"`
+	Phone      string                     `json:",omitempty"`
+	Email      string                     `json:",omitempty"`
+	Password   string                     `json:",omitempty"`
+	TeamEmail  string                     `json:",omitempty"`
+	TeamPhones []string                   `json:",omitempty"`
+	Status     string                     `json:",omitempty"`
+	Extra      map[string]json.RawMessage `json:"-"`
 }
 
 var subResource = map[string]string{"name": "PixDirector"}
 
+//	PixDirector Client struct
+//
+//	Runs the PixDirector functions with the settings of its own utils.Client
+//	instead of starkinfra.User and the other package defaults.
+
+type Client struct {
+	Api *utils.Client
+}
+
 func Create(director PixDirector, user user.User) (PixDirector, Error.StarkErrors) {
 	//	Create a PixDirector struct
 	//
@@ -52,10 +64,27 @@ func Create(director PixDirector, user user.User) (PixDirector, Error.StarkError
 	//
 	//	Return:
 	//	- pixDirector struct with updated attributes
-	create, err := utils.Single(subResource, director, user)
-	unmarshalError := json.Unmarshal(create, &director)
-	if unmarshalError != nil {
+	return Client{Api: utils.Default(user)}.Create(director)
+}
+
+func CreateCtx(ctx context.Context, director PixDirector, user user.User) (PixDirector, Error.StarkErrors) {
+	//	Create a PixDirector struct
+	//
+	//	Same as Create, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.CreateCtx(ctx, director)
+}
+
+func (c Client) Create(director PixDirector) (PixDirector, Error.StarkErrors) {
+	//	Create a PixDirector struct
+	return c.CreateCtx(context.Background(), director)
+}
+
+func (c Client) CreateCtx(ctx context.Context, director PixDirector) (PixDirector, Error.StarkErrors) {
+	//	Create a PixDirector struct
+	create, err := c.Api.Single(ctx, subResource, director)
+	if err.Errors != nil {
 		return director, err
 	}
+	err = c.Api.Unmarshal(create, &director)
 	return director, err
 }
@@ -1,6 +1,7 @@
 package pixdispute
 
 import (
+	"context"
 	"encoding/json"
 	Error "github.com/starkinfra/core-go/starkcore/error"
 	"github.com/starkinfra/core-go/starkcore/user/user"
@@ -40,29 +41,40 @@ import (
 //	- Transactions [slice of PixDispute.Transaction structs]: Slice of transactions that make up the dispute graph.
 //	- Created [time.Time]: Creation datetime for the PixDispute. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC),
 //	- Updated [time.Time]: Latest update datetime for the PixDispute. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC),
+//	- Extra [map[string]json.RawMessage]: Fields of the PixDispute sent by the API but not declared in this SDK version yet, keyed by their JSON name. ex: map[string]json.RawMessage{"newField": json.RawMessage(`"value"`)}
 
 type PixDispute struct {
-	ReferenceId          string        `json:",omitempty"`
-	Method               string        `json:",omitempty"`
-	OperatorEmail        string        `json:",omitempty"`
-	OperatorPhone        string        `json:",omitempty"`
-	Description          string        `json:",omitempty"`
-	Tags                 []string      `json:",omitempty"`
-	MinTransactionAmount int           `json:",omitempty"`
-	MaxTransactionCount  int           `json:",omitempty"`
-	MaxHopInterval       int           `json:",omitempty"`
-	MaxHopCount          int           `json:",omitempty"`
-	Id                   string        `json:",omitempty"`
-	BacenId              string        `json:",omitempty"`
-	Flow                 string        `json:",omitempty"`
-	Status               string        `json:",omitempty"`
-	Transactions         []Transaction `json:",omitempty"`
-	Created              *time.Time    `json:",omitempty"`
-	Updated              *time.Time    `json:",omitempty"`
+	ReferenceId          string                     `json:",omitempty"`
+	Method               string                     `json:",omitempty"`
+	OperatorEmail        string                     `json:",omitempty"`
+	OperatorPhone        string                     `json:",omitempty"`
+	Description          string                     `json:",omitempty"`
+	Tags                 []string                   `json:",omitempty"`
+	MinTransactionAmount int                        `json:",omitempty"`
+	MaxTransactionCount  int                        `json:",omitempty"`
+	MaxHopInterval       int                        `json:",omitempty"`
+	MaxHopCount          int                        `json:",omitempty"`
+	Id                   string                     `json:",omitempty"`
+	BacenId              string                     `json:",omitempty"`
+	Flow                 string                     `json:",omitempty"`
+	Status               string                     `json:",omitempty"`
+	Transactions         []Transaction              `json:",omitempty"`
+	Created              *time.Time                 `json:",omitempty"`
+	Updated              *time.Time                 `json:",omitempty"`
+	Extra                map[string]json.RawMessage `json:"-"`
 }
 
 var resource = map[string]string{"name": "PixDispute"}
 
+//	PixDispute Client struct
+//
+//	Runs the PixDispute functions with the settings of its own utils.Client
+//	instead of starkinfra.User and the other package defaults.
+
+type Client struct {
+	Api *utils.Client
+}
+
 func Create(disputes []PixDispute, user user.User) ([]PixDispute, Error.StarkErrors) {
 	//	Create PixDispute structs
 	//
@@ -76,11 +88,28 @@ func Create(disputes []PixDispute, user user.User) ([]PixDispute, Error.StarkErr
 	//
 	//	Return:
 	//	- slice of PixDispute structs with updated attributes
-	create, err := utils.Multi(resource, disputes, nil, user)
-	unmarshalError := json.Unmarshal(create, &disputes)
-	if unmarshalError != nil {
+	return Client{Api: utils.Default(user)}.Create(disputes)
+}
+
+func CreateCtx(ctx context.Context, disputes []PixDispute, user user.User) ([]PixDispute, Error.StarkErrors) {
+	//	Create PixDispute structs
+	//
+	//	Same as Create, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.CreateCtx(ctx, disputes)
+}
+
+func (c Client) Create(disputes []PixDispute) ([]PixDispute, Error.StarkErrors) {
+	//	Create PixDispute structs
+	return c.CreateCtx(context.Background(), disputes)
+}
+
+func (c Client) CreateCtx(ctx context.Context, disputes []PixDispute) ([]PixDispute, Error.StarkErrors) {
+	//	Create PixDispute structs
+	create, err := c.Api.Multi(ctx, resource, disputes, nil)
+	if err.Errors != nil {
 		return disputes, err
 	}
+	err = c.Api.Unmarshal(create, &disputes)
 	return disputes, err
 }
 
@@ -97,12 +126,29 @@ func Get(id string, user user.User) (PixDispute, Error.StarkErrors) {
 	//
 	//	Return:
 	//	- pixDispute struct with updated attributes
+	return Client{Api: utils.Default(user)}.Get(id)
+}
+
+func GetCtx(ctx context.Context, id string, user user.User) (PixDispute, Error.StarkErrors) {
+	//	Retrieve a specific PixDispute
+	//
+	//	Same as Get, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.GetCtx(ctx, id)
+}
+
+func (c Client) Get(id string) (PixDispute, Error.StarkErrors) {
+	//	Retrieve a specific PixDispute
+	return c.GetCtx(context.Background(), id)
+}
+
+func (c Client) GetCtx(ctx context.Context, id string) (PixDispute, Error.StarkErrors) {
+	//	Retrieve a specific PixDispute
 	var pixDispute PixDispute
-	get, err := utils.Get(resource, id, nil, user)
-	unmarshalError := json.Unmarshal(get, &pixDispute)
-	if unmarshalError != nil {
+	get, err := c.Api.Get(ctx, resource, id, nil)
+	if err.Errors != nil {
 		return pixDispute, err
 	}
+	err = c.Api.Unmarshal(get, &pixDispute)
 	return pixDispute, err
 }
 
@@ -123,29 +169,133 @@ func Query(params map[string]interface{}, user user.User) (chan PixDispute, chan
 	//
 	//	Return:
 	//	- channel of PixDispute structs with updated attributes
-	var pixDispute PixDispute
+	return Client{Api: utils.Default(user)}.Query(params)
+}
+
+func QueryCtx(ctx context.Context, params map[string]interface{}, user user.User) (chan PixDispute, chan Error.StarkErrors) {
+	//	Retrieve PixDispute structs
+	//
+	//	Same as Query, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.QueryCtx(ctx, params)
+}
+
+func (c Client) Query(params map[string]interface{}) (chan PixDispute, chan Error.StarkErrors) {
+	//	Retrieve PixDispute structs
+	return c.QueryCtx(context.Background(), params)
+}
+
+func (c Client) QueryCtx(ctx context.Context, params map[string]interface{}) (chan PixDispute, chan Error.StarkErrors) {
+	//	Retrieve PixDispute structs
 	disputes := make(chan PixDispute)
 	disputesError := make(chan Error.StarkErrors)
-	query, errorChannel := utils.Query(resource, params, user)
+	query, errorChannel := c.Api.Stream(ctx, resource, params)
 	go func() {
+		defer close(disputesError)
+		defer close(disputes)
 		for content := range query {
-			contentByte, _ := json.Marshal(content)
-			err := json.Unmarshal(contentByte, &pixDispute)
-			if err != nil {
-				disputesError <- Error.UnknownError(err.Error())
+			var pixDispute PixDispute
+			err := c.Api.Unmarshal(content, &pixDispute)
+			if err.Errors != nil {
+				if !utils.SendError(ctx, disputesError, err) {
+					return
+				}
 				continue
 			}
-			disputes <- pixDispute
+			select {
+			case disputes <- pixDispute:
+			case <-ctx.Done():
+				return
+			}
 		}
 		for err := range errorChannel {
-			disputesError <- err
+			if !utils.SendError(ctx, disputesError, err) {
+				return
+			}
 		}
-		close(disputes)
-		close(disputesError)
 	}()
 	return disputes, disputesError
 }
 
+//	PixDispute Iterator struct
+//
+//	Returned by Iterate to walk through PixDispute structs one at a time,
+//	requesting a new page only once the current one is exhausted. Check Err
+//	once Next returns false and Close it when leaving the loop early.
+
+type Iterator struct {
+	api   *utils.Client
+	pages *utils.Iterator
+	value PixDispute
+	err   Error.StarkErrors
+}
+
+func Iterate(params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve PixDispute structs
+	//
+	//	Same as Query, but returns an Iterator instead of a pair of channels
+	//
+	//	Example:
+	//	iterator := Iterate(params, nil)
+	//	defer iterator.Close()
+	//	for iterator.Next() {
+	//		fmt.Println(iterator.Value())
+	//	}
+	//	if err := iterator.Err(); err.Errors != nil {
+	//		...
+	//	}
+	return Client{Api: utils.Default(user)}.Iterate(params)
+}
+
+func IterateCtx(ctx context.Context, params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve PixDispute structs
+	//
+	//	Same as Iterate, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.IterateCtx(ctx, params)
+}
+
+func (c Client) Iterate(params map[string]interface{}) *Iterator {
+	//	Retrieve PixDispute structs
+	return c.IterateCtx(context.Background(), params)
+}
+
+func (c Client) IterateCtx(ctx context.Context, params map[string]interface{}) *Iterator {
+	//	Retrieve PixDispute structs
+	return &Iterator{api: c.Api, pages: c.Api.Iterate(ctx, resource, params)}
+}
+
+func (i *Iterator) Next() bool {
+	//	Move to the next PixDispute, returning false when there are no more or a request failed
+	var value PixDispute
+	if i.err.Errors != nil || !i.pages.Next() {
+		return false
+	}
+	i.err = i.api.Unmarshal(i.pages.Value(), &value)
+	if i.err.Errors != nil {
+		i.pages.Close()
+		return false
+	}
+	i.value = value
+	return true
+}
+
+func (i *Iterator) Value() PixDispute {
+	//	Current PixDispute, set by the last call to Next
+	return i.value
+}
+
+func (i *Iterator) Err() Error.StarkErrors {
+	//	Error that stopped the iteration, if any
+	if i.err.Errors != nil {
+		return i.err
+	}
+	return i.pages.Err()
+}
+
+func (i *Iterator) Close() {
+	//	Stop the iteration, releasing the current page
+	i.pages.Close()
+}
+
 func Page(params map[string]interface{}, user user.User) ([]PixDispute, string, Error.StarkErrors) {
 	//	Retrieve paged PixDispute structs
 	//
@@ -166,12 +316,29 @@ func Page(params map[string]interface{}, user user.User) ([]PixDispute, string,
 	//	Return:
 	//	- slice of PixDispute structs with updated attributes
 	//	- cursor to retrieve the next page of PixDispute structs
+	return Client{Api: utils.Default(user)}.Page(params)
+}
+
+func PageCtx(ctx context.Context, params map[string]interface{}, user user.User) ([]PixDispute, string, Error.StarkErrors) {
+	//	Retrieve paged PixDispute structs
+	//
+	//	Same as Page, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.PageCtx(ctx, params)
+}
+
+func (c Client) Page(params map[string]interface{}) ([]PixDispute, string, Error.StarkErrors) {
+	//	Retrieve paged PixDispute structs
+	return c.PageCtx(context.Background(), params)
+}
+
+func (c Client) PageCtx(ctx context.Context, params map[string]interface{}) ([]PixDispute, string, Error.StarkErrors) {
+	//	Retrieve paged PixDispute structs
 	var pixDisputes []PixDispute
-	page, cursor, err := utils.Page(resource, params, user)
-	unmarshalError := json.Unmarshal(page, &pixDisputes)
-	if unmarshalError != nil {
+	page, cursor, err := c.Api.Page(ctx, resource, params)
+	if err.Errors != nil {
 		return pixDisputes, cursor, err
 	}
+	err = c.Api.Unmarshal(page, &pixDisputes)
 	return pixDisputes, cursor, err
 }
 
@@ -188,11 +355,28 @@ func Cancel(id string, user user.User) (PixDispute, Error.StarkErrors) {
 	//
 	//	Return:
 	//	- canceled PixDispute struct
+	return Client{Api: utils.Default(user)}.Cancel(id)
+}
+
+func CancelCtx(ctx context.Context, id string, user user.User) (PixDispute, Error.StarkErrors) {
+	//	Cancel a PixDispute entity
+	//
+	//	Same as Cancel, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.CancelCtx(ctx, id)
+}
+
+func (c Client) Cancel(id string) (PixDispute, Error.StarkErrors) {
+	//	Cancel a PixDispute entity
+	return c.CancelCtx(context.Background(), id)
+}
+
+func (c Client) CancelCtx(ctx context.Context, id string) (PixDispute, Error.StarkErrors) {
+	//	Cancel a PixDispute entity
 	var pixDispute PixDispute
-	deleted, err := utils.Delete(resource, id, user)
-	unmarshalError := json.Unmarshal(deleted, &pixDispute)
-	if unmarshalError != nil {
+	deleted, err := c.Api.Delete(ctx, resource, id)
+	if err.Errors != nil {
 		return pixDispute, err
 	}
+	err = c.Api.Unmarshal(deleted, &pixDispute)
 	return pixDispute, err
 }
@@ -1,6 +1,7 @@
 package pixdomain
 
 import (
+	"context"
 	"encoding/json"
 	Error "github.com/starkinfra/core-go/starkcore/error"
 	"github.com/starkinfra/core-go/starkcore/user/user"
@@ -15,14 +16,25 @@ import (
 //	Attributes (return-only):
 //	- Certificates [slice of PixDomain.Certificate struct]: Certificate information of the Pix participant.
 //	- Name [string]: Current active domain (URL) of the Pix participant.
+//	- Extra [map[string]json.RawMessage]: Fields of the PixDomain sent by the API but not declared in this SDK version yet, keyed by their JSON name. ex: map[string]json.RawMessage{"newField": json.RawMessage(`"value"`)}
 
 type PixDomain struct {
-	Certificates []Certificate `json:",omitempty"`
-	Name         string        `json:",omitempty"`
+	Certificates []Certificate              `json:",omitempty"`
+	Name         string                     `json:",omitempty"`
+	Extra        map[string]json.RawMessage `json:"-"`
 }
 
 var resource = map[string]string{"name": "PixDomain"}
 
+//	PixDomain Client struct
+//
+//	Runs the PixDomain functions with the settings of its own utils.Client
+//	instead of starkinfra.User and the other package defaults.
+
+type Client struct {
+	Api *utils.Client
+}
+
 func Query(user user.User) (chan PixDomain, chan Error.StarkErrors) {
 	//	Retrieve PixDomain structs
 	//
@@ -33,25 +45,129 @@ func Query(user user.User) (chan PixDomain, chan Error.StarkErrors) {
 	//
 	//	Return:
 	//	- Channel  of PixDomain structs with updated attributes
-	var pixDomain PixDomain
+	return Client{Api: utils.Default(user)}.Query()
+}
+
+func QueryCtx(ctx context.Context, user user.User) (chan PixDomain, chan Error.StarkErrors) {
+	//	Retrieve PixDomain structs
+	//
+	//	Same as Query, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.QueryCtx(ctx)
+}
+
+func (c Client) Query() (chan PixDomain, chan Error.StarkErrors) {
+	//	Retrieve PixDomain structs
+	return c.QueryCtx(context.Background())
+}
+
+func (c Client) QueryCtx(ctx context.Context) (chan PixDomain, chan Error.StarkErrors) {
+	//	Retrieve PixDomain structs
 	domains := make(chan PixDomain)
 	domainsError := make(chan Error.StarkErrors)
-	query, errorChannel := utils.Query(resource, nil, user)
+	query, errorChannel := c.Api.Stream(ctx, resource, nil)
 	go func() {
+		defer close(domainsError)
+		defer close(domains)
 		for content := range query {
-			contentByte, _ := json.Marshal(content)
-			err := json.Unmarshal(contentByte, &pixDomain)
-			if err != nil {
-				domainsError <- Error.UnknownError(err.Error())
+			var pixDomain PixDomain
+			err := c.Api.Unmarshal(content, &pixDomain)
+			if err.Errors != nil {
+				if !utils.SendError(ctx, domainsError, err) {
+					return
+				}
 				continue
 			}
-			domains <- pixDomain
+			select {
+			case domains <- pixDomain:
+			case <-ctx.Done():
+				return
+			}
 		}
 		for err := range errorChannel {
-			domainsError <- err
+			if !utils.SendError(ctx, domainsError, err) {
+				return
+			}
 		}
-		close(domains)
-		close(domainsError)
 	}()
 	return domains, domainsError
 }
+
+//	PixDomain Iterator struct
+//
+//	Returned by Iterate to walk through PixDomain structs one at a time,
+//	requesting a new page only once the current one is exhausted. Check Err
+//	once Next returns false and Close it when leaving the loop early.
+
+type Iterator struct {
+	api   *utils.Client
+	pages *utils.Iterator
+	value PixDomain
+	err   Error.StarkErrors
+}
+
+func Iterate(user user.User) *Iterator {
+	//	Retrieve PixDomain structs
+	//
+	//	Same as Query, but returns an Iterator instead of a pair of channels
+	//
+	//	Example:
+	//	iterator := Iterate(nil)
+	//	defer iterator.Close()
+	//	for iterator.Next() {
+	//		fmt.Println(iterator.Value())
+	//	}
+	//	if err := iterator.Err(); err.Errors != nil {
+	//		...
+	//	}
+	return Client{Api: utils.Default(user)}.Iterate()
+}
+
+func IterateCtx(ctx context.Context, user user.User) *Iterator {
+	//	Retrieve PixDomain structs
+	//
+	//	Same as Iterate, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.IterateCtx(ctx)
+}
+
+func (c Client) Iterate() *Iterator {
+	//	Retrieve PixDomain structs
+	return c.IterateCtx(context.Background())
+}
+
+func (c Client) IterateCtx(ctx context.Context) *Iterator {
+	//	Retrieve PixDomain structs
+	return &Iterator{api: c.Api, pages: c.Api.Iterate(ctx, resource, nil)}
+}
+
+func (i *Iterator) Next() bool {
+	//	Move to the next PixDomain, returning false when there are no more or a request failed
+	var value PixDomain
+	if i.err.Errors != nil || !i.pages.Next() {
+		return false
+	}
+	i.err = i.api.Unmarshal(i.pages.Value(), &value)
+	if i.err.Errors != nil {
+		i.pages.Close()
+		return false
+	}
+	i.value = value
+	return true
+}
+
+func (i *Iterator) Value() PixDomain {
+	//	Current PixDomain, set by the last call to Next
+	return i.value
+}
+
+func (i *Iterator) Err() Error.StarkErrors {
+	//	Error that stopped the iteration, if any
+	if i.err.Errors != nil {
+		return i.err
+	}
+	return i.pages.Err()
+}
+
+func (i *Iterator) Close() {
+	//	Stop the iteration, releasing the current page
+	i.pages.Close()
+}
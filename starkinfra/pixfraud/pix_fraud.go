@@ -1,6 +1,7 @@
 package pixfraud
 
 import (
+	"context"
 	"encoding/json"
 	Error "github.com/starkinfra/core-go/starkcore/error"
 	"github.com/starkinfra/core-go/starkcore/user/user"
@@ -31,22 +32,33 @@ import (
 // 	- Status [string]: Current PixFraud status. Options: "created", "failed", "registered", "canceled".
 // 	- Created [string]: Creation datetime for the PixFraud. ex: "2020-03-10 10:30:00.000000+00:00"
 // 	- Updated [string]: Latest update datetime for the PixFraud. ex: "2020-03-10 10:30:00.000000+00:00"
+//	- Extra [map[string]json.RawMessage]: Fields of the PixFraud sent by the API but not declared in this SDK version yet, keyed by their JSON name. ex: map[string]json.RawMessage{"newField": json.RawMessage(`"value"`)}
 
 type PixFraud struct {
-	ExternalId     	 string     `json:",omitempty"`
-	Type             string     `json:",omitempty"`
-	TaxId 	         string     `json:",omitempty"`
-	KeyId		     string     `json:",omitempty"`
-	Tags             []string   `json:",omitempty"`
-	Id               string     `json:",omitempty"`
-	BacenId			 string		`json:",omitempty"`
-	Status           string     `json:",omitempty"`
-	Created          *time.Time `json:",omitempty"`
-	Updated          *time.Time `json:",omitempty"`
+	ExternalId string                     `json:",omitempty"`
+	Type       string                     `json:",omitempty"`
+	TaxId      string                     `json:",omitempty"`
+	KeyId      string                     `json:",omitempty"`
+	Tags       []string                   `json:",omitempty"`
+	Id         string                     `json:",omitempty"`
+	BacenId    string                     `json:",omitempty"`
+	Status     string                     `json:",omitempty"`
+	Created    *time.Time                 `json:",omitempty"`
+	Updated    *time.Time                 `json:",omitempty"`
+	Extra      map[string]json.RawMessage `json:"-"`
 }
 
 var resource = map[string]string{"name": "PixFraud"}
 
+//	PixFraud Client struct
+//
+//	Runs the PixFraud functions with the settings of its own utils.Client
+//	instead of starkinfra.User and the other package defaults.
+
+type Client struct {
+	Api *utils.Client
+}
+
 func Create(frauds []PixFraud, user user.User) ([]PixFraud, Error.StarkErrors) {
 	//	Create PixFraud structs
 	//
@@ -60,11 +72,28 @@ func Create(frauds []PixFraud, user user.User) ([]PixFraud, Error.StarkErrors) {
 	//
 	//	Return:
 	//	- slice of PixFraud structs with updated attributes
-	create, err := utils.Multi(resource, frauds, nil, user)
-	unmarshalError := json.Unmarshal(create, &frauds)
-	if unmarshalError != nil {
+	return Client{Api: utils.Default(user)}.Create(frauds)
+}
+
+func CreateCtx(ctx context.Context, frauds []PixFraud, user user.User) ([]PixFraud, Error.StarkErrors) {
+	//	Create PixFraud structs
+	//
+	//	Same as Create, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.CreateCtx(ctx, frauds)
+}
+
+func (c Client) Create(frauds []PixFraud) ([]PixFraud, Error.StarkErrors) {
+	//	Create PixFraud structs
+	return c.CreateCtx(context.Background(), frauds)
+}
+
+func (c Client) CreateCtx(ctx context.Context, frauds []PixFraud) ([]PixFraud, Error.StarkErrors) {
+	//	Create PixFraud structs
+	create, err := c.Api.Multi(ctx, resource, frauds, nil)
+	if err.Errors != nil {
 		return frauds, err
 	}
+	err = c.Api.Unmarshal(create, &frauds)
 	return frauds, err
 }
 
@@ -81,12 +110,29 @@ func Get(id string, user user.User) (PixFraud, Error.StarkErrors) {
 	//
 	//	Return:
 	//	- pixFraud struct that corresponds to the given id.
+	return Client{Api: utils.Default(user)}.Get(id)
+}
+
+func GetCtx(ctx context.Context, id string, user user.User) (PixFraud, Error.StarkErrors) {
+	//	Retrieve a PixFraud struct
+	//
+	//	Same as Get, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.GetCtx(ctx, id)
+}
+
+func (c Client) Get(id string) (PixFraud, Error.StarkErrors) {
+	//	Retrieve a PixFraud struct
+	return c.GetCtx(context.Background(), id)
+}
+
+func (c Client) GetCtx(ctx context.Context, id string) (PixFraud, Error.StarkErrors) {
+	//	Retrieve a PixFraud struct
 	var pixFraud PixFraud
-	get, err := utils.Get(resource, id, nil, user)
-	unmarshalError := json.Unmarshal(get, &pixFraud)
-	if unmarshalError != nil {
+	get, err := c.Api.Get(ctx, resource, id, nil)
+	if err.Errors != nil {
 		return pixFraud, err
 	}
+	err = c.Api.Unmarshal(get, &pixFraud)
 	return pixFraud, err
 }
 
@@ -110,29 +156,133 @@ func Query(params map[string]interface{}, user user.User) (chan PixFraud, chan E
 	//
 	//	Return:
 	//	- channel of PixFraud structs with updated attributes
-	var pixFraud PixFraud
+	return Client{Api: utils.Default(user)}.Query(params)
+}
+
+func QueryCtx(ctx context.Context, params map[string]interface{}, user user.User) (chan PixFraud, chan Error.StarkErrors) {
+	//	Retrieve PixFraud structs
+	//
+	//	Same as Query, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.QueryCtx(ctx, params)
+}
+
+func (c Client) Query(params map[string]interface{}) (chan PixFraud, chan Error.StarkErrors) {
+	//	Retrieve PixFraud structs
+	return c.QueryCtx(context.Background(), params)
+}
+
+func (c Client) QueryCtx(ctx context.Context, params map[string]interface{}) (chan PixFraud, chan Error.StarkErrors) {
+	//	Retrieve PixFraud structs
 	frauds := make(chan PixFraud)
 	fraudsError := make(chan Error.StarkErrors)
-	query, errorChannel := utils.Query(resource, params, user)
+	query, errorChannel := c.Api.Stream(ctx, resource, params)
 	go func() {
+		defer close(fraudsError)
+		defer close(frauds)
 		for content := range query {
-			contentByte, _ := json.Marshal(content)
-			err := json.Unmarshal(contentByte, &pixFraud)
-			if err != nil {
-				fraudsError <- Error.UnknownError(err.Error())
+			var pixFraud PixFraud
+			err := c.Api.Unmarshal(content, &pixFraud)
+			if err.Errors != nil {
+				if !utils.SendError(ctx, fraudsError, err) {
+					return
+				}
 				continue
 			}
-			frauds <- pixFraud
+			select {
+			case frauds <- pixFraud:
+			case <-ctx.Done():
+				return
+			}
 		}
 		for err := range errorChannel {
-			fraudsError <- err
+			if !utils.SendError(ctx, fraudsError, err) {
+				return
+			}
 		}
-		close(frauds)
-		close(fraudsError)
 	}()
 	return frauds, fraudsError
 }
 
+//	PixFraud Iterator struct
+//
+//	Returned by Iterate to walk through PixFraud structs one at a time,
+//	requesting a new page only once the current one is exhausted. Check Err
+//	once Next returns false and Close it when leaving the loop early.
+
+type Iterator struct {
+	api   *utils.Client
+	pages *utils.Iterator
+	value PixFraud
+	err   Error.StarkErrors
+}
+
+func Iterate(params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve PixFraud structs
+	//
+	//	Same as Query, but returns an Iterator instead of a pair of channels
+	//
+	//	Example:
+	//	iterator := Iterate(params, nil)
+	//	defer iterator.Close()
+	//	for iterator.Next() {
+	//		fmt.Println(iterator.Value())
+	//	}
+	//	if err := iterator.Err(); err.Errors != nil {
+	//		...
+	//	}
+	return Client{Api: utils.Default(user)}.Iterate(params)
+}
+
+func IterateCtx(ctx context.Context, params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve PixFraud structs
+	//
+	//	Same as Iterate, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.IterateCtx(ctx, params)
+}
+
+func (c Client) Iterate(params map[string]interface{}) *Iterator {
+	//	Retrieve PixFraud structs
+	return c.IterateCtx(context.Background(), params)
+}
+
+func (c Client) IterateCtx(ctx context.Context, params map[string]interface{}) *Iterator {
+	//	Retrieve PixFraud structs
+	return &Iterator{api: c.Api, pages: c.Api.Iterate(ctx, resource, params)}
+}
+
+func (i *Iterator) Next() bool {
+	//	Move to the next PixFraud, returning false when there are no more or a request failed
+	var value PixFraud
+	if i.err.Errors != nil || !i.pages.Next() {
+		return false
+	}
+	i.err = i.api.Unmarshal(i.pages.Value(), &value)
+	if i.err.Errors != nil {
+		i.pages.Close()
+		return false
+	}
+	i.value = value
+	return true
+}
+
+func (i *Iterator) Value() PixFraud {
+	//	Current PixFraud, set by the last call to Next
+	return i.value
+}
+
+func (i *Iterator) Err() Error.StarkErrors {
+	//	Error that stopped the iteration, if any
+	if i.err.Errors != nil {
+		return i.err
+	}
+	return i.pages.Err()
+}
+
+func (i *Iterator) Close() {
+	//	Stop the iteration, releasing the current page
+	i.pages.Close()
+}
+
 func Page(params map[string]interface{}, user user.User) ([]PixFraud, string, Error.StarkErrors) {
 	//	Retrieve paged PixFraud structs.
 	//
@@ -155,12 +305,29 @@ func Page(params map[string]interface{}, user user.User) ([]PixFraud, string, Er
 	//	Return:
 	//	- slice of PixFraud structs with updated attributes
 	//  - Cursor to retrieve the next page of PixFraud structs
+	return Client{Api: utils.Default(user)}.Page(params)
+}
+
+func PageCtx(ctx context.Context, params map[string]interface{}, user user.User) ([]PixFraud, string, Error.StarkErrors) {
+	//	Retrieve paged PixFraud structs.
+	//
+	//	Same as Page, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.PageCtx(ctx, params)
+}
+
+func (c Client) Page(params map[string]interface{}) ([]PixFraud, string, Error.StarkErrors) {
+	//	Retrieve paged PixFraud structs.
+	return c.PageCtx(context.Background(), params)
+}
+
+func (c Client) PageCtx(ctx context.Context, params map[string]interface{}) ([]PixFraud, string, Error.StarkErrors) {
+	//	Retrieve paged PixFraud structs.
 	var pixFrauds []PixFraud
-	page, cursor, err := utils.Page(resource, params, user)
-	unmarshalError := json.Unmarshal(page, &pixFrauds)
-	if unmarshalError != nil {
+	page, cursor, err := c.Api.Page(ctx, resource, params)
+	if err.Errors != nil {
 		return pixFrauds, cursor, err
 	}
+	err = c.Api.Unmarshal(page, &pixFrauds)
 	return pixFrauds, cursor, err
 }
 
@@ -177,11 +344,28 @@ func Cancel(id string, user user.User) (PixFraud, Error.StarkErrors) {
 	//
 	//	Return:
 	//	- canceled PixFraud struct
+	return Client{Api: utils.Default(user)}.Cancel(id)
+}
+
+func CancelCtx(ctx context.Context, id string, user user.User) (PixFraud, Error.StarkErrors) {
+	//	Cancel a PixFraud entity
+	//
+	//	Same as Cancel, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.CancelCtx(ctx, id)
+}
+
+func (c Client) Cancel(id string) (PixFraud, Error.StarkErrors) {
+	//	Cancel a PixFraud entity
+	return c.CancelCtx(context.Background(), id)
+}
+
+func (c Client) CancelCtx(ctx context.Context, id string) (PixFraud, Error.StarkErrors) {
+	//	Cancel a PixFraud entity
 	var pixFraud PixFraud
-	deleted, err := utils.Delete(resource, id, user)
-	unmarshalError := json.Unmarshal(deleted, &pixFraud)
-	if unmarshalError != nil {
+	deleted, err := c.Api.Delete(ctx, resource, id)
+	if err.Errors != nil {
 		return pixFraud, err
 	}
+	err = c.Api.Unmarshal(deleted, &pixFraud)
 	return pixFraud, err
 }
@@ -0,0 +1,40 @@
+package pixfraud
+
+import (
+	"github.com/starkinfra/sdk-go/starkinfra/utils"
+	"time"
+)
+
+//	PixFraud QueryParams struct
+//
+//	Typed filters for Query, Iterate and Page. Fields left empty are not sent.
+//
+//	Attributes:
+//	- Limit [int, default nil]: Maximum number of structs to be retrieved. Unlimited if nil, except on Page, which returns up to 100. ex: 35
+//	- Cursor [string, default nil]: Cursor returned on the previous Page call. Only used by Page
+//	- After [time.Time, default nil]: Date filter for structs created only after specified date. ex: time.Date(2020, 3, 10, 0, 0, 0, 0, time.UTC)
+//	- Before [time.Time, default nil]: Date filter for structs created only before specified date. ex: time.Date(2022, 11, 10, 0, 0, 0, 0, time.UTC)
+//	- Status [slice of strings, default nil]: Filter for status of retrieved structs. ex: []string{"created", "failed", "registered", "canceled"}
+//	- Ids [slice of strings, default nil]: Slice of ids to filter retrieved structs. ex: []string{"5656565656565656", "4545454545454545"}
+//	- BacenId [string, default nil]: Unique transaction id returned from Central Bank. ex: "ccf9bd9c-e99d-999e-bab9-b999ca999f99"
+//	- Type [slice of strings, default nil]: Filter for the type of retrieved PixFrauds. Options: "reversal", "reversalChargeback"
+//	- Tags [slice of strings, default nil]: Slice of strings for tagging. ex: []string{"fraudulent"}
+//	- Flow [string, default nil]: Direction of the PixFraud flow. Options: "out" if you created the PixFraud, "in" if you received the PixFraud.
+
+type QueryParams struct {
+	Limit   int       `json:"limit,omitempty"`
+	Cursor  string    `json:"cursor,omitempty"`
+	After   time.Time `json:"after,omitempty"`
+	Before  time.Time `json:"before,omitempty"`
+	Status  []string  `json:"status,omitempty"`
+	Ids     []string  `json:"ids,omitempty"`
+	BacenId string    `json:"bacenId,omitempty"`
+	Type    []string  `json:"type,omitempty"`
+	Tags    []string  `json:"tags,omitempty"`
+	Flow    string    `json:"flow,omitempty"`
+}
+
+func (p QueryParams) Map() map[string]interface{} {
+	//	Convert the QueryParams into the params map taken by Query, Iterate and Page
+	return utils.ParamsMap(p)
+}
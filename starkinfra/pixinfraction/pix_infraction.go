@@ -1,6 +1,7 @@
 package pixinfraction
 
 import (
+	"context"
 	"encoding/json"
 	Error "github.com/starkinfra/core-go/starkcore/error"
 	"github.com/starkinfra/core-go/starkcore/user/user"
@@ -42,33 +43,44 @@ import (
 //	- Status [string]: Current PixInfraction status. Options: "created", "failed", "delivered", "closed", "canceled"
 //	- Created [time.Time]: Creation datetime for the PixInfraction. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC),
 //	- Updated [time.Time]: Latest update datetime for the PixInfraction. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC),
+//	- Extra [map[string]json.RawMessage]: Fields of the PixInfraction sent by the API but not declared in this SDK version yet, keyed by their JSON name. ex: map[string]json.RawMessage{"newField": json.RawMessage(`"value"`)}
 
 type PixInfraction struct {
-	ReferenceId      string     `json:",omitempty"`
-	Type             string     `json:",omitempty"`
-	Method           string     `json:",omitempty"`
-	OperatorEmail    string     `json:",omitempty"`
-	OperatorPhone    string     `json:",omitempty"`
-	Description      string     `json:",omitempty"`
-	Tags             []string   `json:",omitempty"`
-	FraudType        string     `json:",omitempty"`
-	Id               string     `json:",omitempty"`
-	FraudId          string     `json:",omitempty"`
-	CreditedBankCode string     `json:",omitempty"`
-	DebitedBankCode  string     `json:",omitempty"`
-	Flow             string     `json:",omitempty"`
-	Analysis         string     `json:",omitempty"`
-	ReportedBy       string     `json:",omitempty"`
-	Result           string     `json:",omitempty"`
-	Amount           int        `json:",omitempty"`
-	DisputeId        string     `json:",omitempty"`
-	Status           string     `json:",omitempty"`
-	Created          *time.Time `json:",omitempty"`
-	Updated          *time.Time `json:",omitempty"`
+	ReferenceId      string                     `json:",omitempty"`
+	Type             string                     `json:",omitempty"`
+	Method           string                     `json:",omitempty"`
+	OperatorEmail    string                     `json:",omitempty"`
+	OperatorPhone    string                     `json:",omitempty"`
+	Description      string                     `json:",omitempty"`
+	Tags             []string                   `json:",omitempty"`
+	FraudType        string                     `json:",omitempty"`
+	Id               string                     `json:",omitempty"`
+	FraudId          string                     `json:",omitempty"`
+	CreditedBankCode string                     `json:",omitempty"`
+	DebitedBankCode  string                     `json:",omitempty"`
+	Flow             string                     `json:",omitempty"`
+	Analysis         string                     `json:",omitempty"`
+	ReportedBy       string                     `json:",omitempty"`
+	Result           string                     `json:",omitempty"`
+	Amount           int                        `json:",omitempty"`
+	DisputeId        string                     `json:",omitempty"`
+	Status           string                     `json:",omitempty"`
+	Created          *time.Time                 `json:",omitempty"`
+	Updated          *time.Time                 `json:",omitempty"`
+	Extra            map[string]json.RawMessage `json:"-"`
 }
 
 var resource = map[string]string{"name": "PixInfraction"}
 
+//	PixInfraction Client struct
+//
+//	Runs the PixInfraction functions with the settings of its own utils.Client
+//	instead of starkinfra.User and the other package defaults.
+
+type Client struct {
+	Api *utils.Client
+}
+
 func Create(infractions []PixInfraction, user user.User) ([]PixInfraction, Error.StarkErrors) {
 	//	Create PixInfraction structs
 	//
@@ -83,6 +95,23 @@ func Create(infractions []PixInfraction, user user.User) ([]PixInfraction, Error
 	//	Return:
 	//	- slice of PixInfraction structs with updated attributes
 	//  Deprecated: Function deprecated since v1.1.0
+	return Client{Api: utils.Default(user)}.Create(infractions)
+}
+
+func CreateCtx(ctx context.Context, infractions []PixInfraction, user user.User) ([]PixInfraction, Error.StarkErrors) {
+	//	Create PixInfraction structs
+	//
+	//	Same as Create, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.CreateCtx(ctx, infractions)
+}
+
+func (c Client) Create(infractions []PixInfraction) ([]PixInfraction, Error.StarkErrors) {
+	//	Create PixInfraction structs
+	return c.CreateCtx(context.Background(), infractions)
+}
+
+func (c Client) CreateCtx(ctx context.Context, infractions []PixInfraction) ([]PixInfraction, Error.StarkErrors) {
+	//	Create PixInfraction structs
 	return nil, Error.UnknownError("Function deprecated since v1.1.0")
 }
 
@@ -99,12 +128,29 @@ func Get(id string, user user.User) (PixInfraction, Error.StarkErrors) {
 	//
 	//	Return:
 	//	- pixInfraction struct that corresponds to the given id.
+	return Client{Api: utils.Default(user)}.Get(id)
+}
+
+func GetCtx(ctx context.Context, id string, user user.User) (PixInfraction, Error.StarkErrors) {
+	//	Retrieve a PixInfraction struct
+	//
+	//	Same as Get, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.GetCtx(ctx, id)
+}
+
+func (c Client) Get(id string) (PixInfraction, Error.StarkErrors) {
+	//	Retrieve a PixInfraction struct
+	return c.GetCtx(context.Background(), id)
+}
+
+func (c Client) GetCtx(ctx context.Context, id string) (PixInfraction, Error.StarkErrors) {
+	//	Retrieve a PixInfraction struct
 	var pixInfraction PixInfraction
-	get, err := utils.Get(resource, id, nil, user)
-	unmarshalError := json.Unmarshal(get, &pixInfraction)
-	if unmarshalError != nil {
+	get, err := c.Api.Get(ctx, resource, id, nil)
+	if err.Errors != nil {
 		return pixInfraction, err
 	}
+	err = c.Api.Unmarshal(get, &pixInfraction)
 	return pixInfraction, err
 }
 
@@ -127,29 +173,133 @@ func Query(params map[string]interface{}, user user.User) (chan PixInfraction, c
 	//
 	//	Return:
 	//	- channel of PixInfraction structs with updated attributes
-	var pixInfraction PixInfraction
+	return Client{Api: utils.Default(user)}.Query(params)
+}
+
+func QueryCtx(ctx context.Context, params map[string]interface{}, user user.User) (chan PixInfraction, chan Error.StarkErrors) {
+	//	Retrieve PixInfraction structs
+	//
+	//	Same as Query, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.QueryCtx(ctx, params)
+}
+
+func (c Client) Query(params map[string]interface{}) (chan PixInfraction, chan Error.StarkErrors) {
+	//	Retrieve PixInfraction structs
+	return c.QueryCtx(context.Background(), params)
+}
+
+func (c Client) QueryCtx(ctx context.Context, params map[string]interface{}) (chan PixInfraction, chan Error.StarkErrors) {
+	//	Retrieve PixInfraction structs
 	infractions := make(chan PixInfraction)
 	infractionsError := make(chan Error.StarkErrors)
-	query, errorChannel := utils.Query(resource, params, user)
+	query, errorChannel := c.Api.Stream(ctx, resource, params)
 	go func() {
+		defer close(infractionsError)
+		defer close(infractions)
 		for content := range query {
-			contentByte, _ := json.Marshal(content)
-			err := json.Unmarshal(contentByte, &pixInfraction)
-			if err != nil {
-				infractionsError <- Error.UnknownError(err.Error())
+			var pixInfraction PixInfraction
+			err := c.Api.Unmarshal(content, &pixInfraction)
+			if err.Errors != nil {
+				if !utils.SendError(ctx, infractionsError, err) {
+					return
+				}
 				continue
 			}
-			infractions <- pixInfraction
+			select {
+			case infractions <- pixInfraction:
+			case <-ctx.Done():
+				return
+			}
 		}
 		for err := range errorChannel {
-			infractionsError <- err
+			if !utils.SendError(ctx, infractionsError, err) {
+				return
+			}
 		}
-		close(infractions)
-		close(infractionsError)
 	}()
 	return infractions, infractionsError
 }
 
+//	PixInfraction Iterator struct
+//
+//	Returned by Iterate to walk through PixInfraction structs one at a time,
+//	requesting a new page only once the current one is exhausted. Check Err
+//	once Next returns false and Close it when leaving the loop early.
+
+type Iterator struct {
+	api   *utils.Client
+	pages *utils.Iterator
+	value PixInfraction
+	err   Error.StarkErrors
+}
+
+func Iterate(params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve PixInfraction structs
+	//
+	//	Same as Query, but returns an Iterator instead of a pair of channels
+	//
+	//	Example:
+	//	iterator := Iterate(params, nil)
+	//	defer iterator.Close()
+	//	for iterator.Next() {
+	//		fmt.Println(iterator.Value())
+	//	}
+	//	if err := iterator.Err(); err.Errors != nil {
+	//		...
+	//	}
+	return Client{Api: utils.Default(user)}.Iterate(params)
+}
+
+func IterateCtx(ctx context.Context, params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve PixInfraction structs
+	//
+	//	Same as Iterate, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.IterateCtx(ctx, params)
+}
+
+func (c Client) Iterate(params map[string]interface{}) *Iterator {
+	//	Retrieve PixInfraction structs
+	return c.IterateCtx(context.Background(), params)
+}
+
+func (c Client) IterateCtx(ctx context.Context, params map[string]interface{}) *Iterator {
+	//	Retrieve PixInfraction structs
+	return &Iterator{api: c.Api, pages: c.Api.Iterate(ctx, resource, params)}
+}
+
+func (i *Iterator) Next() bool {
+	//	Move to the next PixInfraction, returning false when there are no more or a request failed
+	var value PixInfraction
+	if i.err.Errors != nil || !i.pages.Next() {
+		return false
+	}
+	i.err = i.api.Unmarshal(i.pages.Value(), &value)
+	if i.err.Errors != nil {
+		i.pages.Close()
+		return false
+	}
+	i.value = value
+	return true
+}
+
+func (i *Iterator) Value() PixInfraction {
+	//	Current PixInfraction, set by the last call to Next
+	return i.value
+}
+
+func (i *Iterator) Err() Error.StarkErrors {
+	//	Error that stopped the iteration, if any
+	if i.err.Errors != nil {
+		return i.err
+	}
+	return i.pages.Err()
+}
+
+func (i *Iterator) Close() {
+	//	Stop the iteration, releasing the current page
+	i.pages.Close()
+}
+
 func Page(params map[string]interface{}, user user.User) ([]PixInfraction, string, Error.StarkErrors) {
 	//	Retrieve paged PixInfraction structs.
 	//
@@ -172,12 +322,29 @@ func Page(params map[string]interface{}, user user.User) ([]PixInfraction, strin
 	//	Return:
 	//	- slice of PixInfraction structs with updated attributes
 	//  - Cursor to retrieve the next page of PixInfraction structs
+	return Client{Api: utils.Default(user)}.Page(params)
+}
+
+func PageCtx(ctx context.Context, params map[string]interface{}, user user.User) ([]PixInfraction, string, Error.StarkErrors) {
+	//	Retrieve paged PixInfraction structs.
+	//
+	//	Same as Page, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.PageCtx(ctx, params)
+}
+
+func (c Client) Page(params map[string]interface{}) ([]PixInfraction, string, Error.StarkErrors) {
+	//	Retrieve paged PixInfraction structs.
+	return c.PageCtx(context.Background(), params)
+}
+
+func (c Client) PageCtx(ctx context.Context, params map[string]interface{}) ([]PixInfraction, string, Error.StarkErrors) {
+	//	Retrieve paged PixInfraction structs.
 	var pixInfractions []PixInfraction
-	page, cursor, err := utils.Page(resource, params, user)
-	unmarshalError := json.Unmarshal(page, &pixInfractions)
-	if unmarshalError != nil {
+	page, cursor, err := c.Api.Page(ctx, resource, params)
+	if err.Errors != nil {
 		return pixInfractions, cursor, err
 	}
+	err = c.Api.Unmarshal(page, &pixInfractions)
 	return pixInfractions, cursor, err
 }
 
@@ -201,12 +368,29 @@ func Update(id string, patchData map[string]interface{}, user user.User) (PixInf
 	//
 	//	Return:
 	//	- pixInfraction with updated attributes
+	return Client{Api: utils.Default(user)}.Update(id, patchData)
+}
+
+func UpdateCtx(ctx context.Context, id string, patchData map[string]interface{}, user user.User) (PixInfraction, Error.StarkErrors) {
+	//	Update PixInfraction entity
+	//
+	//	Same as Update, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.UpdateCtx(ctx, id, patchData)
+}
+
+func (c Client) Update(id string, patchData map[string]interface{}) (PixInfraction, Error.StarkErrors) {
+	//	Update PixInfraction entity
+	return c.UpdateCtx(context.Background(), id, patchData)
+}
+
+func (c Client) UpdateCtx(ctx context.Context, id string, patchData map[string]interface{}) (PixInfraction, Error.StarkErrors) {
+	//	Update PixInfraction entity
 	var pixInfraction PixInfraction
-	update, err := utils.Patch(resource, id, patchData, user)
-	unmarshalError := json.Unmarshal(update, &pixInfraction)
-	if unmarshalError != nil {
+	update, err := c.Api.Patch(ctx, resource, id, patchData)
+	if err.Errors != nil {
 		return pixInfraction, err
 	}
+	err = c.Api.Unmarshal(update, &pixInfraction)
 	return pixInfraction, err
 }
 
@@ -223,11 +407,28 @@ func Cancel(id string, user user.User) (PixInfraction, Error.StarkErrors) {
 	//
 	//	Return:
 	//	- canceled PixInfraction struct
+	return Client{Api: utils.Default(user)}.Cancel(id)
+}
+
+func CancelCtx(ctx context.Context, id string, user user.User) (PixInfraction, Error.StarkErrors) {
+	//	Cancel a PixInfraction entity
+	//
+	//	Same as Cancel, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.CancelCtx(ctx, id)
+}
+
+func (c Client) Cancel(id string) (PixInfraction, Error.StarkErrors) {
+	//	Cancel a PixInfraction entity
+	return c.CancelCtx(context.Background(), id)
+}
+
+func (c Client) CancelCtx(ctx context.Context, id string) (PixInfraction, Error.StarkErrors) {
+	//	Cancel a PixInfraction entity
 	var pixInfraction PixInfraction
-	deleted, err := utils.Delete(resource, id, user)
-	unmarshalError := json.Unmarshal(deleted, &pixInfraction)
-	if unmarshalError != nil {
+	deleted, err := c.Api.Delete(ctx, resource, id)
+	if err.Errors != nil {
 		return pixInfraction, err
 	}
+	err = c.Api.Unmarshal(deleted, &pixInfraction)
 	return pixInfraction, err
 }
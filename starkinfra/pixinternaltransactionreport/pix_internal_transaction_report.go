@@ -1,6 +1,7 @@
 package pixinternaltransactionreport
 
 import (
+	"context"
 	"encoding/json"
 	Error "github.com/starkinfra/core-go/starkcore/error"
 	"github.com/starkinfra/core-go/starkcore/user/user"
@@ -42,32 +43,43 @@ import (
 //	- Id [string]: Unique id returned when the PixInternalTransactionReport is created. ex: "5656565656565656"
 //	- Status [string]: Current PixInternalTransactionReport status. ex: "created", "failed", "sent", "success"
 //	- Updated [time.Time]: Latest update datetime for the PixInternalTransactionReport. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC),
+//	- Extra [map[string]json.RawMessage]: Fields of the PixInternalTransactionReport sent by the API but not declared in this SDK version yet, keyed by their JSON name. ex: map[string]json.RawMessage{"newField": json.RawMessage(`"value"`)}
 
 type PixInternalTransactionReport struct {
-	Amount                int        `json:",omitempty"`
-	Created               *time.Time `json:",omitempty"`
-	EndToEndId            string     `json:",omitempty"`
-	Method                string     `json:",omitempty"`
-	ReferenceType         string     `json:",omitempty"`
-	SenderAccountNumber   string     `json:",omitempty"`
-	SenderBranchCode      string     `json:",omitempty"`
-	SenderAccountType     string     `json:",omitempty"`
-	SenderBankCode        string     `json:",omitempty"`
-	SenderTaxId           string     `json:",omitempty"`
-	ReceiverAccountNumber string     `json:",omitempty"`
-	ReceiverBranchCode    string     `json:",omitempty"`
-	ReceiverAccountType   string     `json:",omitempty"`
-	ReceiverBankCode      string     `json:",omitempty"`
-	ReceiverTaxId         string     `json:",omitempty"`
-	ReceiverKeyId         string     `json:",omitempty"`
-	ReturnId              string     `json:",omitempty"`
-	Id                    string     `json:",omitempty"`
-	Status                string     `json:",omitempty"`
-	Updated               *time.Time `json:",omitempty"`
+	Amount                int                        `json:",omitempty"`
+	Created               *time.Time                 `json:",omitempty"`
+	EndToEndId            string                     `json:",omitempty"`
+	Method                string                     `json:",omitempty"`
+	ReferenceType         string                     `json:",omitempty"`
+	SenderAccountNumber   string                     `json:",omitempty"`
+	SenderBranchCode      string                     `json:",omitempty"`
+	SenderAccountType     string                     `json:",omitempty"`
+	SenderBankCode        string                     `json:",omitempty"`
+	SenderTaxId           string                     `json:",omitempty"`
+	ReceiverAccountNumber string                     `json:",omitempty"`
+	ReceiverBranchCode    string                     `json:",omitempty"`
+	ReceiverAccountType   string                     `json:",omitempty"`
+	ReceiverBankCode      string                     `json:",omitempty"`
+	ReceiverTaxId         string                     `json:",omitempty"`
+	ReceiverKeyId         string                     `json:",omitempty"`
+	ReturnId              string                     `json:",omitempty"`
+	Id                    string                     `json:",omitempty"`
+	Status                string                     `json:",omitempty"`
+	Updated               *time.Time                 `json:",omitempty"`
+	Extra                 map[string]json.RawMessage `json:"-"`
 }
 
 var resource = map[string]string{"name": "PixInternalTransactionReport"}
 
+//	PixInternalTransactionReport Client struct
+//
+//	Runs the PixInternalTransactionReport functions with the settings of its own utils.Client
+//	instead of starkinfra.User and the other package defaults.
+
+type Client struct {
+	Api *utils.Client
+}
+
 func Create(reports []PixInternalTransactionReport, user user.User) ([]PixInternalTransactionReport, Error.StarkErrors) {
 	//	Create PixInternalTransactionReports
 	//
@@ -81,11 +93,28 @@ func Create(reports []PixInternalTransactionReport, user user.User) ([]PixIntern
 	//
 	//	Return:
 	//	- slice of PixInternalTransactionReport structs with updated attributes
-	create, err := utils.Multi(resource, reports, nil, user)
-	unmarshalError := json.Unmarshal(create, &reports)
-	if unmarshalError != nil {
+	return Client{Api: utils.Default(user)}.Create(reports)
+}
+
+func CreateCtx(ctx context.Context, reports []PixInternalTransactionReport, user user.User) ([]PixInternalTransactionReport, Error.StarkErrors) {
+	//	Create PixInternalTransactionReports
+	//
+	//	Same as Create, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.CreateCtx(ctx, reports)
+}
+
+func (c Client) Create(reports []PixInternalTransactionReport) ([]PixInternalTransactionReport, Error.StarkErrors) {
+	//	Create PixInternalTransactionReports
+	return c.CreateCtx(context.Background(), reports)
+}
+
+func (c Client) CreateCtx(ctx context.Context, reports []PixInternalTransactionReport) ([]PixInternalTransactionReport, Error.StarkErrors) {
+	//	Create PixInternalTransactionReports
+	create, err := c.Api.Multi(ctx, resource, reports, nil)
+	if err.Errors != nil {
 		return reports, err
 	}
+	err = c.Api.Unmarshal(create, &reports)
 	return reports, err
 }
 
@@ -102,12 +131,29 @@ func Get(id string, user user.User) (PixInternalTransactionReport, Error.StarkEr
 	//
 	//	Return:
 	//	- pixInternalTransactionReport struct with updated attributes
+	return Client{Api: utils.Default(user)}.Get(id)
+}
+
+func GetCtx(ctx context.Context, id string, user user.User) (PixInternalTransactionReport, Error.StarkErrors) {
+	//	Retrieve a specific PixInternalTransactionReport
+	//
+	//	Same as Get, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.GetCtx(ctx, id)
+}
+
+func (c Client) Get(id string) (PixInternalTransactionReport, Error.StarkErrors) {
+	//	Retrieve a specific PixInternalTransactionReport
+	return c.GetCtx(context.Background(), id)
+}
+
+func (c Client) GetCtx(ctx context.Context, id string) (PixInternalTransactionReport, Error.StarkErrors) {
+	//	Retrieve a specific PixInternalTransactionReport
 	var pixInternalTransactionReport PixInternalTransactionReport
-	get, err := utils.Get(resource, id, nil, user)
-	unmarshalError := json.Unmarshal(get, &pixInternalTransactionReport)
-	if unmarshalError != nil {
+	get, err := c.Api.Get(ctx, resource, id, nil)
+	if err.Errors != nil {
 		return pixInternalTransactionReport, err
 	}
+	err = c.Api.Unmarshal(get, &pixInternalTransactionReport)
 	return pixInternalTransactionReport, err
 }
 
@@ -127,29 +173,133 @@ func Query(params map[string]interface{}, user user.User) (chan PixInternalTrans
 	//
 	//	Return:
 	//	- channel of PixInternalTransactionReport structs with updated attributes
-	var pixInternalTransactionReport PixInternalTransactionReport
+	return Client{Api: utils.Default(user)}.Query(params)
+}
+
+func QueryCtx(ctx context.Context, params map[string]interface{}, user user.User) (chan PixInternalTransactionReport, chan Error.StarkErrors) {
+	//	Retrieve PixInternalTransactionReports
+	//
+	//	Same as Query, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.QueryCtx(ctx, params)
+}
+
+func (c Client) Query(params map[string]interface{}) (chan PixInternalTransactionReport, chan Error.StarkErrors) {
+	//	Retrieve PixInternalTransactionReports
+	return c.QueryCtx(context.Background(), params)
+}
+
+func (c Client) QueryCtx(ctx context.Context, params map[string]interface{}) (chan PixInternalTransactionReport, chan Error.StarkErrors) {
+	//	Retrieve PixInternalTransactionReports
 	reports := make(chan PixInternalTransactionReport)
 	reportsError := make(chan Error.StarkErrors)
-	query, errorChannel := utils.Query(resource, params, user)
+	query, errorChannel := c.Api.Stream(ctx, resource, params)
 	go func() {
+		defer close(reportsError)
+		defer close(reports)
 		for content := range query {
-			contentByte, _ := json.Marshal(content)
-			err := json.Unmarshal(contentByte, &pixInternalTransactionReport)
-			if err != nil {
-				reportsError <- Error.UnknownError(err.Error())
+			var pixInternalTransactionReport PixInternalTransactionReport
+			err := c.Api.Unmarshal(content, &pixInternalTransactionReport)
+			if err.Errors != nil {
+				if !utils.SendError(ctx, reportsError, err) {
+					return
+				}
 				continue
 			}
-			reports <- pixInternalTransactionReport
+			select {
+			case reports <- pixInternalTransactionReport:
+			case <-ctx.Done():
+				return
+			}
 		}
 		for err := range errorChannel {
-			reportsError <- err
+			if !utils.SendError(ctx, reportsError, err) {
+				return
+			}
 		}
-		close(reports)
-		close(reportsError)
 	}()
 	return reports, reportsError
 }
 
+//	PixInternalTransactionReport Iterator struct
+//
+//	Returned by Iterate to walk through PixInternalTransactionReport structs one at a time,
+//	requesting a new page only once the current one is exhausted. Check Err
+//	once Next returns false and Close it when leaving the loop early.
+
+type Iterator struct {
+	api   *utils.Client
+	pages *utils.Iterator
+	value PixInternalTransactionReport
+	err   Error.StarkErrors
+}
+
+func Iterate(params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve PixInternalTransactionReports
+	//
+	//	Same as Query, but returns an Iterator instead of a pair of channels
+	//
+	//	Example:
+	//	iterator := Iterate(params, nil)
+	//	defer iterator.Close()
+	//	for iterator.Next() {
+	//		fmt.Println(iterator.Value())
+	//	}
+	//	if err := iterator.Err(); err.Errors != nil {
+	//		...
+	//	}
+	return Client{Api: utils.Default(user)}.Iterate(params)
+}
+
+func IterateCtx(ctx context.Context, params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve PixInternalTransactionReports
+	//
+	//	Same as Iterate, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.IterateCtx(ctx, params)
+}
+
+func (c Client) Iterate(params map[string]interface{}) *Iterator {
+	//	Retrieve PixInternalTransactionReports
+	return c.IterateCtx(context.Background(), params)
+}
+
+func (c Client) IterateCtx(ctx context.Context, params map[string]interface{}) *Iterator {
+	//	Retrieve PixInternalTransactionReports
+	return &Iterator{api: c.Api, pages: c.Api.Iterate(ctx, resource, params)}
+}
+
+func (i *Iterator) Next() bool {
+	//	Move to the next PixInternalTransactionReport, returning false when there are no more or a request failed
+	var value PixInternalTransactionReport
+	if i.err.Errors != nil || !i.pages.Next() {
+		return false
+	}
+	i.err = i.api.Unmarshal(i.pages.Value(), &value)
+	if i.err.Errors != nil {
+		i.pages.Close()
+		return false
+	}
+	i.value = value
+	return true
+}
+
+func (i *Iterator) Value() PixInternalTransactionReport {
+	//	Current PixInternalTransactionReport, set by the last call to Next
+	return i.value
+}
+
+func (i *Iterator) Err() Error.StarkErrors {
+	//	Error that stopped the iteration, if any
+	if i.err.Errors != nil {
+		return i.err
+	}
+	return i.pages.Err()
+}
+
+func (i *Iterator) Close() {
+	//	Stop the iteration, releasing the current page
+	i.pages.Close()
+}
+
 func Page(params map[string]interface{}, user user.User) ([]PixInternalTransactionReport, string, Error.StarkErrors) {
 	//	Retrieve paged PixInternalTransactionReport structs
 	//
@@ -169,11 +319,28 @@ func Page(params map[string]interface{}, user user.User) ([]PixInternalTransacti
 	//	Return:
 	//	- slice of PixInternalTransactionReport structs with updated attributes
 	//	- cursor to retrieve the next page of PixInternalTransactionReport structs
+	return Client{Api: utils.Default(user)}.Page(params)
+}
+
+func PageCtx(ctx context.Context, params map[string]interface{}, user user.User) ([]PixInternalTransactionReport, string, Error.StarkErrors) {
+	//	Retrieve paged PixInternalTransactionReport structs
+	//
+	//	Same as Page, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.PageCtx(ctx, params)
+}
+
+func (c Client) Page(params map[string]interface{}) ([]PixInternalTransactionReport, string, Error.StarkErrors) {
+	//	Retrieve paged PixInternalTransactionReport structs
+	return c.PageCtx(context.Background(), params)
+}
+
+func (c Client) PageCtx(ctx context.Context, params map[string]interface{}) ([]PixInternalTransactionReport, string, Error.StarkErrors) {
+	//	Retrieve paged PixInternalTransactionReport structs
 	var pixInternalTransactionReports []PixInternalTransactionReport
-	page, cursor, err := utils.Page(resource, params, user)
-	unmarshalError := json.Unmarshal(page, &pixInternalTransactionReports)
-	if unmarshalError != nil {
+	page, cursor, err := c.Api.Page(ctx, resource, params)
+	if err.Errors != nil {
 		return pixInternalTransactionReports, cursor, err
 	}
+	err = c.Api.Unmarshal(page, &pixInternalTransactionReports)
 	return pixInternalTransactionReports, cursor, err
 }
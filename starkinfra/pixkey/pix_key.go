@@ -1,6 +1,7 @@
 package pixkey
 
 import (
+	"context"
 	"encoding/json"
 	Error "github.com/starkinfra/core-go/starkcore/error"
 	"github.com/starkinfra/core-go/starkcore/user/user"
@@ -36,27 +37,38 @@ import (
 //	- BankName [string]: Name of the bank that holds the account linked to the PixKey. ex: "StarkBank"
 //	- Type [string]: Type of the PixKey. Options: "cpf", "cnpj", "phone", "email" and "evp",
 //	- Created [time.Time]: Creation datetime for the PixKey. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC),
+//	- Extra [map[string]json.RawMessage]: Fields of the PixKey sent by the API but not declared in this SDK version yet, keyed by their JSON name. ex: map[string]json.RawMessage{"newField": json.RawMessage(`"value"`)}
 
 type PixKey struct {
-	AccountCreated *time.Time `json:",omitempty"`
-	AccountNumber  string     `json:",omitempty"`
-	AccountType    string     `json:",omitempty"`
-	BranchCode     string     `json:",omitempty"`
-	Name           string     `json:",omitempty"`
-	TaxId          string     `json:",omitempty"`
-	Id             string     `json:",omitempty"`
-	Tags           []string   `json:",omitempty"`
-	Owned          *time.Time `json:",omitempty"`
-	OwnerType      string     `json:",omitempty"`
-	Status         string     `json:",omitempty"`
-	BankCode       string     `json:",omitempty"`
-	BankName       string     `json:",omitempty"`
-	Type           string     `json:",omitempty"`
-	Created        *time.Time `json:",omitempty"`
+	AccountCreated *time.Time                 `json:",omitempty"`
+	AccountNumber  string                     `json:",omitempty"`
+	AccountType    string                     `json:",omitempty"`
+	BranchCode     string                     `json:",omitempty"`
+	Name           string                     `json:",omitempty"`
+	TaxId          string                     `json:",omitempty"`
+	Id             string                     `json:",omitempty"`
+	Tags           []string                   `json:",omitempty"`
+	Owned          *time.Time                 `json:",omitempty"`
+	OwnerType      string                     `json:",omitempty"`
+	Status         string                     `json:",omitempty"`
+	BankCode       string                     `json:",omitempty"`
+	BankName       string                     `json:",omitempty"`
+	Type           string                     `json:",omitempty"`
+	Created        *time.Time                 `json:",omitempty"`
+	Extra          map[string]json.RawMessage `json:"-"`
 }
 
 var resource = map[string]string{"name": "PixKey"}
 
+//	PixKey Client struct
+//
+//	Runs the PixKey functions with the settings of its own utils.Client
+//	instead of starkinfra.User and the other package defaults.
+
+type Client struct {
+	Api *utils.Client
+}
+
 func Create(key PixKey, user user.User) (PixKey, Error.StarkErrors) {
 	//	Create a PixKey struct
 	//
@@ -70,11 +82,28 @@ func Create(key PixKey, user user.User) (PixKey, Error.StarkErrors) {
 	//
 	//	Return:
 	//	- pixKey struct with updated attributes.
-	create, err := utils.Single(resource, key, user)
-	unmarshalError := json.Unmarshal(create, &key)
-	if unmarshalError != nil {
+	return Client{Api: utils.Default(user)}.Create(key)
+}
+
+func CreateCtx(ctx context.Context, key PixKey, user user.User) (PixKey, Error.StarkErrors) {
+	//	Create a PixKey struct
+	//
+	//	Same as Create, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.CreateCtx(ctx, key)
+}
+
+func (c Client) Create(key PixKey) (PixKey, Error.StarkErrors) {
+	//	Create a PixKey struct
+	return c.CreateCtx(context.Background(), key)
+}
+
+func (c Client) CreateCtx(ctx context.Context, key PixKey) (PixKey, Error.StarkErrors) {
+	//	Create a PixKey struct
+	create, err := c.Api.Single(ctx, resource, key)
+	if err.Errors != nil {
 		return key, err
 	}
+	err = c.Api.Unmarshal(create, &key)
 	return key, err
 }
 func Get(id string, query map[string]interface{}, user user.User) (PixKey, Error.StarkErrors) {
@@ -95,12 +124,29 @@ func Get(id string, query map[string]interface{}, user user.User) (PixKey, Error
 	//
 	//	Return:
 	//	- pixKey struct that corresponds to the given id.
+	return Client{Api: utils.Default(user)}.Get(id, query)
+}
+
+func GetCtx(ctx context.Context, id string, query map[string]interface{}, user user.User) (PixKey, Error.StarkErrors) {
+	//	Retrieve a PixKey struct
+	//
+	//	Same as Get, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.GetCtx(ctx, id, query)
+}
+
+func (c Client) Get(id string, query map[string]interface{}) (PixKey, Error.StarkErrors) {
+	//	Retrieve a PixKey struct
+	return c.GetCtx(context.Background(), id, query)
+}
+
+func (c Client) GetCtx(ctx context.Context, id string, query map[string]interface{}) (PixKey, Error.StarkErrors) {
+	//	Retrieve a PixKey struct
 	var pixKey PixKey
-	get, err := utils.Get(resource, id, query, user)
-	unmarshalError := json.Unmarshal(get, &pixKey)
-	if unmarshalError != nil {
+	get, err := c.Api.Get(ctx, resource, id, query)
+	if err.Errors != nil {
 		return pixKey, err
 	}
+	err = c.Api.Unmarshal(get, &pixKey)
 	return pixKey, err
 }
 
@@ -123,29 +169,133 @@ func Query(params map[string]interface{}, user user.User) (chan PixKey, chan Err
 	//
 	//	Return:
 	//	- channel of PixKey structs with updated attributes
-	var pixKey PixKey
+	return Client{Api: utils.Default(user)}.Query(params)
+}
+
+func QueryCtx(ctx context.Context, params map[string]interface{}, user user.User) (chan PixKey, chan Error.StarkErrors) {
+	//	Retrieve PixKey structs
+	//
+	//	Same as Query, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.QueryCtx(ctx, params)
+}
+
+func (c Client) Query(params map[string]interface{}) (chan PixKey, chan Error.StarkErrors) {
+	//	Retrieve PixKey structs
+	return c.QueryCtx(context.Background(), params)
+}
+
+func (c Client) QueryCtx(ctx context.Context, params map[string]interface{}) (chan PixKey, chan Error.StarkErrors) {
+	//	Retrieve PixKey structs
 	keys := make(chan PixKey)
 	keysError := make(chan Error.StarkErrors)
-	query, errorChannel := utils.Query(resource, params, user)
+	query, errorChannel := c.Api.Stream(ctx, resource, params)
 	go func() {
+		defer close(keysError)
+		defer close(keys)
 		for content := range query {
-			contentByte, _ := json.Marshal(content)
-			err := json.Unmarshal(contentByte, &pixKey)
-			if err != nil {
-				keysError <- Error.UnknownError(err.Error())
+			var pixKey PixKey
+			err := c.Api.Unmarshal(content, &pixKey)
+			if err.Errors != nil {
+				if !utils.SendError(ctx, keysError, err) {
+					return
+				}
 				continue
 			}
-			keys <- pixKey
+			select {
+			case keys <- pixKey:
+			case <-ctx.Done():
+				return
+			}
 		}
 		for err := range errorChannel {
-			keysError <- err
+			if !utils.SendError(ctx, keysError, err) {
+				return
+			}
 		}
-		close(keys)
-		close(keysError)
 	}()
 	return keys, keysError
 }
 
+//	PixKey Iterator struct
+//
+//	Returned by Iterate to walk through PixKey structs one at a time,
+//	requesting a new page only once the current one is exhausted. Check Err
+//	once Next returns false and Close it when leaving the loop early.
+
+type Iterator struct {
+	api   *utils.Client
+	pages *utils.Iterator
+	value PixKey
+	err   Error.StarkErrors
+}
+
+func Iterate(params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve PixKey structs
+	//
+	//	Same as Query, but returns an Iterator instead of a pair of channels
+	//
+	//	Example:
+	//	iterator := Iterate(params, nil)
+	//	defer iterator.Close()
+	//	for iterator.Next() {
+	//		fmt.Println(iterator.Value())
+	//	}
+	//	if err := iterator.Err(); err.Errors != nil {
+	//		...
+	//	}
+	return Client{Api: utils.Default(user)}.Iterate(params)
+}
+
+func IterateCtx(ctx context.Context, params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve PixKey structs
+	//
+	//	Same as Iterate, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.IterateCtx(ctx, params)
+}
+
+func (c Client) Iterate(params map[string]interface{}) *Iterator {
+	//	Retrieve PixKey structs
+	return c.IterateCtx(context.Background(), params)
+}
+
+func (c Client) IterateCtx(ctx context.Context, params map[string]interface{}) *Iterator {
+	//	Retrieve PixKey structs
+	return &Iterator{api: c.Api, pages: c.Api.Iterate(ctx, resource, params)}
+}
+
+func (i *Iterator) Next() bool {
+	//	Move to the next PixKey, returning false when there are no more or a request failed
+	var value PixKey
+	if i.err.Errors != nil || !i.pages.Next() {
+		return false
+	}
+	i.err = i.api.Unmarshal(i.pages.Value(), &value)
+	if i.err.Errors != nil {
+		i.pages.Close()
+		return false
+	}
+	i.value = value
+	return true
+}
+
+func (i *Iterator) Value() PixKey {
+	//	Current PixKey, set by the last call to Next
+	return i.value
+}
+
+func (i *Iterator) Err() Error.StarkErrors {
+	//	Error that stopped the iteration, if any
+	if i.err.Errors != nil {
+		return i.err
+	}
+	return i.pages.Err()
+}
+
+func (i *Iterator) Close() {
+	//	Stop the iteration, releasing the current page
+	i.pages.Close()
+}
+
 func Page(params map[string]interface{}, user user.User) ([]PixKey, string, Error.StarkErrors) {
 	//	Retrieve paged PixKey structs
 	//
@@ -168,12 +318,29 @@ func Page(params map[string]interface{}, user user.User) ([]PixKey, string, Erro
 	//	Return:
 	//	- slice of PixKey structs with updated attributes
 	//  - Cursor to retrieve the next page of PixKey structs
+	return Client{Api: utils.Default(user)}.Page(params)
+}
+
+func PageCtx(ctx context.Context, params map[string]interface{}, user user.User) ([]PixKey, string, Error.StarkErrors) {
+	//	Retrieve paged PixKey structs
+	//
+	//	Same as Page, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.PageCtx(ctx, params)
+}
+
+func (c Client) Page(params map[string]interface{}) ([]PixKey, string, Error.StarkErrors) {
+	//	Retrieve paged PixKey structs
+	return c.PageCtx(context.Background(), params)
+}
+
+func (c Client) PageCtx(ctx context.Context, params map[string]interface{}) ([]PixKey, string, Error.StarkErrors) {
+	//	Retrieve paged PixKey structs
 	var pixKeys []PixKey
-	page, cursor, err := utils.Page(resource, params, user)
-	unmarshalError := json.Unmarshal(page, &pixKeys)
-	if unmarshalError != nil {
+	page, cursor, err := c.Api.Page(ctx, resource, params)
+	if err.Errors != nil {
 		return pixKeys, cursor, err
 	}
+	err = c.Api.Unmarshal(page, &pixKeys)
 	return pixKeys, cursor, err
 }
 
@@ -199,12 +366,29 @@ func Update(id string, patchData map[string]interface{}, user user.User) (PixKey
 	//
 	//	Return:
 	//	- pixKey with updated attributes
+	return Client{Api: utils.Default(user)}.Update(id, patchData)
+}
+
+func UpdateCtx(ctx context.Context, id string, patchData map[string]interface{}, user user.User) (PixKey, Error.StarkErrors) {
+	//	Update PixKey entity
+	//
+	//	Same as Update, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.UpdateCtx(ctx, id, patchData)
+}
+
+func (c Client) Update(id string, patchData map[string]interface{}) (PixKey, Error.StarkErrors) {
+	//	Update PixKey entity
+	return c.UpdateCtx(context.Background(), id, patchData)
+}
+
+func (c Client) UpdateCtx(ctx context.Context, id string, patchData map[string]interface{}) (PixKey, Error.StarkErrors) {
+	//	Update PixKey entity
 	var pixKey PixKey
-	update, err := utils.Patch(resource, id, patchData, user)
-	unmarshalError := json.Unmarshal(update, &pixKey)
-	if unmarshalError != nil {
+	update, err := c.Api.Patch(ctx, resource, id, patchData)
+	if err.Errors != nil {
 		return pixKey, err
 	}
+	err = c.Api.Unmarshal(update, &pixKey)
 	return pixKey, err
 }
 
@@ -221,11 +405,28 @@ func Cancel(id string, user user.User) (PixKey, Error.StarkErrors) {
 	//
 	//	Return:
 	//	- canceled pixKey struct
+	return Client{Api: utils.Default(user)}.Cancel(id)
+}
+
+func CancelCtx(ctx context.Context, id string, user user.User) (PixKey, Error.StarkErrors) {
+	//	Cancel a PixKey entity
+	//
+	//	Same as Cancel, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.CancelCtx(ctx, id)
+}
+
+func (c Client) Cancel(id string) (PixKey, Error.StarkErrors) {
+	//	Cancel a PixKey entity
+	return c.CancelCtx(context.Background(), id)
+}
+
+func (c Client) CancelCtx(ctx context.Context, id string) (PixKey, Error.StarkErrors) {
+	//	Cancel a PixKey entity
 	var pixKey PixKey
-	deleted, err := utils.Delete(resource, id, user)
-	unmarshalError := json.Unmarshal(deleted, &pixKey)
-	if unmarshalError != nil {
+	deleted, err := c.Api.Delete(ctx, resource, id)
+	if err.Errors != nil {
 		return pixKey, err
 	}
+	err = c.Api.Unmarshal(deleted, &pixKey)
 	return pixKey, err
 }
@@ -1,6 +1,7 @@
 package pixkeyholmes
 
 import (
+	"context"
 	"encoding/json"
 	Error "github.com/starkinfra/core-go/starkcore/error"
 	"github.com/starkinfra/core-go/starkcore/user/user"
@@ -29,19 +30,30 @@ import (
 //	- Status [string]: Current status of the PixKeyHolmes. ex: "created", "solving", "solved", "failed"
 //	- Created [time.Time]: Creation datetime for the PixKeyHolmes. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC),
 //	- Updated [time.Time]: Latest update datetime for the PixKeyHolmes. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC),
+//	- Extra [map[string]json.RawMessage]: Fields of the PixKeyHolmes sent by the API but not declared in this SDK version yet, keyed by their JSON name. ex: map[string]json.RawMessage{"newField": json.RawMessage(`"value"`)}
 
 type PixKeyHolmes struct {
-	KeyId   string     `json:",omitempty"`
-	Tags    []string   `json:",omitempty"`
-	Id      string     `json:",omitempty"`
-	Result  string     `json:",omitempty"`
-	Status  string     `json:",omitempty"`
-	Created *time.Time `json:",omitempty"`
-	Updated *time.Time `json:",omitempty"`
+	KeyId   string                     `json:",omitempty"`
+	Tags    []string                   `json:",omitempty"`
+	Id      string                     `json:",omitempty"`
+	Result  string                     `json:",omitempty"`
+	Status  string                     `json:",omitempty"`
+	Created *time.Time                 `json:",omitempty"`
+	Updated *time.Time                 `json:",omitempty"`
+	Extra   map[string]json.RawMessage `json:"-"`
 }
 
 var resource = map[string]string{"name": "PixKeyHolmes"}
 
+//	PixKeyHolmes Client struct
+//
+//	Runs the PixKeyHolmes functions with the settings of its own utils.Client
+//	instead of starkinfra.User and the other package defaults.
+
+type Client struct {
+	Api *utils.Client
+}
+
 func Create(holmes []PixKeyHolmes, user user.User) ([]PixKeyHolmes, Error.StarkErrors) {
 	//	Create PixKeyHolmes
 	//
@@ -55,11 +67,28 @@ func Create(holmes []PixKeyHolmes, user user.User) ([]PixKeyHolmes, Error.StarkE
 	//
 	//	Return:
 	//	- Slice of PixKeyHolmes structs with updated attributes
-	create, err := utils.Multi(resource, holmes, nil, user)
-	unmarshalError := json.Unmarshal(create, &holmes)
-	if unmarshalError != nil {
+	return Client{Api: utils.Default(user)}.Create(holmes)
+}
+
+func CreateCtx(ctx context.Context, holmes []PixKeyHolmes, user user.User) ([]PixKeyHolmes, Error.StarkErrors) {
+	//	Create PixKeyHolmes
+	//
+	//	Same as Create, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.CreateCtx(ctx, holmes)
+}
+
+func (c Client) Create(holmes []PixKeyHolmes) ([]PixKeyHolmes, Error.StarkErrors) {
+	//	Create PixKeyHolmes
+	return c.CreateCtx(context.Background(), holmes)
+}
+
+func (c Client) CreateCtx(ctx context.Context, holmes []PixKeyHolmes) ([]PixKeyHolmes, Error.StarkErrors) {
+	//	Create PixKeyHolmes
+	create, err := c.Api.Multi(ctx, resource, holmes, nil)
+	if err.Errors != nil {
 		return holmes, err
 	}
+	err = c.Api.Unmarshal(create, &holmes)
 	return holmes, err
 }
 
@@ -80,29 +109,133 @@ func Query(params map[string]interface{}, user user.User) (chan PixKeyHolmes, ch
 	//
 	//	Return:
 	//	- Channel of PixKeyHolmes structs with updated attributes
-	var pixKeyHolmes PixKeyHolmes
+	return Client{Api: utils.Default(user)}.Query(params)
+}
+
+func QueryCtx(ctx context.Context, params map[string]interface{}, user user.User) (chan PixKeyHolmes, chan Error.StarkErrors) {
+	//	Retrieve PixKeyHolmes
+	//
+	//	Same as Query, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.QueryCtx(ctx, params)
+}
+
+func (c Client) Query(params map[string]interface{}) (chan PixKeyHolmes, chan Error.StarkErrors) {
+	//	Retrieve PixKeyHolmes
+	return c.QueryCtx(context.Background(), params)
+}
+
+func (c Client) QueryCtx(ctx context.Context, params map[string]interface{}) (chan PixKeyHolmes, chan Error.StarkErrors) {
+	//	Retrieve PixKeyHolmes
 	holmes := make(chan PixKeyHolmes)
 	holmesError := make(chan Error.StarkErrors)
-	query, errorChannel := utils.Query(resource, params, user)
+	query, errorChannel := c.Api.Stream(ctx, resource, params)
 	go func() {
+		defer close(holmesError)
+		defer close(holmes)
 		for content := range query {
-			contentByte, _ := json.Marshal(content)
-			err := json.Unmarshal(contentByte, &pixKeyHolmes)
-			if err != nil {
-				holmesError <- Error.UnknownError(err.Error())
+			var pixKeyHolmes PixKeyHolmes
+			err := c.Api.Unmarshal(content, &pixKeyHolmes)
+			if err.Errors != nil {
+				if !utils.SendError(ctx, holmesError, err) {
+					return
+				}
 				continue
 			}
-			holmes <- pixKeyHolmes
+			select {
+			case holmes <- pixKeyHolmes:
+			case <-ctx.Done():
+				return
+			}
 		}
 		for err := range errorChannel {
-			holmesError <- err
+			if !utils.SendError(ctx, holmesError, err) {
+				return
+			}
 		}
-		close(holmes)
-		close(holmesError)
 	}()
 	return holmes, holmesError
 }
 
+//	PixKeyHolmes Iterator struct
+//
+//	Returned by Iterate to walk through PixKeyHolmes structs one at a time,
+//	requesting a new page only once the current one is exhausted. Check Err
+//	once Next returns false and Close it when leaving the loop early.
+
+type Iterator struct {
+	api   *utils.Client
+	pages *utils.Iterator
+	value PixKeyHolmes
+	err   Error.StarkErrors
+}
+
+func Iterate(params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve PixKeyHolmes
+	//
+	//	Same as Query, but returns an Iterator instead of a pair of channels
+	//
+	//	Example:
+	//	iterator := Iterate(params, nil)
+	//	defer iterator.Close()
+	//	for iterator.Next() {
+	//		fmt.Println(iterator.Value())
+	//	}
+	//	if err := iterator.Err(); err.Errors != nil {
+	//		...
+	//	}
+	return Client{Api: utils.Default(user)}.Iterate(params)
+}
+
+func IterateCtx(ctx context.Context, params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve PixKeyHolmes
+	//
+	//	Same as Iterate, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.IterateCtx(ctx, params)
+}
+
+func (c Client) Iterate(params map[string]interface{}) *Iterator {
+	//	Retrieve PixKeyHolmes
+	return c.IterateCtx(context.Background(), params)
+}
+
+func (c Client) IterateCtx(ctx context.Context, params map[string]interface{}) *Iterator {
+	//	Retrieve PixKeyHolmes
+	return &Iterator{api: c.Api, pages: c.Api.Iterate(ctx, resource, params)}
+}
+
+func (i *Iterator) Next() bool {
+	//	Move to the next PixKeyHolmes, returning false when there are no more or a request failed
+	var value PixKeyHolmes
+	if i.err.Errors != nil || !i.pages.Next() {
+		return false
+	}
+	i.err = i.api.Unmarshal(i.pages.Value(), &value)
+	if i.err.Errors != nil {
+		i.pages.Close()
+		return false
+	}
+	i.value = value
+	return true
+}
+
+func (i *Iterator) Value() PixKeyHolmes {
+	//	Current PixKeyHolmes, set by the last call to Next
+	return i.value
+}
+
+func (i *Iterator) Err() Error.StarkErrors {
+	//	Error that stopped the iteration, if any
+	if i.err.Errors != nil {
+		return i.err
+	}
+	return i.pages.Err()
+}
+
+func (i *Iterator) Close() {
+	//	Stop the iteration, releasing the current page
+	i.pages.Close()
+}
+
 func Page(params map[string]interface{}, user user.User) ([]PixKeyHolmes, string, Error.StarkErrors) {
 	//	Retrieve paged PixKeyHolmes structs
 	//
@@ -123,11 +256,28 @@ func Page(params map[string]interface{}, user user.User) ([]PixKeyHolmes, string
 	//	Return:
 	//	- Slice of PixKeyHolmes structs with updated attributes
 	//	- Cursor to retrieve the next page of PixKeyHolmes structs
+	return Client{Api: utils.Default(user)}.Page(params)
+}
+
+func PageCtx(ctx context.Context, params map[string]interface{}, user user.User) ([]PixKeyHolmes, string, Error.StarkErrors) {
+	//	Retrieve paged PixKeyHolmes structs
+	//
+	//	Same as Page, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.PageCtx(ctx, params)
+}
+
+func (c Client) Page(params map[string]interface{}) ([]PixKeyHolmes, string, Error.StarkErrors) {
+	//	Retrieve paged PixKeyHolmes structs
+	return c.PageCtx(context.Background(), params)
+}
+
+func (c Client) PageCtx(ctx context.Context, params map[string]interface{}) ([]PixKeyHolmes, string, Error.StarkErrors) {
+	//	Retrieve paged PixKeyHolmes structs
 	var pixKeyHolmes []PixKeyHolmes
-	page, cursor, err := utils.Page(resource, params, user)
-	unmarshalError := json.Unmarshal(page, &pixKeyHolmes)
-	if unmarshalError != nil {
+	page, cursor, err := c.Api.Page(ctx, resource, params)
+	if err.Errors != nil {
 		return pixKeyHolmes, cursor, err
 	}
+	err = c.Api.Unmarshal(page, &pixKeyHolmes)
 	return pixKeyHolmes, cursor, err
 }
@@ -1,6 +1,7 @@
 package pixpullrequest
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	Error "github.com/starkinfra/core-go/starkcore/error"
@@ -47,36 +48,47 @@ import (
 //	- SenderFinalName [string]: Sender's final name.
 //	- SenderFinalTaxId [string]: CPF or CNPJ of the final sender, when different from the account holder.
 //	- SenderTaxId [string]: Sender's tax ID (CPF or CNPJ).
+//	- Extra [map[string]json.RawMessage]: Fields of the PixPullRequest sent by the API but not declared in this SDK version yet, keyed by their JSON name. ex: map[string]json.RawMessage{"newField": json.RawMessage(`"value"`)}
 
 type PixPullRequest struct {
-	Amount                int        `json:",omitempty"`
-	Due                   *time.Time `json:",omitempty"`
-	EndToEndId            string     `json:",omitempty"`
-	ReceiverAccountNumber string     `json:",omitempty"`
-	ReceiverAccountType   string     `json:",omitempty"`
-	ReceiverBankCode      string     `json:",omitempty"`
-	ReceiverName          string     `json:",omitempty"`
-	ReconciliationId      string     `json:",omitempty"`
-	SubscriptionId        string     `json:",omitempty"`
-	SubscriptionBacenId   string     `json:",omitempty"`
-	AttemptType           string     `json:",omitempty"`
-	Description           string     `json:",omitempty"`
-	ReceiverBranchCode    string     `json:",omitempty"`
-	Tags                  []string   `json:",omitempty"`
-	Id                    string     `json:",omitempty"`
-	Status                string     `json:",omitempty"`
-	Created               *time.Time `json:",omitempty"`
-	Updated               *time.Time `json:",omitempty"`
-	Flow                  string     `json:",omitempty"`
-	ReceiverTaxId         string     `json:",omitempty"`
-	SenderBankCode        string     `json:",omitempty"`
-	SenderFinalName       string     `json:",omitempty"`
-	SenderFinalTaxId      string     `json:",omitempty"`
-	SenderTaxId           string     `json:",omitempty"`
+	Amount                int                        `json:",omitempty"`
+	Due                   *time.Time                 `json:",omitempty"`
+	EndToEndId            string                     `json:",omitempty"`
+	ReceiverAccountNumber string                     `json:",omitempty"`
+	ReceiverAccountType   string                     `json:",omitempty"`
+	ReceiverBankCode      string                     `json:",omitempty"`
+	ReceiverName          string                     `json:",omitempty"`
+	ReconciliationId      string                     `json:",omitempty"`
+	SubscriptionId        string                     `json:",omitempty"`
+	SubscriptionBacenId   string                     `json:",omitempty"`
+	AttemptType           string                     `json:",omitempty"`
+	Description           string                     `json:",omitempty"`
+	ReceiverBranchCode    string                     `json:",omitempty"`
+	Tags                  []string                   `json:",omitempty"`
+	Id                    string                     `json:",omitempty"`
+	Status                string                     `json:",omitempty"`
+	Created               *time.Time                 `json:",omitempty"`
+	Updated               *time.Time                 `json:",omitempty"`
+	Flow                  string                     `json:",omitempty"`
+	ReceiverTaxId         string                     `json:",omitempty"`
+	SenderBankCode        string                     `json:",omitempty"`
+	SenderFinalName       string                     `json:",omitempty"`
+	SenderFinalTaxId      string                     `json:",omitempty"`
+	SenderTaxId           string                     `json:",omitempty"`
+	Extra                 map[string]json.RawMessage `json:"-"`
 }
 
 var resource = map[string]string{"name": "PixPullRequest"}
 
+//	PixPullRequest Client struct
+//
+//	Runs the PixPullRequest functions with the settings of its own utils.Client
+//	instead of starkinfra.User and the other package defaults.
+
+type Client struct {
+	Api *utils.Client
+}
+
 func Create(requests []PixPullRequest, user user.User) ([]PixPullRequest, Error.StarkErrors) {
 	//	Create PixPullRequests
 	//
@@ -90,11 +102,28 @@ func Create(requests []PixPullRequest, user user.User) ([]PixPullRequest, Error.
 	//
 	//	Return:
 	//	- slice of PixPullRequest structs with updated attributes
-	create, err := utils.Multi(resource, requests, nil, user)
-	unmarshalError := json.Unmarshal(create, &requests)
-	if unmarshalError != nil {
+	return Client{Api: utils.Default(user)}.Create(requests)
+}
+
+func CreateCtx(ctx context.Context, requests []PixPullRequest, user user.User) ([]PixPullRequest, Error.StarkErrors) {
+	//	Create PixPullRequests
+	//
+	//	Same as Create, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.CreateCtx(ctx, requests)
+}
+
+func (c Client) Create(requests []PixPullRequest) ([]PixPullRequest, Error.StarkErrors) {
+	//	Create PixPullRequests
+	return c.CreateCtx(context.Background(), requests)
+}
+
+func (c Client) CreateCtx(ctx context.Context, requests []PixPullRequest) ([]PixPullRequest, Error.StarkErrors) {
+	//	Create PixPullRequests
+	create, err := c.Api.Multi(ctx, resource, requests, nil)
+	if err.Errors != nil {
 		return requests, err
 	}
+	err = c.Api.Unmarshal(create, &requests)
 	return requests, err
 }
 
@@ -111,12 +140,29 @@ func Get(id string, user user.User) (PixPullRequest, Error.StarkErrors) {
 	//
 	//	Return:
 	//	- PixPullRequest struct with updated attributes
+	return Client{Api: utils.Default(user)}.Get(id)
+}
+
+func GetCtx(ctx context.Context, id string, user user.User) (PixPullRequest, Error.StarkErrors) {
+	//	Retrieve a specific PixPullRequest
+	//
+	//	Same as Get, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.GetCtx(ctx, id)
+}
+
+func (c Client) Get(id string) (PixPullRequest, Error.StarkErrors) {
+	//	Retrieve a specific PixPullRequest
+	return c.GetCtx(context.Background(), id)
+}
+
+func (c Client) GetCtx(ctx context.Context, id string) (PixPullRequest, Error.StarkErrors) {
+	//	Retrieve a specific PixPullRequest
 	var request PixPullRequest
-	get, err := utils.Get(resource, id, nil, user)
-	unmarshalError := json.Unmarshal(get, &request)
-	if unmarshalError != nil {
+	get, err := c.Api.Get(ctx, resource, id, nil)
+	if err.Errors != nil {
 		return request, err
 	}
+	err = c.Api.Unmarshal(get, &request)
 	return request, err
 }
 
@@ -139,29 +185,133 @@ func Query(params map[string]interface{}, user user.User) (chan PixPullRequest,
 	//
 	//	Return:
 	//	- channel of PixPullRequest structs with updated attributes
-	var request PixPullRequest
+	return Client{Api: utils.Default(user)}.Query(params)
+}
+
+func QueryCtx(ctx context.Context, params map[string]interface{}, user user.User) (chan PixPullRequest, chan Error.StarkErrors) {
+	//	Retrieve PixPullRequests
+	//
+	//	Same as Query, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.QueryCtx(ctx, params)
+}
+
+func (c Client) Query(params map[string]interface{}) (chan PixPullRequest, chan Error.StarkErrors) {
+	//	Retrieve PixPullRequests
+	return c.QueryCtx(context.Background(), params)
+}
+
+func (c Client) QueryCtx(ctx context.Context, params map[string]interface{}) (chan PixPullRequest, chan Error.StarkErrors) {
+	//	Retrieve PixPullRequests
 	requests := make(chan PixPullRequest)
 	requestsError := make(chan Error.StarkErrors)
-	query, errorChannel := utils.Query(resource, params, user)
+	query, errorChannel := c.Api.Stream(ctx, resource, params)
 	go func() {
+		defer close(requestsError)
+		defer close(requests)
 		for content := range query {
-			contentByte, _ := json.Marshal(content)
-			err := json.Unmarshal(contentByte, &request)
-			if err != nil {
-				requestsError <- Error.UnknownError(err.Error())
+			var request PixPullRequest
+			err := c.Api.Unmarshal(content, &request)
+			if err.Errors != nil {
+				if !utils.SendError(ctx, requestsError, err) {
+					return
+				}
 				continue
 			}
-			requests <- request
+			select {
+			case requests <- request:
+			case <-ctx.Done():
+				return
+			}
 		}
 		for err := range errorChannel {
-			requestsError <- err
+			if !utils.SendError(ctx, requestsError, err) {
+				return
+			}
 		}
-		close(requests)
-		close(requestsError)
 	}()
 	return requests, requestsError
 }
 
+//	PixPullRequest Iterator struct
+//
+//	Returned by Iterate to walk through PixPullRequest structs one at a time,
+//	requesting a new page only once the current one is exhausted. Check Err
+//	once Next returns false and Close it when leaving the loop early.
+
+type Iterator struct {
+	api   *utils.Client
+	pages *utils.Iterator
+	value PixPullRequest
+	err   Error.StarkErrors
+}
+
+func Iterate(params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve PixPullRequests
+	//
+	//	Same as Query, but returns an Iterator instead of a pair of channels
+	//
+	//	Example:
+	//	iterator := Iterate(params, nil)
+	//	defer iterator.Close()
+	//	for iterator.Next() {
+	//		fmt.Println(iterator.Value())
+	//	}
+	//	if err := iterator.Err(); err.Errors != nil {
+	//		...
+	//	}
+	return Client{Api: utils.Default(user)}.Iterate(params)
+}
+
+func IterateCtx(ctx context.Context, params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve PixPullRequests
+	//
+	//	Same as Iterate, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.IterateCtx(ctx, params)
+}
+
+func (c Client) Iterate(params map[string]interface{}) *Iterator {
+	//	Retrieve PixPullRequests
+	return c.IterateCtx(context.Background(), params)
+}
+
+func (c Client) IterateCtx(ctx context.Context, params map[string]interface{}) *Iterator {
+	//	Retrieve PixPullRequests
+	return &Iterator{api: c.Api, pages: c.Api.Iterate(ctx, resource, params)}
+}
+
+func (i *Iterator) Next() bool {
+	//	Move to the next PixPullRequest, returning false when there are no more or a request failed
+	var value PixPullRequest
+	if i.err.Errors != nil || !i.pages.Next() {
+		return false
+	}
+	i.err = i.api.Unmarshal(i.pages.Value(), &value)
+	if i.err.Errors != nil {
+		i.pages.Close()
+		return false
+	}
+	i.value = value
+	return true
+}
+
+func (i *Iterator) Value() PixPullRequest {
+	//	Current PixPullRequest, set by the last call to Next
+	return i.value
+}
+
+func (i *Iterator) Err() Error.StarkErrors {
+	//	Error that stopped the iteration, if any
+	if i.err.Errors != nil {
+		return i.err
+	}
+	return i.pages.Err()
+}
+
+func (i *Iterator) Close() {
+	//	Stop the iteration, releasing the current page
+	i.pages.Close()
+}
+
 func Page(params map[string]interface{}, user user.User) ([]PixPullRequest, string, Error.StarkErrors) {
 	//	Retrieve paged PixPullRequest structs
 	//
@@ -184,12 +334,29 @@ func Page(params map[string]interface{}, user user.User) ([]PixPullRequest, stri
 	//	Return:
 	//	- slice of PixPullRequest structs with updated attributes
 	//	- cursor to retrieve the next page of PixPullRequest structs
+	return Client{Api: utils.Default(user)}.Page(params)
+}
+
+func PageCtx(ctx context.Context, params map[string]interface{}, user user.User) ([]PixPullRequest, string, Error.StarkErrors) {
+	//	Retrieve paged PixPullRequest structs
+	//
+	//	Same as Page, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.PageCtx(ctx, params)
+}
+
+func (c Client) Page(params map[string]interface{}) ([]PixPullRequest, string, Error.StarkErrors) {
+	//	Retrieve paged PixPullRequest structs
+	return c.PageCtx(context.Background(), params)
+}
+
+func (c Client) PageCtx(ctx context.Context, params map[string]interface{}) ([]PixPullRequest, string, Error.StarkErrors) {
+	//	Retrieve paged PixPullRequest structs
 	var requests []PixPullRequest
-	page, cursor, err := utils.Page(resource, params, user)
-	unmarshalError := json.Unmarshal(page, &requests)
-	if unmarshalError != nil {
+	page, cursor, err := c.Api.Page(ctx, resource, params)
+	if err.Errors != nil {
 		return requests, cursor, err
 	}
+	err = c.Api.Unmarshal(page, &requests)
 	return requests, cursor, err
 }
 
@@ -208,12 +375,29 @@ func Update(id string, patchData map[string]interface{}, user user.User) (PixPul
 	//
 	//	Return:
 	//	- pixPullRequest with updated attributes
+	return Client{Api: utils.Default(user)}.Update(id, patchData)
+}
+
+func UpdateCtx(ctx context.Context, id string, patchData map[string]interface{}, user user.User) (PixPullRequest, Error.StarkErrors) {
+	//	Update PixPullRequests
+	//
+	//	Same as Update, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.UpdateCtx(ctx, id, patchData)
+}
+
+func (c Client) Update(id string, patchData map[string]interface{}) (PixPullRequest, Error.StarkErrors) {
+	//	Update PixPullRequests
+	return c.UpdateCtx(context.Background(), id, patchData)
+}
+
+func (c Client) UpdateCtx(ctx context.Context, id string, patchData map[string]interface{}) (PixPullRequest, Error.StarkErrors) {
+	//	Update PixPullRequests
 	var subscription PixPullRequest
-	update, err := utils.Patch(resource, id, patchData, user)
-	unmarshalError := json.Unmarshal(update, &subscription)
-	if unmarshalError != nil {
+	update, err := c.Api.Patch(ctx, resource, id, patchData)
+	if err.Errors != nil {
 		return subscription, err
 	}
+	err = c.Api.Unmarshal(update, &subscription)
 	return subscription, err
 }
 
@@ -234,23 +418,40 @@ func Cancel(id string, reason string, user user.User) (PixPullRequest, Error.Sta
 	//
 	//	Return:
 	//	- canceled pixPullRequest struct
+	return Client{Api: utils.Default(user)}.Cancel(id, reason)
+}
+
+func CancelCtx(ctx context.Context, id string, reason string, user user.User) (PixPullRequest, Error.StarkErrors) {
+	//	Cancel a PixPullRequest
+	//
+	//	Same as Cancel, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.CancelCtx(ctx, id, reason)
+}
+
+func (c Client) Cancel(id string, reason string) (PixPullRequest, Error.StarkErrors) {
+	//	Cancel a PixPullRequest
+	return c.CancelCtx(context.Background(), id, reason)
+}
+
+func (c Client) CancelCtx(ctx context.Context, id string, reason string) (PixPullRequest, Error.StarkErrors) {
+	//	Cancel a PixPullRequest
 	var request PixPullRequest
 	data := map[string]interface{}{}
 	path := fmt.Sprintf("%v/%v?reason=%v", api.Endpoint(resource), id, reason)
-	deleted, err := utils.DeleteRaw(path, user, "", true)
+	deleted, err := c.Api.DeleteRaw(ctx, path, "", true)
 	if err.Errors != nil {
 		return PixPullRequest{}, err
 	}
 
-	unmarshalError := json.Unmarshal(deleted.Content, &data)
-	if unmarshalError != nil {
-		return PixPullRequest{}, Error.UnknownError(string(deleted.Content))
+	unmarshalError := c.Api.Unmarshal(deleted.Content, &data)
+	if unmarshalError.Errors != nil {
+		return PixPullRequest{}, unmarshalError
 	}
 	jsonBytes, _ := json.Marshal(data[api.LastName(resource)])
 
-	unmarshalError = json.Unmarshal(jsonBytes, &request)
-	if unmarshalError != nil {
-		return PixPullRequest{}, Error.UnknownError(unmarshalError.Error())
+	unmarshalError = c.Api.Unmarshal(jsonBytes, &request)
+	if unmarshalError.Errors != nil {
+		return PixPullRequest{}, unmarshalError
 	}
 	return request, err
 }
@@ -0,0 +1,38 @@
+package pixpullrequest
+
+import (
+	"github.com/starkinfra/sdk-go/starkinfra/utils"
+	"time"
+)
+
+//	PixPullRequest QueryParams struct
+//
+//	Typed filters for Query, Iterate and Page. Fields left empty are not sent.
+//
+//	Attributes:
+//	- Limit [int, default nil]: Maximum number of structs to be retrieved. Unlimited if nil, except on Page, which returns up to 100. ex: 35
+//	- Cursor [string, default nil]: Cursor returned on the previous Page call. Only used by Page
+//	- After [time.Time, default nil]: Date filter for structs created only after specified date. ex: time.Date(2020, 3, 10, 0, 0, 0, 0, time.UTC)
+//	- Before [time.Time, default nil]: Date filter for structs created only before specified date. ex: time.Date(2022, 11, 10, 0, 0, 0, 0, time.UTC)
+//	- Status [slice of strings, default nil]: Filter for status of retrieved structs. ex: []string{"created", "active", "canceled", "failed"}
+//	- Tags [slice of strings, default nil]: Filter for structs with a given tag. ex: []string{"employees", "monthly"}
+//	- Ids [slice of strings, default nil]: Filter for structs with a given id. ex: []string{"5656565656565656", "4545454545454545"}
+//	- Flow [string, default nil]: String to filter Pix Pull Requests by the specific flow. Options: "in", "out"
+//	- SubscriptionIds [slice of strings, default nil]: Strings to filter Pix Pull Requets by the subscriptionIds. ex: []string{"5656565656565656", "4545454545454545"}
+
+type QueryParams struct {
+	Limit           int       `json:"limit,omitempty"`
+	Cursor          string    `json:"cursor,omitempty"`
+	After           time.Time `json:"after,omitempty"`
+	Before          time.Time `json:"before,omitempty"`
+	Status          []string  `json:"status,omitempty"`
+	Tags            []string  `json:"tags,omitempty"`
+	Ids             []string  `json:"ids,omitempty"`
+	Flow            string    `json:"flow,omitempty"`
+	SubscriptionIds []string  `json:"subscriptionIds,omitempty"`
+}
+
+func (p QueryParams) Map() map[string]interface{} {
+	//	Convert the QueryParams into the params map taken by Query, Iterate and Page
+	return utils.ParamsMap(p)
+}
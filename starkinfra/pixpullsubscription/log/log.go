@@ -1,6 +1,7 @@
 package log
 
 import (
+	"context"
 	"encoding/json"
 	Error "github.com/starkinfra/core-go/starkcore/error"
 	"github.com/starkinfra/core-go/starkcore/user/user"
@@ -20,6 +21,7 @@ import (
 //	- Type [string]: Type of the PixRequest event which triggered the log creation. ex: "sent", "denied", "failed", "created", "success", "approved", "credited", "refunded", "processing"
 //	- Errors [slice of strings]: Slice of errors linked to this PixRequest event
 //	- Created [time.Time]: Creation datetime for the log. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC),
+//	- Extra [map[string]json.RawMessage]: Fields of the PixPullSubscription.Log sent by the API but not declared in this SDK version yet, keyed by their JSON name. ex: map[string]json.RawMessage{"newField": json.RawMessage(`"value"`)}
 
 type Log struct {
 	Id           string                                  `json:",omitempty"`
@@ -27,10 +29,20 @@ type Log struct {
 	Type         string                                  `json:",omitempty"`
 	Errors       interface{}                             `json:",omitempty"`
 	Created      *time.Time                              `json:",omitempty"`
+	Extra        map[string]json.RawMessage              `json:"-"`
 }
 
 var resource = map[string]string{"name": "PixPullSubscriptionLog"}
 
+//	PixPullSubscription.Log Client struct
+//
+//	Runs the PixPullSubscription.Log functions with the settings of its own utils.Client
+//	instead of starkinfra.User and the other package defaults.
+
+type Client struct {
+	Api *utils.Client
+}
+
 func Get(id string, user user.User) (Log, Error.StarkErrors) {
 	//	Retrieve a specific PixPullSubscription.Log by its id
 	//
@@ -44,14 +56,31 @@ func Get(id string, user user.User) (Log, Error.StarkErrors) {
 	//
 	//	Return:
 	//	- pixPullSubscription.Log struct that corresponds to the given id.
+	return Client{Api: utils.Default(user)}.Get(id)
+}
+
+func GetCtx(ctx context.Context, id string, user user.User) (Log, Error.StarkErrors) {
+	//	Retrieve a specific PixPullSubscription.Log by its id
+	//
+	//	Same as Get, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.GetCtx(ctx, id)
+}
+
+func (c Client) Get(id string) (Log, Error.StarkErrors) {
+	//	Retrieve a specific PixPullSubscription.Log by its id
+	return c.GetCtx(context.Background(), id)
+}
+
+func (c Client) GetCtx(ctx context.Context, id string) (Log, Error.StarkErrors) {
+	//	Retrieve a specific PixPullSubscription.Log by its id
 	var pixPullSubscriptionLog Log
-	get, err := utils.Get(resource, id, nil, user)
+	get, err := c.Api.Get(ctx, resource, id, nil)
 	jsonStr := string(get)
 	jsonStr = utils.ReplaceEmptyStringField(jsonStr, `"due":""`, `"due":null`)
-	unmarshalError := json.Unmarshal([]byte(jsonStr), &pixPullSubscriptionLog)
-	if unmarshalError != nil {
+	if err.Errors != nil {
 		return pixPullSubscriptionLog, err
 	}
+	err = c.Api.Unmarshal([]byte(jsonStr), &pixPullSubscriptionLog)
 	return pixPullSubscriptionLog, err
 }
 
@@ -71,31 +100,137 @@ func Query(params map[string]interface{}, user user.User) (chan Log, chan Error.
 	//
 	//	Return:
 	//	- channel of PixPullSubscription.Log structs with updated attributes
-	var pixPullSubscriptionLog Log
+	return Client{Api: utils.Default(user)}.Query(params)
+}
+
+func QueryCtx(ctx context.Context, params map[string]interface{}, user user.User) (chan Log, chan Error.StarkErrors) {
+	//	Retrieve PixPullSubscription.Log structs
+	//
+	//	Same as Query, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.QueryCtx(ctx, params)
+}
+
+func (c Client) Query(params map[string]interface{}) (chan Log, chan Error.StarkErrors) {
+	//	Retrieve PixPullSubscription.Log structs
+	return c.QueryCtx(context.Background(), params)
+}
+
+func (c Client) QueryCtx(ctx context.Context, params map[string]interface{}) (chan Log, chan Error.StarkErrors) {
+	//	Retrieve PixPullSubscription.Log structs
 	logs := make(chan Log)
 	logsErrors := make(chan Error.StarkErrors)
-	query, errorChannel := utils.Query(resource, params, user)
+	query, errorChannel := c.Api.Stream(ctx, resource, params)
 	go func() {
+		defer close(logsErrors)
+		defer close(logs)
 		for content := range query {
-			contentByte, _ := json.Marshal(content)
-			jsonStr := string(contentByte)
+			var pixPullSubscriptionLog Log
+			jsonStr := string(content)
 			jsonStr = utils.ReplaceEmptyStringField(jsonStr, `"due":""`, `"due":null`)
-			err := json.Unmarshal([]byte(jsonStr), &pixPullSubscriptionLog)
-			if err != nil {
-				logsErrors <- Error.UnknownError(err.Error())
+			err := c.Api.Unmarshal([]byte(jsonStr), &pixPullSubscriptionLog)
+			if err.Errors != nil {
+				if !utils.SendError(ctx, logsErrors, err) {
+					return
+				}
 				continue
 			}
-			logs <- pixPullSubscriptionLog
+			select {
+			case logs <- pixPullSubscriptionLog:
+			case <-ctx.Done():
+				return
+			}
 		}
 		for err := range errorChannel {
-			logsErrors <- err
+			if !utils.SendError(ctx, logsErrors, err) {
+				return
+			}
 		}
-		close(logs)
-		close(logsErrors)
 	}()
 	return logs, logsErrors
 }
 
+//	PixPullSubscription.Log Iterator struct
+//
+//	Returned by Iterate to walk through PixPullSubscription.Log structs one at a time,
+//	requesting a new page only once the current one is exhausted. Check Err
+//	once Next returns false and Close it when leaving the loop early.
+
+type Iterator struct {
+	api   *utils.Client
+	pages *utils.Iterator
+	value Log
+	err   Error.StarkErrors
+}
+
+func Iterate(params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve PixPullSubscription.Log structs
+	//
+	//	Same as Query, but returns an Iterator instead of a pair of channels
+	//
+	//	Example:
+	//	iterator := Iterate(params, nil)
+	//	defer iterator.Close()
+	//	for iterator.Next() {
+	//		fmt.Println(iterator.Value())
+	//	}
+	//	if err := iterator.Err(); err.Errors != nil {
+	//		...
+	//	}
+	return Client{Api: utils.Default(user)}.Iterate(params)
+}
+
+func IterateCtx(ctx context.Context, params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve PixPullSubscription.Log structs
+	//
+	//	Same as Iterate, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.IterateCtx(ctx, params)
+}
+
+func (c Client) Iterate(params map[string]interface{}) *Iterator {
+	//	Retrieve PixPullSubscription.Log structs
+	return c.IterateCtx(context.Background(), params)
+}
+
+func (c Client) IterateCtx(ctx context.Context, params map[string]interface{}) *Iterator {
+	//	Retrieve PixPullSubscription.Log structs
+	return &Iterator{api: c.Api, pages: c.Api.Iterate(ctx, resource, params)}
+}
+
+func (i *Iterator) Next() bool {
+	//	Move to the next PixPullSubscription.Log, returning false when there are no more or a request failed
+	var value Log
+	if i.err.Errors != nil || !i.pages.Next() {
+		return false
+	}
+	jsonStr := string(i.pages.Value())
+	jsonStr = utils.ReplaceEmptyStringField(jsonStr, `"due":""`, `"due":null`)
+	i.err = i.api.Unmarshal([]byte(jsonStr), &value)
+	if i.err.Errors != nil {
+		i.pages.Close()
+		return false
+	}
+	i.value = value
+	return true
+}
+
+func (i *Iterator) Value() Log {
+	//	Current PixPullSubscription.Log, set by the last call to Next
+	return i.value
+}
+
+func (i *Iterator) Err() Error.StarkErrors {
+	//	Error that stopped the iteration, if any
+	if i.err.Errors != nil {
+		return i.err
+	}
+	return i.pages.Err()
+}
+
+func (i *Iterator) Close() {
+	//	Stop the iteration, releasing the current page
+	i.pages.Close()
+}
+
 func Page(params map[string]interface{}, user user.User) ([]Log, string, Error.StarkErrors) {
 	//	Retrieve paged PixPullSubscription.Log structs
 	//
@@ -115,13 +250,30 @@ func Page(params map[string]interface{}, user user.User) ([]Log, string, Error.S
 	//	Return:
 	//	- slice of PixPullSubscription.Log structs with updated attributes
 	//	- cursor to retrieve the next page of PixPullSubscription.Log structs
+	return Client{Api: utils.Default(user)}.Page(params)
+}
+
+func PageCtx(ctx context.Context, params map[string]interface{}, user user.User) ([]Log, string, Error.StarkErrors) {
+	//	Retrieve paged PixPullSubscription.Log structs
+	//
+	//	Same as Page, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.PageCtx(ctx, params)
+}
+
+func (c Client) Page(params map[string]interface{}) ([]Log, string, Error.StarkErrors) {
+	//	Retrieve paged PixPullSubscription.Log structs
+	return c.PageCtx(context.Background(), params)
+}
+
+func (c Client) PageCtx(ctx context.Context, params map[string]interface{}) ([]Log, string, Error.StarkErrors) {
+	//	Retrieve paged PixPullSubscription.Log structs
 	var pixPullSubscriptionLogs []Log
-	page, cursor, err := utils.Page(resource, params, user)
+	page, cursor, err := c.Api.Page(ctx, resource, params)
 	jsonStr := string(page)
 	jsonStr = utils.ReplaceEmptyStringField(jsonStr, `"due":""`, `"due":null`)
-	unmarshalError := json.Unmarshal([]byte(jsonStr), &pixPullSubscriptionLogs)
-	if unmarshalError != nil {
+	if err.Errors != nil {
 		return pixPullSubscriptionLogs, cursor, err
 	}
+	err = c.Api.Unmarshal([]byte(jsonStr), &pixPullSubscriptionLogs)
 	return pixPullSubscriptionLogs, cursor, err
 }
@@ -1,13 +1,14 @@
 package pixpullsubscription
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	Error "github.com/starkinfra/core-go/starkcore/error"
 	"github.com/starkinfra/core-go/starkcore/user/user"
 	"github.com/starkinfra/core-go/starkcore/utils/api"
 	"github.com/starkinfra/sdk-go/starkinfra/utils"
 	"time"
-	"fmt"
 )
 
 //	PixPullSubscription struct
@@ -51,40 +52,53 @@ import (
 //  - Created [time.Time]: Creation datetime for the PixPullSubscription. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC),
 //  - Updated [time.Time]: Latest update datetime for the PixPullSubscription. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC),
 //  - Flow [string]: Indicates the flow of the Pix Subscription. ex: "in" or "out"
+//
+//	Attributes (return-only):
+//	- Extra [map[string]json.RawMessage]: Fields of the PixPullSubscription sent by the API but not declared in this SDK version yet, keyed by their JSON name. ex: map[string]json.RawMessage{"newField": json.RawMessage(`"value"`)}
 
 type PixPullSubscription struct {
-	BacenId             string     `json:",omitempty"`
-	ExternalId          string     `json:",omitempty"`
-	InstallmentStart    *time.Time `json:",omitempty"`
-	Interval            string     `json:",omitempty"`
-	ReceiverName        string     `json:",omitempty"`
-	ReceiverTaxId       string     `json:",omitempty"`
-	SenderAccountNumber string     `json:",omitempty"`
-	SenderBankCode      string     `json:",omitempty"`
-	SenderBranchCode    string     `json:",omitempty"`
-	SenderTaxId         string     `json:",omitempty"`
-	Type                string     `json:",omitempty"`
-	Amount              int        `json:",omitempty"`
-	AmountMinLimit      int        `json:",omitempty"`
-	Description         string     `json:",omitempty"`
-	Due                 *time.Time `json:",omitempty"`
-	InstallmentEnd      *time.Time `json:",omitempty"`
-	ReceiverBankCode    string     `json:",omitempty"`
-	ReferenceCode       string     `json:",omitempty"`
-	PullRetryLimit      int        `json:",omitempty"`
-	SenderCityCode      string     `json:",omitempty"`
-	SenderFinalName     string     `json:",omitempty"`
-	SenderFinalTaxId    string     `json:",omitempty"`
-	Tags                []string   `json:",omitempty"`
-	Id                  string     `json:",omitempty"`
-	Status              string     `json:",omitempty"`
-	Created             *time.Time `json:",omitempty"`
-	Updated             *time.Time `json:",omitempty"`
-	Flow                string     `json:",omitempty"`
+	BacenId             string                     `json:",omitempty"`
+	ExternalId          string                     `json:",omitempty"`
+	InstallmentStart    *time.Time                 `json:",omitempty"`
+	Interval            string                     `json:",omitempty"`
+	ReceiverName        string                     `json:",omitempty"`
+	ReceiverTaxId       string                     `json:",omitempty"`
+	SenderAccountNumber string                     `json:",omitempty"`
+	SenderBankCode      string                     `json:",omitempty"`
+	SenderBranchCode    string                     `json:",omitempty"`
+	SenderTaxId         string                     `json:",omitempty"`
+	Type                string                     `json:",omitempty"`
+	Amount              int                        `json:",omitempty"`
+	AmountMinLimit      int                        `json:",omitempty"`
+	Description         string                     `json:",omitempty"`
+	Due                 *time.Time                 `json:",omitempty"`
+	InstallmentEnd      *time.Time                 `json:",omitempty"`
+	ReceiverBankCode    string                     `json:",omitempty"`
+	ReferenceCode       string                     `json:",omitempty"`
+	PullRetryLimit      int                        `json:",omitempty"`
+	SenderCityCode      string                     `json:",omitempty"`
+	SenderFinalName     string                     `json:",omitempty"`
+	SenderFinalTaxId    string                     `json:",omitempty"`
+	Tags                []string                   `json:",omitempty"`
+	Id                  string                     `json:",omitempty"`
+	Status              string                     `json:",omitempty"`
+	Created             *time.Time                 `json:",omitempty"`
+	Updated             *time.Time                 `json:",omitempty"`
+	Flow                string                     `json:",omitempty"`
+	Extra               map[string]json.RawMessage `json:"-"`
 }
 
 var resource = map[string]string{"name": "PixPullSubscription"}
 
+//	PixPullSubscription Client struct
+//
+//	Runs the PixPullSubscription functions with the settings of its own utils.Client
+//	instead of starkinfra.User and the other package defaults.
+
+type Client struct {
+	Api *utils.Client
+}
+
 func Create(subscriptions []PixPullSubscription, user user.User) ([]PixPullSubscription, Error.StarkErrors) {
 	//	Create PixPullSubscriptions
 	//
@@ -98,14 +112,31 @@ func Create(subscriptions []PixPullSubscription, user user.User) ([]PixPullSubsc
 	//
 	//	Return:
 	//	- slice of PixPullSubscription structs with updated attributes
-	create, err := utils.Multi(resource, subscriptions, nil, user)
+	return Client{Api: utils.Default(user)}.Create(subscriptions)
+}
+
+func CreateCtx(ctx context.Context, subscriptions []PixPullSubscription, user user.User) ([]PixPullSubscription, Error.StarkErrors) {
+	//	Create PixPullSubscriptions
+	//
+	//	Same as Create, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.CreateCtx(ctx, subscriptions)
+}
+
+func (c Client) Create(subscriptions []PixPullSubscription) ([]PixPullSubscription, Error.StarkErrors) {
+	//	Create PixPullSubscriptions
+	return c.CreateCtx(context.Background(), subscriptions)
+}
+
+func (c Client) CreateCtx(ctx context.Context, subscriptions []PixPullSubscription) ([]PixPullSubscription, Error.StarkErrors) {
+	//	Create PixPullSubscriptions
+	create, err := c.Api.Multi(ctx, resource, subscriptions, nil)
 	jsonStr := string(create)
 	jsonStr = utils.ReplaceEmptyStringField(jsonStr, `"due":""`, `"due":null`)
 	jsonStr = utils.ReplaceEmptyStringField(jsonStr, `"installmentEnd":""`, `"installmentEnd":null`)
-	unmarshalError := json.Unmarshal([]byte(jsonStr), &subscriptions)
-	if unmarshalError != nil {
+	if err.Errors != nil {
 		return subscriptions, err
 	}
+	err = c.Api.Unmarshal([]byte(jsonStr), &subscriptions)
 	return subscriptions, err
 }
 
@@ -122,15 +153,32 @@ func Get(id string, user user.User) (PixPullSubscription, Error.StarkErrors) {
 	//
 	//	Return:
 	//	- PixPullSubscription struct with updated attributes
+	return Client{Api: utils.Default(user)}.Get(id)
+}
+
+func GetCtx(ctx context.Context, id string, user user.User) (PixPullSubscription, Error.StarkErrors) {
+	//	Retrieve a specific PixPullSubscription
+	//
+	//	Same as Get, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.GetCtx(ctx, id)
+}
+
+func (c Client) Get(id string) (PixPullSubscription, Error.StarkErrors) {
+	//	Retrieve a specific PixPullSubscription
+	return c.GetCtx(context.Background(), id)
+}
+
+func (c Client) GetCtx(ctx context.Context, id string) (PixPullSubscription, Error.StarkErrors) {
+	//	Retrieve a specific PixPullSubscription
 	var subscription PixPullSubscription
-	get, err := utils.Get(resource, id, nil, user)
+	get, err := c.Api.Get(ctx, resource, id, nil)
 	jsonStr := string(get)
 	jsonStr = utils.ReplaceEmptyStringField(jsonStr, `"due":""`, `"due":null`)
 	jsonStr = utils.ReplaceEmptyStringField(jsonStr, `"installmentEnd":""`, `"installmentEnd":null`)
-	unmarshalError := json.Unmarshal([]byte(jsonStr), &subscription)
-	if unmarshalError != nil {
+	if err.Errors != nil {
 		return subscription, err
 	}
+	err = c.Api.Unmarshal([]byte(jsonStr), &subscription)
 	return subscription, err
 }
 
@@ -151,32 +199,139 @@ func Query(params map[string]interface{}, user user.User) (chan PixPullSubscript
 	//
 	//	Return:
 	//	- channel of PixPullSubscription structs with updated attributes
-	var subscription PixPullSubscription
+	return Client{Api: utils.Default(user)}.Query(params)
+}
+
+func QueryCtx(ctx context.Context, params map[string]interface{}, user user.User) (chan PixPullSubscription, chan Error.StarkErrors) {
+	//	Retrieve PixPullSubscriptions
+	//
+	//	Same as Query, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.QueryCtx(ctx, params)
+}
+
+func (c Client) Query(params map[string]interface{}) (chan PixPullSubscription, chan Error.StarkErrors) {
+	//	Retrieve PixPullSubscriptions
+	return c.QueryCtx(context.Background(), params)
+}
+
+func (c Client) QueryCtx(ctx context.Context, params map[string]interface{}) (chan PixPullSubscription, chan Error.StarkErrors) {
+	//	Retrieve PixPullSubscriptions
 	subscriptions := make(chan PixPullSubscription)
 	subscriptionsError := make(chan Error.StarkErrors)
-	query, errorChannel := utils.Query(resource, params, user)
+	query, errorChannel := c.Api.Stream(ctx, resource, params)
 	go func() {
+		defer close(subscriptionsError)
+		defer close(subscriptions)
 		for content := range query {
-			contentByte, _ := json.Marshal(content)
-			jsonStr := string(contentByte)
+			var subscription PixPullSubscription
+			jsonStr := string(content)
 			jsonStr = utils.ReplaceEmptyStringField(jsonStr, `"due":""`, `"due":null`)
 			jsonStr = utils.ReplaceEmptyStringField(jsonStr, `"installmentEnd":""`, `"installmentEnd":null`)
-			err := json.Unmarshal([]byte(jsonStr), &subscription)
-			if err != nil {
-				subscriptionsError <- Error.UnknownError(err.Error())
+			err := c.Api.Unmarshal([]byte(jsonStr), &subscription)
+			if err.Errors != nil {
+				if !utils.SendError(ctx, subscriptionsError, err) {
+					return
+				}
 				continue
 			}
-			subscriptions <- subscription
+			select {
+			case subscriptions <- subscription:
+			case <-ctx.Done():
+				return
+			}
 		}
 		for err := range errorChannel {
-			subscriptionsError <- err
+			if !utils.SendError(ctx, subscriptionsError, err) {
+				return
+			}
 		}
-		close(subscriptions)
-		close(subscriptionsError)
 	}()
 	return subscriptions, subscriptionsError
 }
 
+//	PixPullSubscription Iterator struct
+//
+//	Returned by Iterate to walk through PixPullSubscription structs one at a time,
+//	requesting a new page only once the current one is exhausted. Check Err
+//	once Next returns false and Close it when leaving the loop early.
+
+type Iterator struct {
+	api   *utils.Client
+	pages *utils.Iterator
+	value PixPullSubscription
+	err   Error.StarkErrors
+}
+
+func Iterate(params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve PixPullSubscriptions
+	//
+	//	Same as Query, but returns an Iterator instead of a pair of channels
+	//
+	//	Example:
+	//	iterator := Iterate(params, nil)
+	//	defer iterator.Close()
+	//	for iterator.Next() {
+	//		fmt.Println(iterator.Value())
+	//	}
+	//	if err := iterator.Err(); err.Errors != nil {
+	//		...
+	//	}
+	return Client{Api: utils.Default(user)}.Iterate(params)
+}
+
+func IterateCtx(ctx context.Context, params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve PixPullSubscriptions
+	//
+	//	Same as Iterate, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.IterateCtx(ctx, params)
+}
+
+func (c Client) Iterate(params map[string]interface{}) *Iterator {
+	//	Retrieve PixPullSubscriptions
+	return c.IterateCtx(context.Background(), params)
+}
+
+func (c Client) IterateCtx(ctx context.Context, params map[string]interface{}) *Iterator {
+	//	Retrieve PixPullSubscriptions
+	return &Iterator{api: c.Api, pages: c.Api.Iterate(ctx, resource, params)}
+}
+
+func (i *Iterator) Next() bool {
+	//	Move to the next PixPullSubscription, returning false when there are no more or a request failed
+	var value PixPullSubscription
+	if i.err.Errors != nil || !i.pages.Next() {
+		return false
+	}
+	jsonStr := string(i.pages.Value())
+	jsonStr = utils.ReplaceEmptyStringField(jsonStr, `"due":""`, `"due":null`)
+	jsonStr = utils.ReplaceEmptyStringField(jsonStr, `"installmentEnd":""`, `"installmentEnd":null`)
+	i.err = i.api.Unmarshal([]byte(jsonStr), &value)
+	if i.err.Errors != nil {
+		i.pages.Close()
+		return false
+	}
+	i.value = value
+	return true
+}
+
+func (i *Iterator) Value() PixPullSubscription {
+	//	Current PixPullSubscription, set by the last call to Next
+	return i.value
+}
+
+func (i *Iterator) Err() Error.StarkErrors {
+	//	Error that stopped the iteration, if any
+	if i.err.Errors != nil {
+		return i.err
+	}
+	return i.pages.Err()
+}
+
+func (i *Iterator) Close() {
+	//	Stop the iteration, releasing the current page
+	i.pages.Close()
+}
+
 func Page(params map[string]interface{}, user user.User) ([]PixPullSubscription, string, Error.StarkErrors) {
 	//	Retrieve paged PixPullSubscription structs
 	//
@@ -197,15 +352,32 @@ func Page(params map[string]interface{}, user user.User) ([]PixPullSubscription,
 	//	Return:
 	//	- slice of PixPullSubscription structs with updated attributes
 	//	- cursor to retrieve the next page of PixPullSubscription structs
+	return Client{Api: utils.Default(user)}.Page(params)
+}
+
+func PageCtx(ctx context.Context, params map[string]interface{}, user user.User) ([]PixPullSubscription, string, Error.StarkErrors) {
+	//	Retrieve paged PixPullSubscription structs
+	//
+	//	Same as Page, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.PageCtx(ctx, params)
+}
+
+func (c Client) Page(params map[string]interface{}) ([]PixPullSubscription, string, Error.StarkErrors) {
+	//	Retrieve paged PixPullSubscription structs
+	return c.PageCtx(context.Background(), params)
+}
+
+func (c Client) PageCtx(ctx context.Context, params map[string]interface{}) ([]PixPullSubscription, string, Error.StarkErrors) {
+	//	Retrieve paged PixPullSubscription structs
 	var subscriptions []PixPullSubscription
-	page, cursor, err := utils.Page(resource, params, user)
+	page, cursor, err := c.Api.Page(ctx, resource, params)
 	jsonStr := string(page)
 	jsonStr = utils.ReplaceEmptyStringField(jsonStr, `"due":""`, `"due":null`)
 	jsonStr = utils.ReplaceEmptyStringField(jsonStr, `"installmentEnd":""`, `"installmentEnd":null`)
-	unmarshalError := json.Unmarshal([]byte(jsonStr), &subscriptions)
-	if unmarshalError != nil {
+	if err.Errors != nil {
 		return subscriptions, cursor, err
 	}
+	err = c.Api.Unmarshal([]byte(jsonStr), &subscriptions)
 	return subscriptions, cursor, err
 }
 
@@ -227,15 +399,32 @@ func Update(id string, patchData map[string]interface{}, user user.User) (PixPul
 	//
 	//	Return:
 	//	- pixPullSubscription with updated attributes
+	return Client{Api: utils.Default(user)}.Update(id, patchData)
+}
+
+func UpdateCtx(ctx context.Context, id string, patchData map[string]interface{}, user user.User) (PixPullSubscription, Error.StarkErrors) {
+	//	Update PixPullSubscriptions
+	//
+	//	Same as Update, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.UpdateCtx(ctx, id, patchData)
+}
+
+func (c Client) Update(id string, patchData map[string]interface{}) (PixPullSubscription, Error.StarkErrors) {
+	//	Update PixPullSubscriptions
+	return c.UpdateCtx(context.Background(), id, patchData)
+}
+
+func (c Client) UpdateCtx(ctx context.Context, id string, patchData map[string]interface{}) (PixPullSubscription, Error.StarkErrors) {
+	//	Update PixPullSubscriptions
 	var subscription PixPullSubscription
-	update, err := utils.Patch(resource, id, patchData, user)
+	update, err := c.Api.Patch(ctx, resource, id, patchData)
 	jsonStr := string(update)
 	jsonStr = utils.ReplaceEmptyStringField(jsonStr, `"due":""`, `"due":null`)
 	jsonStr = utils.ReplaceEmptyStringField(jsonStr, `"installmentEnd":""`, `"installmentEnd":null`)
-	unmarshalError := json.Unmarshal([]byte(jsonStr), &subscription)
-	if unmarshalError != nil {
+	if err.Errors != nil {
 		return subscription, err
 	}
+	err = c.Api.Unmarshal([]byte(jsonStr), &subscription)
 	return subscription, err
 }
 
@@ -256,26 +445,43 @@ func Cancel(id string, reason string, user user.User) (PixPullSubscription, Erro
 	//
 	//	Return:
 	//	- canceled pixPullSubscription struct
+	return Client{Api: utils.Default(user)}.Cancel(id, reason)
+}
+
+func CancelCtx(ctx context.Context, id string, reason string, user user.User) (PixPullSubscription, Error.StarkErrors) {
+	//	Cancel a PixPullSubscription
+	//
+	//	Same as Cancel, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.CancelCtx(ctx, id, reason)
+}
+
+func (c Client) Cancel(id string, reason string) (PixPullSubscription, Error.StarkErrors) {
+	//	Cancel a PixPullSubscription
+	return c.CancelCtx(context.Background(), id, reason)
+}
+
+func (c Client) CancelCtx(ctx context.Context, id string, reason string) (PixPullSubscription, Error.StarkErrors) {
+	//	Cancel a PixPullSubscription
 	var subscription PixPullSubscription
 	data := map[string]interface{}{}
 	path := fmt.Sprintf("%v/%v?reason=%v", api.Endpoint(resource), id, reason)
-	deleted, err := utils.DeleteRaw(path, user, "", true)
+	deleted, err := c.Api.DeleteRaw(ctx, path, "", true)
 	if err.Errors != nil {
 		return PixPullSubscription{}, err
 	}
 
-	unmarshalError := json.Unmarshal(deleted.Content, &data)
-	if unmarshalError != nil {
-		return PixPullSubscription{}, Error.UnknownError(string(deleted.Content))
+	unmarshalError := c.Api.Unmarshal(deleted.Content, &data)
+	if unmarshalError.Errors != nil {
+		return PixPullSubscription{}, unmarshalError
 	}
 	jsonBytes, _ := json.Marshal(data[api.LastName(resource)])
 	jsonStr := string(jsonBytes)
 	jsonStr = utils.ReplaceEmptyStringField(jsonStr, `"due":""`, `"due":null`)
 	jsonStr = utils.ReplaceEmptyStringField(jsonStr, `"installmentEnd":""`, `"installmentEnd":null`)
 
-	unmarshalError = json.Unmarshal([]byte(jsonStr), &subscription)
-	if unmarshalError != nil {
-		return PixPullSubscription{}, Error.UnknownError(unmarshalError.Error())
+	unmarshalError = c.Api.Unmarshal([]byte(jsonStr), &subscription)
+	if unmarshalError.Errors != nil {
+		return PixPullSubscription{}, unmarshalError
 	}
 	return subscription, err
 }
@@ -0,0 +1,103 @@
+package pixrequest
+
+import (
+	"fmt"
+	Error "github.com/starkinfra/core-go/starkcore/error"
+	"github.com/starkinfra/sdk-go/starkinfra/utils"
+)
+
+//	PixRequest Authorization struct
+//
+//	Answer to an inbound PixRequest authorization request. Build it with Approve
+//	or Deny, and turn it into the JSON to be returned with Response, which
+//	refuses invalid answers before they reach the API.
+//
+//	Attributes:
+//	- Status [string]: Response to the authorization. ex: StatusApproved or StatusDenied
+//	- Reason [Reason]: Denial reason, required for denials and refused for approvals. ex: ReasonOrderRejected
+
+type Authorization struct {
+	Status string
+	Reason Reason
+}
+
+//	PixRequest authorization Reason
+//
+//	Reason for denying an inbound PixRequest, one of the Reason* constants.
+
+type Reason string
+
+const StatusApproved = "approved"
+const StatusDenied = "denied"
+
+const ReasonInvalidAccountNumber Reason = "invalidAccountNumber"
+const ReasonBlockedAccount Reason = "blockedAccount"
+const ReasonAccountClosed Reason = "accountClosed"
+const ReasonInvalidAccountType Reason = "invalidAccountType"
+const ReasonInvalidTransactionType Reason = "invalidTransactionType"
+const ReasonTaxIdMismatch Reason = "taxIdMismatch"
+const ReasonInvalidTaxId Reason = "invalidTaxId"
+const ReasonOrderRejected Reason = "orderRejected"
+const ReasonReversalTimeExpired Reason = "reversalTimeExpired"
+const ReasonSettlementFailed Reason = "settlementFailed"
+
+var reasons = map[Reason]bool{
+	ReasonInvalidAccountNumber:   true,
+	ReasonBlockedAccount:         true,
+	ReasonAccountClosed:          true,
+	ReasonInvalidAccountType:     true,
+	ReasonInvalidTransactionType: true,
+	ReasonTaxIdMismatch:          true,
+	ReasonInvalidTaxId:           true,
+	ReasonOrderRejected:          true,
+	ReasonReversalTimeExpired:    true,
+	ReasonSettlementFailed:       true,
+}
+
+func Approve() Authorization {
+	//	Approve an inbound PixRequest
+	return Authorization{Status: StatusApproved}
+}
+
+func Deny(reason Reason) Authorization {
+	//	Deny an inbound PixRequest
+	//
+	//	Parameters (required):
+	//	- reason [Reason]: Denial reason. ex: ReasonOrderRejected
+	return Authorization{Status: StatusDenied, Reason: reason}
+}
+
+func (a Authorization) Validate() Error.StarkErrors {
+	//	Check the Authorization before it is sent
+	//
+	//	Return:
+	//	- inputError if the Status is unknown, a denial has no known Reason or an approval has one
+	switch a.Status {
+	case StatusApproved:
+		if a.Reason != "" {
+			return utils.ValidationError(fmt.Sprintf("An approved PixRequest takes no reason, got %v", a.Reason))
+		}
+	case StatusDenied:
+		if !reasons[a.Reason] {
+			return utils.ValidationError(fmt.Sprintf("A denied PixRequest needs one of the Reason constants, got %q", a.Reason))
+		}
+	default:
+		return utils.ValidationError(fmt.Sprintf("The status of a PixRequest authorization must be %q or %q, got %q", StatusApproved, StatusDenied, a.Status))
+	}
+	return Error.StarkErrors{}
+}
+
+func (a Authorization) Response() (string, Error.StarkErrors) {
+	//	Build the JSON string that must be returned to us on the PixRequest request
+	//
+	//	Return:
+	//	- same JSON string as the Response function, or an inputError if Validate fails
+	if err := a.Validate(); err.Errors != nil {
+		return "", err
+	}
+	authorization := map[string]interface{}{"status": a.Status}
+	if a.Reason != "" {
+		authorization["reason"] = string(a.Reason)
+	}
+	return Response(authorization), Error.StarkErrors{}
+}
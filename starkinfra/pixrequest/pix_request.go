@@ -1,6 +1,7 @@
 package pixrequest
 
 import (
+	"context"
 	"encoding/json"
 	Error "github.com/starkinfra/core-go/starkcore/error"
 	"github.com/starkinfra/core-go/starkcore/user/user"
@@ -53,44 +54,55 @@ import (
 //	- SenderBankCode [string]: Sender's bank institution code in Brazil. ex: "20018183"
 //	- Created [time.Time]: Creation datetime for the PixRequest. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC),
 //	- Updated [time.Time]: Latest update datetime for the PixRequest. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC),
+//	- Extra [map[string]json.RawMessage]: Fields of the PixRequest sent by the API but not declared in this SDK version yet, keyed by their JSON name. ex: map[string]json.RawMessage{"newField": json.RawMessage(`"value"`)}
 
 type PixRequest struct {
-	Amount                int        `json:",omitempty"`
-	ExternalId            string     `json:",omitempty"`
-	SenderName            string     `json:",omitempty"`
-	SenderTaxId           string     `json:",omitempty"`
-	SenderBranchCode      string     `json:",omitempty"`
-	SenderAccountNumber   string     `json:",omitempty"`
-	SenderAccountType     string     `json:",omitempty"`
-	ReceiverName          string     `json:",omitempty"`
-	ReceiverTaxId         string     `json:",omitempty"`
-	ReceiverBankCode      string     `json:",omitempty"`
-	ReceiverAccountNumber string     `json:",omitempty"`
-	ReceiverBranchCode    string     `json:",omitempty"`
-	ReceiverAccountType   string     `json:",omitempty"`
-	EndToEndId            string     `json:",omitempty"`
-	ReceiverKeyId         string     `json:",omitempty"`
-	Description           string     `json:",omitempty"`
-	ReconciliationId      string     `json:",omitempty"`
-	InitiatorTaxId        string     `json:",omitempty"`
-	CashAmount            int        `json:",omitempty"`
-	CashierBankCode       string     `json:",omitempty"`
-	CashierType           string     `json:",omitempty"`
-	Tags                  []string   `json:",omitempty"`
-	Method                string     `json:",omitempty"`
-	Reason                string     `json:",omitempty"`
-	Priority              string     `json:",omitempty"`
-	Id                    string     `json:",omitempty"`
-	Fee                   int        `json:",omitempty"`
-	Status                string     `json:",omitempty"`
-	Flow                  string     `json:",omitempty"`
-	SenderBankCode        string     `json:",omitempty"`
-	Created               *time.Time `json:",omitempty"`
-	Updated               *time.Time `json:",omitempty"`
+	Amount                int                        `json:",omitempty"`
+	ExternalId            string                     `json:",omitempty"`
+	SenderName            string                     `json:",omitempty"`
+	SenderTaxId           string                     `json:",omitempty"`
+	SenderBranchCode      string                     `json:",omitempty"`
+	SenderAccountNumber   string                     `json:",omitempty"`
+	SenderAccountType     string                     `json:",omitempty"`
+	ReceiverName          string                     `json:",omitempty"`
+	ReceiverTaxId         string                     `json:",omitempty"`
+	ReceiverBankCode      string                     `json:",omitempty"`
+	ReceiverAccountNumber string                     `json:",omitempty"`
+	ReceiverBranchCode    string                     `json:",omitempty"`
+	ReceiverAccountType   string                     `json:",omitempty"`
+	EndToEndId            string                     `json:",omitempty"`
+	ReceiverKeyId         string                     `json:",omitempty"`
+	Description           string                     `json:",omitempty"`
+	ReconciliationId      string                     `json:",omitempty"`
+	InitiatorTaxId        string                     `json:",omitempty"`
+	CashAmount            int                        `json:",omitempty"`
+	CashierBankCode       string                     `json:",omitempty"`
+	CashierType           string                     `json:",omitempty"`
+	Tags                  []string                   `json:",omitempty"`
+	Method                string                     `json:",omitempty"`
+	Reason                string                     `json:",omitempty"`
+	Priority              string                     `json:",omitempty"`
+	Id                    string                     `json:",omitempty"`
+	Fee                   int                        `json:",omitempty"`
+	Status                string                     `json:",omitempty"`
+	Flow                  string                     `json:",omitempty"`
+	SenderBankCode        string                     `json:",omitempty"`
+	Created               *time.Time                 `json:",omitempty"`
+	Updated               *time.Time                 `json:",omitempty"`
+	Extra                 map[string]json.RawMessage `json:"-"`
 }
 
 var resource = map[string]string{"name": "PixRequest"}
 
+//	PixRequest Client struct
+//
+//	Runs the PixRequest functions with the settings of its own utils.Client
+//	instead of starkinfra.User and the other package defaults.
+
+type Client struct {
+	Api *utils.Client
+}
+
 func Create(requests []PixRequest, user user.User) ([]PixRequest, Error.StarkErrors) {
 	//	Create PixRequests
 	//
@@ -104,11 +116,28 @@ func Create(requests []PixRequest, user user.User) ([]PixRequest, Error.StarkErr
 	//
 	//	Return:
 	//	- slice of PixRequest structs with updated attributes
-	create, err := utils.Multi(resource, requests, nil, user)
-	unmarshalError := json.Unmarshal(create, &requests)
-	if unmarshalError != nil {
+	return Client{Api: utils.Default(user)}.Create(requests)
+}
+
+func CreateCtx(ctx context.Context, requests []PixRequest, user user.User) ([]PixRequest, Error.StarkErrors) {
+	//	Create PixRequests
+	//
+	//	Same as Create, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.CreateCtx(ctx, requests)
+}
+
+func (c Client) Create(requests []PixRequest) ([]PixRequest, Error.StarkErrors) {
+	//	Create PixRequests
+	return c.CreateCtx(context.Background(), requests)
+}
+
+func (c Client) CreateCtx(ctx context.Context, requests []PixRequest) ([]PixRequest, Error.StarkErrors) {
+	//	Create PixRequests
+	create, err := c.Api.Multi(ctx, resource, requests, nil)
+	if err.Errors != nil {
 		return requests, err
 	}
+	err = c.Api.Unmarshal(create, &requests)
 	return requests, err
 }
 
@@ -125,12 +154,29 @@ func Get(id string, user user.User) (PixRequest, Error.StarkErrors) {
 	//
 	//	Return:
 	//	- pixRequest struct with updated attributes
+	return Client{Api: utils.Default(user)}.Get(id)
+}
+
+func GetCtx(ctx context.Context, id string, user user.User) (PixRequest, Error.StarkErrors) {
+	//	Retrieve a specific PixRequest
+	//
+	//	Same as Get, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.GetCtx(ctx, id)
+}
+
+func (c Client) Get(id string) (PixRequest, Error.StarkErrors) {
+	//	Retrieve a specific PixRequest
+	return c.GetCtx(context.Background(), id)
+}
+
+func (c Client) GetCtx(ctx context.Context, id string) (PixRequest, Error.StarkErrors) {
+	//	Retrieve a specific PixRequest
 	var pixRequest PixRequest
-	get, err := utils.Get(resource, id, nil, user)
-	unmarshalError := json.Unmarshal(get, &pixRequest)
-	if unmarshalError != nil {
+	get, err := c.Api.Get(ctx, resource, id, nil)
+	if err.Errors != nil {
 		return pixRequest, err
 	}
+	err = c.Api.Unmarshal(get, &pixRequest)
 	return pixRequest, err
 }
 
@@ -153,29 +199,133 @@ func Query(params map[string]interface{}, user user.User) (chan PixRequest, chan
 	//
 	//	Return:
 	//	- channel of PixRequest structs with updated attributes
-	var pixRequest PixRequest
+	return Client{Api: utils.Default(user)}.Query(params)
+}
+
+func QueryCtx(ctx context.Context, params map[string]interface{}, user user.User) (chan PixRequest, chan Error.StarkErrors) {
+	//	Retrieve PixRequests
+	//
+	//	Same as Query, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.QueryCtx(ctx, params)
+}
+
+func (c Client) Query(params map[string]interface{}) (chan PixRequest, chan Error.StarkErrors) {
+	//	Retrieve PixRequests
+	return c.QueryCtx(context.Background(), params)
+}
+
+func (c Client) QueryCtx(ctx context.Context, params map[string]interface{}) (chan PixRequest, chan Error.StarkErrors) {
+	//	Retrieve PixRequests
 	requests := make(chan PixRequest)
 	requestsError := make(chan Error.StarkErrors)
-	query, errorChannel := utils.Query(resource, params, user)
+	query, errorChannel := c.Api.Stream(ctx, resource, params)
 	go func() {
+		defer close(requestsError)
+		defer close(requests)
 		for content := range query {
-			contentByte, _ := json.Marshal(content)
-			err := json.Unmarshal(contentByte, &pixRequest)
-			if err != nil {
-				requestsError <- Error.UnknownError(err.Error())
+			var pixRequest PixRequest
+			err := c.Api.Unmarshal(content, &pixRequest)
+			if err.Errors != nil {
+				if !utils.SendError(ctx, requestsError, err) {
+					return
+				}
 				continue
 			}
-			requests <- pixRequest
+			select {
+			case requests <- pixRequest:
+			case <-ctx.Done():
+				return
+			}
 		}
 		for err := range errorChannel {
-			requestsError <- err
+			if !utils.SendError(ctx, requestsError, err) {
+				return
+			}
 		}
-		close(requests)
-		close(requestsError)
 	}()
 	return requests, requestsError
 }
 
+//	PixRequest Iterator struct
+//
+//	Returned by Iterate to walk through PixRequest structs one at a time,
+//	requesting a new page only once the current one is exhausted. Check Err
+//	once Next returns false and Close it when leaving the loop early.
+
+type Iterator struct {
+	api   *utils.Client
+	pages *utils.Iterator
+	value PixRequest
+	err   Error.StarkErrors
+}
+
+func Iterate(params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve PixRequests
+	//
+	//	Same as Query, but returns an Iterator instead of a pair of channels
+	//
+	//	Example:
+	//	iterator := Iterate(params, nil)
+	//	defer iterator.Close()
+	//	for iterator.Next() {
+	//		fmt.Println(iterator.Value())
+	//	}
+	//	if err := iterator.Err(); err.Errors != nil {
+	//		...
+	//	}
+	return Client{Api: utils.Default(user)}.Iterate(params)
+}
+
+func IterateCtx(ctx context.Context, params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve PixRequests
+	//
+	//	Same as Iterate, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.IterateCtx(ctx, params)
+}
+
+func (c Client) Iterate(params map[string]interface{}) *Iterator {
+	//	Retrieve PixRequests
+	return c.IterateCtx(context.Background(), params)
+}
+
+func (c Client) IterateCtx(ctx context.Context, params map[string]interface{}) *Iterator {
+	//	Retrieve PixRequests
+	return &Iterator{api: c.Api, pages: c.Api.Iterate(ctx, resource, params)}
+}
+
+func (i *Iterator) Next() bool {
+	//	Move to the next PixRequest, returning false when there are no more or a request failed
+	var value PixRequest
+	if i.err.Errors != nil || !i.pages.Next() {
+		return false
+	}
+	i.err = i.api.Unmarshal(i.pages.Value(), &value)
+	if i.err.Errors != nil {
+		i.pages.Close()
+		return false
+	}
+	i.value = value
+	return true
+}
+
+func (i *Iterator) Value() PixRequest {
+	//	Current PixRequest, set by the last call to Next
+	return i.value
+}
+
+func (i *Iterator) Err() Error.StarkErrors {
+	//	Error that stopped the iteration, if any
+	if i.err.Errors != nil {
+		return i.err
+	}
+	return i.pages.Err()
+}
+
+func (i *Iterator) Close() {
+	//	Stop the iteration, releasing the current page
+	i.pages.Close()
+}
+
 func Page(params map[string]interface{}, user user.User) ([]PixRequest, string, Error.StarkErrors) {
 	//	Retrieve paged PixRequest structs
 	//
@@ -198,12 +348,29 @@ func Page(params map[string]interface{}, user user.User) ([]PixRequest, string,
 	//	Return:
 	//	- slice of PixRequest structs with updated attributes
 	//	- cursor to retrieve the next page of PixRequest structs
+	return Client{Api: utils.Default(user)}.Page(params)
+}
+
+func PageCtx(ctx context.Context, params map[string]interface{}, user user.User) ([]PixRequest, string, Error.StarkErrors) {
+	//	Retrieve paged PixRequest structs
+	//
+	//	Same as Page, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.PageCtx(ctx, params)
+}
+
+func (c Client) Page(params map[string]interface{}) ([]PixRequest, string, Error.StarkErrors) {
+	//	Retrieve paged PixRequest structs
+	return c.PageCtx(context.Background(), params)
+}
+
+func (c Client) PageCtx(ctx context.Context, params map[string]interface{}) ([]PixRequest, string, Error.StarkErrors) {
+	//	Retrieve paged PixRequest structs
 	var pixRequests []PixRequest
-	page, cursor, err := utils.Page(resource, params, user)
-	unmarshalError := json.Unmarshal(page, &pixRequests)
-	if unmarshalError != nil {
+	page, cursor, err := c.Api.Page(ctx, resource, params)
+	if err.Errors != nil {
 		return pixRequests, cursor, err
 	}
+	err = c.Api.Unmarshal(page, &pixRequests)
 	return pixRequests, cursor, err
 }
 
@@ -223,16 +390,33 @@ func Parse(content string, signature string, user user.User) (PixRequest, Error.
 	//
 	//	Return:
 	//	- parsed PixRequest struct
+	return Client{Api: utils.Default(user)}.Parse(content, signature)
+}
+
+func ParseCtx(ctx context.Context, content string, signature string, user user.User) (PixRequest, Error.StarkErrors) {
+	//	Create single verified PixRequest struct from a content string
+	//
+	//	Same as Parse, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.ParseCtx(ctx, content, signature)
+}
+
+func (c Client) Parse(content string, signature string) (PixRequest, Error.StarkErrors) {
+	//	Create single verified PixRequest struct from a content string
+	return c.ParseCtx(context.Background(), content, signature)
+}
+
+func (c Client) ParseCtx(ctx context.Context, content string, signature string) (PixRequest, Error.StarkErrors) {
+	//	Create single verified PixRequest struct from a content string
 	var pixRequest PixRequest
 
-	parsed, err := utils.ParseAndVerify(content, signature, "", user)
+	parsed, err := c.Api.ParseAndVerify(ctx, content, signature, "")
 	if err.Errors != nil {
 		return pixRequest, err
 	}
 
-	unmarshalError := json.Unmarshal([]byte(parsed), &pixRequest)
-	if unmarshalError != nil {
-		return pixRequest, Error.UnknownError(unmarshalError.Error())
+	unmarshalError := c.Api.Unmarshal([]byte(parsed), &pixRequest)
+	if unmarshalError.Errors != nil {
+		return pixRequest, unmarshalError
 	}
 	return pixRequest, Error.StarkErrors{}
 }
@@ -1,6 +1,7 @@
 package pixreversal
 
 import (
+	"context"
 	"encoding/json"
 	Error "github.com/starkinfra/core-go/starkcore/error"
 	"github.com/starkinfra/core-go/starkcore/user/user"
@@ -33,24 +34,35 @@ import (
 //	- Flow [string]: Direction of money flow. ex: "in" or "out"
 //	- Created [time.Time]: Creation datetime for the PixReversal. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC),
 //	- Updated [time.Time]: Latest update datetime for the PixReversal. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC),
+//	- Extra [map[string]json.RawMessage]: Fields of the PixReversal sent by the API but not declared in this SDK version yet, keyed by their JSON name. ex: map[string]json.RawMessage{"newField": json.RawMessage(`"value"`)}
 
 type PixReversal struct {
-	Amount     int        `json:",omitempty"`
-	ExternalId string     `json:",omitempty"`
-	EndToEndId string     `json:",omitempty"`
-	Reason     string     `json:",omitempty"`
-	Tags       []string   `json:",omitempty"`
-	Id         string     `json:",omitempty"`
-	ReturnId   string     `json:",omitempty"`
-	Fee        int        `json:",omitempty"`
-	Status     string     `json:",omitempty"`
-	Flow       string     `json:",omitempty"`
-	Created    *time.Time `json:",omitempty"`
-	Updated    *time.Time `json:",omitempty"`
+	Amount     int                        `json:",omitempty"`
+	ExternalId string                     `json:",omitempty"`
+	EndToEndId string                     `json:",omitempty"`
+	Reason     string                     `json:",omitempty"`
+	Tags       []string                   `json:",omitempty"`
+	Id         string                     `json:",omitempty"`
+	ReturnId   string                     `json:",omitempty"`
+	Fee        int                        `json:",omitempty"`
+	Status     string                     `json:",omitempty"`
+	Flow       string                     `json:",omitempty"`
+	Created    *time.Time                 `json:",omitempty"`
+	Updated    *time.Time                 `json:",omitempty"`
+	Extra      map[string]json.RawMessage `json:"-"`
 }
 
 var resource = map[string]string{"name": "PixReversal"}
 
+//	PixReversal Client struct
+//
+//	Runs the PixReversal functions with the settings of its own utils.Client
+//	instead of starkinfra.User and the other package defaults.
+
+type Client struct {
+	Api *utils.Client
+}
+
 func Create(reversals []PixReversal, user user.User) ([]PixReversal, Error.StarkErrors) {
 	//	Create PixReversals
 	//
@@ -64,11 +76,28 @@ func Create(reversals []PixReversal, user user.User) ([]PixReversal, Error.Stark
 	//
 	//	Return:
 	//	- slice of PixReversal structs with updated attributes
-	create, err := utils.Multi(resource, reversals, nil, user)
-	unmarshalError := json.Unmarshal(create, &reversals)
-	if unmarshalError != nil {
+	return Client{Api: utils.Default(user)}.Create(reversals)
+}
+
+func CreateCtx(ctx context.Context, reversals []PixReversal, user user.User) ([]PixReversal, Error.StarkErrors) {
+	//	Create PixReversals
+	//
+	//	Same as Create, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.CreateCtx(ctx, reversals)
+}
+
+func (c Client) Create(reversals []PixReversal) ([]PixReversal, Error.StarkErrors) {
+	//	Create PixReversals
+	return c.CreateCtx(context.Background(), reversals)
+}
+
+func (c Client) CreateCtx(ctx context.Context, reversals []PixReversal) ([]PixReversal, Error.StarkErrors) {
+	//	Create PixReversals
+	create, err := c.Api.Multi(ctx, resource, reversals, nil)
+	if err.Errors != nil {
 		return reversals, err
 	}
+	err = c.Api.Unmarshal(create, &reversals)
 	return reversals, err
 }
 
@@ -85,12 +114,29 @@ func Get(id string, user user.User) (PixReversal, Error.StarkErrors) {
 	//
 	//	Return:
 	//	- pixReversal struct that corresponds to the given id.
+	return Client{Api: utils.Default(user)}.Get(id)
+}
+
+func GetCtx(ctx context.Context, id string, user user.User) (PixReversal, Error.StarkErrors) {
+	//	Retrieve a specific PixReversal by its id
+	//
+	//	Same as Get, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.GetCtx(ctx, id)
+}
+
+func (c Client) Get(id string) (PixReversal, Error.StarkErrors) {
+	//	Retrieve a specific PixReversal by its id
+	return c.GetCtx(context.Background(), id)
+}
+
+func (c Client) GetCtx(ctx context.Context, id string) (PixReversal, Error.StarkErrors) {
+	//	Retrieve a specific PixReversal by its id
 	var pixReversal PixReversal
-	get, err := utils.Get(resource, id, nil, user)
-	unmarshalError := json.Unmarshal(get, &pixReversal)
-	if unmarshalError != nil {
+	get, err := c.Api.Get(ctx, resource, id, nil)
+	if err.Errors != nil {
 		return pixReversal, err
 	}
+	err = c.Api.Unmarshal(get, &pixReversal)
 	return pixReversal, err
 }
 
@@ -113,29 +159,133 @@ func Query(params map[string]interface{}, user user.User) (chan PixReversal, cha
 	//
 	//	Return:
 	//	- channel of PixReversal structs with updated attributes
-	var pixReversal PixReversal
+	return Client{Api: utils.Default(user)}.Query(params)
+}
+
+func QueryCtx(ctx context.Context, params map[string]interface{}, user user.User) (chan PixReversal, chan Error.StarkErrors) {
+	//	Retrieve PixReversals
+	//
+	//	Same as Query, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.QueryCtx(ctx, params)
+}
+
+func (c Client) Query(params map[string]interface{}) (chan PixReversal, chan Error.StarkErrors) {
+	//	Retrieve PixReversals
+	return c.QueryCtx(context.Background(), params)
+}
+
+func (c Client) QueryCtx(ctx context.Context, params map[string]interface{}) (chan PixReversal, chan Error.StarkErrors) {
+	//	Retrieve PixReversals
 	reversals := make(chan PixReversal)
 	reversalsError := make(chan Error.StarkErrors)
-	query, errorChannel := utils.Query(resource, params, user)
+	query, errorChannel := c.Api.Stream(ctx, resource, params)
 	go func() {
+		defer close(reversalsError)
+		defer close(reversals)
 		for content := range query {
-			contentByte, _ := json.Marshal(content)
-			err := json.Unmarshal(contentByte, &pixReversal)
-			if err != nil {
-				reversalsError <- Error.UnknownError(err.Error())
+			var pixReversal PixReversal
+			err := c.Api.Unmarshal(content, &pixReversal)
+			if err.Errors != nil {
+				if !utils.SendError(ctx, reversalsError, err) {
+					return
+				}
 				continue
 			}
-			reversals <- pixReversal
+			select {
+			case reversals <- pixReversal:
+			case <-ctx.Done():
+				return
+			}
 		}
 		for err := range errorChannel {
-			reversalsError <- err
+			if !utils.SendError(ctx, reversalsError, err) {
+				return
+			}
 		}
-		close(reversals)
-		close(reversalsError)
 	}()
 	return reversals, reversalsError
 }
 
+//	PixReversal Iterator struct
+//
+//	Returned by Iterate to walk through PixReversal structs one at a time,
+//	requesting a new page only once the current one is exhausted. Check Err
+//	once Next returns false and Close it when leaving the loop early.
+
+type Iterator struct {
+	api   *utils.Client
+	pages *utils.Iterator
+	value PixReversal
+	err   Error.StarkErrors
+}
+
+func Iterate(params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve PixReversals
+	//
+	//	Same as Query, but returns an Iterator instead of a pair of channels
+	//
+	//	Example:
+	//	iterator := Iterate(params, nil)
+	//	defer iterator.Close()
+	//	for iterator.Next() {
+	//		fmt.Println(iterator.Value())
+	//	}
+	//	if err := iterator.Err(); err.Errors != nil {
+	//		...
+	//	}
+	return Client{Api: utils.Default(user)}.Iterate(params)
+}
+
+func IterateCtx(ctx context.Context, params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve PixReversals
+	//
+	//	Same as Iterate, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.IterateCtx(ctx, params)
+}
+
+func (c Client) Iterate(params map[string]interface{}) *Iterator {
+	//	Retrieve PixReversals
+	return c.IterateCtx(context.Background(), params)
+}
+
+func (c Client) IterateCtx(ctx context.Context, params map[string]interface{}) *Iterator {
+	//	Retrieve PixReversals
+	return &Iterator{api: c.Api, pages: c.Api.Iterate(ctx, resource, params)}
+}
+
+func (i *Iterator) Next() bool {
+	//	Move to the next PixReversal, returning false when there are no more or a request failed
+	var value PixReversal
+	if i.err.Errors != nil || !i.pages.Next() {
+		return false
+	}
+	i.err = i.api.Unmarshal(i.pages.Value(), &value)
+	if i.err.Errors != nil {
+		i.pages.Close()
+		return false
+	}
+	i.value = value
+	return true
+}
+
+func (i *Iterator) Value() PixReversal {
+	//	Current PixReversal, set by the last call to Next
+	return i.value
+}
+
+func (i *Iterator) Err() Error.StarkErrors {
+	//	Error that stopped the iteration, if any
+	if i.err.Errors != nil {
+		return i.err
+	}
+	return i.pages.Err()
+}
+
+func (i *Iterator) Close() {
+	//	Stop the iteration, releasing the current page
+	i.pages.Close()
+}
+
 func Page(params map[string]interface{}, user user.User) ([]PixReversal, string, Error.StarkErrors) {
 	//	Retrieve paged PixReversals
 	//
@@ -158,12 +308,29 @@ func Page(params map[string]interface{}, user user.User) ([]PixReversal, string,
 	//	Return:
 	//	- slice of PixReversal structs with updated attributes
 	//	- cursor to retrieve the next page of PixReversal structs
+	return Client{Api: utils.Default(user)}.Page(params)
+}
+
+func PageCtx(ctx context.Context, params map[string]interface{}, user user.User) ([]PixReversal, string, Error.StarkErrors) {
+	//	Retrieve paged PixReversals
+	//
+	//	Same as Page, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.PageCtx(ctx, params)
+}
+
+func (c Client) Page(params map[string]interface{}) ([]PixReversal, string, Error.StarkErrors) {
+	//	Retrieve paged PixReversals
+	return c.PageCtx(context.Background(), params)
+}
+
+func (c Client) PageCtx(ctx context.Context, params map[string]interface{}) ([]PixReversal, string, Error.StarkErrors) {
+	//	Retrieve paged PixReversals
 	var pixReversals []PixReversal
-	page, cursor, err := utils.Page(resource, params, user)
-	unmarshalError := json.Unmarshal(page, &pixReversals)
-	if unmarshalError != nil {
+	page, cursor, err := c.Api.Page(ctx, resource, params)
+	if err.Errors != nil {
 		return pixReversals, cursor, err
 	}
+	err = c.Api.Unmarshal(page, &pixReversals)
 	return pixReversals, cursor, err
 }
 
@@ -183,16 +350,33 @@ func Parse(content string, signature string, user user.User) (PixReversal, Error
 	//
 	//	Return:
 	//	- parsed PixReversal struct
+	return Client{Api: utils.Default(user)}.Parse(content, signature)
+}
+
+func ParseCtx(ctx context.Context, content string, signature string, user user.User) (PixReversal, Error.StarkErrors) {
+	//	Create single verified PixReversal struct from a content string
+	//
+	//	Same as Parse, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.ParseCtx(ctx, content, signature)
+}
+
+func (c Client) Parse(content string, signature string) (PixReversal, Error.StarkErrors) {
+	//	Create single verified PixReversal struct from a content string
+	return c.ParseCtx(context.Background(), content, signature)
+}
+
+func (c Client) ParseCtx(ctx context.Context, content string, signature string) (PixReversal, Error.StarkErrors) {
+	//	Create single verified PixReversal struct from a content string
 	var pixReversal PixReversal
 
-	parsed, err := utils.ParseAndVerify(content, signature, "", user)
+	parsed, err := c.Api.ParseAndVerify(ctx, content, signature, "")
 	if err.Errors != nil {
 		return pixReversal, err
 	}
-	
-	unmarshalError := json.Unmarshal([]byte(parsed), &pixReversal)
-	if unmarshalError != nil {
-		return pixReversal, Error.UnknownError(unmarshalError.Error())
+
+	unmarshalError := c.Api.Unmarshal([]byte(parsed), &pixReversal)
+	if unmarshalError.Errors != nil {
+		return pixReversal, unmarshalError
 	}
 
 	return pixReversal, Error.StarkErrors{}
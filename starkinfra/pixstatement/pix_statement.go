@@ -1,6 +1,7 @@
 package pixstatement
 
 import (
+	"context"
 	"encoding/json"
 	Error "github.com/starkinfra/core-go/starkcore/error"
 	"github.com/starkinfra/core-go/starkcore/user/user"
@@ -30,21 +31,32 @@ import (
 //	- ChunkCount [int]: Number of chunks the statement file is split into. ex: 2
 //	- Created [time.Time]: Creation datetime for the PixStatement. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC),
 //	- Updated [time.Time]: Latest update datetime for the PixStatement. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC),
+//	- Extra [map[string]json.RawMessage]: Fields of the PixStatement sent by the API but not declared in this SDK version yet, keyed by their JSON name. ex: map[string]json.RawMessage{"newField": json.RawMessage(`"value"`)}
 
 type PixStatement struct {
-	After            *time.Time `json:",omitempty"`
-	Before           *time.Time `json:",omitempty"`
-	Type             string     `json:",omitempty"`
-	Id               string     `json:",omitempty"`
-	Status           string     `json:",omitempty"`
-	TransactionCount int        `json:",omitempty"`
-	ChunkCount       int        `json:",omitempty"`
-	Created          *time.Time `json:",omitempty"`
-	Updated          *time.Time `json:",omitempty"`
+	After            *time.Time                 `json:",omitempty"`
+	Before           *time.Time                 `json:",omitempty"`
+	Type             string                     `json:",omitempty"`
+	Id               string                     `json:",omitempty"`
+	Status           string                     `json:",omitempty"`
+	TransactionCount int                        `json:",omitempty"`
+	ChunkCount       int                        `json:",omitempty"`
+	Created          *time.Time                 `json:",omitempty"`
+	Updated          *time.Time                 `json:",omitempty"`
+	Extra            map[string]json.RawMessage `json:"-"`
 }
 
 var resource = map[string]string{"name": "PixStatement"}
 
+//	PixStatement Client struct
+//
+//	Runs the PixStatement functions with the settings of its own utils.Client
+//	instead of starkinfra.User and the other package defaults.
+
+type Client struct {
+	Api *utils.Client
+}
+
 func Create(statement PixStatement, user user.User) (PixStatement, Error.StarkErrors) {
 	//	Create a PixStatement struct
 	//
@@ -58,11 +70,28 @@ func Create(statement PixStatement, user user.User) (PixStatement, Error.StarkEr
 	//
 	//	Return:
 	//	- PixStatement struct with updated attributes.
-	create, err := utils.Single(resource, statement, user)
-	unmarshalError := json.Unmarshal(create, &statement)
-	if unmarshalError != nil {
+	return Client{Api: utils.Default(user)}.Create(statement)
+}
+
+func CreateCtx(ctx context.Context, statement PixStatement, user user.User) (PixStatement, Error.StarkErrors) {
+	//	Create a PixStatement struct
+	//
+	//	Same as Create, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.CreateCtx(ctx, statement)
+}
+
+func (c Client) Create(statement PixStatement) (PixStatement, Error.StarkErrors) {
+	//	Create a PixStatement struct
+	return c.CreateCtx(context.Background(), statement)
+}
+
+func (c Client) CreateCtx(ctx context.Context, statement PixStatement) (PixStatement, Error.StarkErrors) {
+	//	Create a PixStatement struct
+	create, err := c.Api.Single(ctx, resource, statement)
+	if err.Errors != nil {
 		return statement, err
 	}
+	err = c.Api.Unmarshal(create, &statement)
 	return statement, err
 }
 
@@ -79,12 +108,29 @@ func Get(id string, user user.User) (PixStatement, Error.StarkErrors) {
 	//
 	//	Return:
 	//	- pixStatement struct that corresponds to the given id.
+	return Client{Api: utils.Default(user)}.Get(id)
+}
+
+func GetCtx(ctx context.Context, id string, user user.User) (PixStatement, Error.StarkErrors) {
+	//	Retrieve a specific PixStatement by its id
+	//
+	//	Same as Get, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.GetCtx(ctx, id)
+}
+
+func (c Client) Get(id string) (PixStatement, Error.StarkErrors) {
+	//	Retrieve a specific PixStatement by its id
+	return c.GetCtx(context.Background(), id)
+}
+
+func (c Client) GetCtx(ctx context.Context, id string) (PixStatement, Error.StarkErrors) {
+	//	Retrieve a specific PixStatement by its id
 	var pixStatement PixStatement
-	get, err := utils.Get(resource, id, nil, user)
-	unmarshalError := json.Unmarshal(get, &pixStatement)
-	if unmarshalError != nil {
+	get, err := c.Api.Get(ctx, resource, id, nil)
+	if err.Errors != nil {
 		return pixStatement, err
 	}
+	err = c.Api.Unmarshal(get, &pixStatement)
 	return pixStatement, err
 }
 
@@ -101,29 +147,133 @@ func Query(params map[string]interface{}, user user.User) (chan PixStatement, ch
 	//
 	//	Return:
 	//	- channel of PixStatement structs with updated attributes
-	var pixStatement PixStatement
+	return Client{Api: utils.Default(user)}.Query(params)
+}
+
+func QueryCtx(ctx context.Context, params map[string]interface{}, user user.User) (chan PixStatement, chan Error.StarkErrors) {
+	//	Retrieve PixStatement structs
+	//
+	//	Same as Query, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.QueryCtx(ctx, params)
+}
+
+func (c Client) Query(params map[string]interface{}) (chan PixStatement, chan Error.StarkErrors) {
+	//	Retrieve PixStatement structs
+	return c.QueryCtx(context.Background(), params)
+}
+
+func (c Client) QueryCtx(ctx context.Context, params map[string]interface{}) (chan PixStatement, chan Error.StarkErrors) {
+	//	Retrieve PixStatement structs
 	statements := make(chan PixStatement)
 	statementsError := make(chan Error.StarkErrors)
-	query, errorChannel := utils.Query(resource, params, user)
+	query, errorChannel := c.Api.Stream(ctx, resource, params)
 	go func() {
+		defer close(statementsError)
+		defer close(statements)
 		for content := range query {
-			contentByte, _ := json.Marshal(content)
-			err := json.Unmarshal(contentByte, &pixStatement)
-			if err != nil {
-				statementsError <- Error.UnknownError(err.Error())
+			var pixStatement PixStatement
+			err := c.Api.Unmarshal(content, &pixStatement)
+			if err.Errors != nil {
+				if !utils.SendError(ctx, statementsError, err) {
+					return
+				}
 				continue
 			}
-			statements <- pixStatement
+			select {
+			case statements <- pixStatement:
+			case <-ctx.Done():
+				return
+			}
 		}
 		for err := range errorChannel {
-			statementsError <- err
+			if !utils.SendError(ctx, statementsError, err) {
+				return
+			}
 		}
-		close(statements)
-		close(statementsError)
 	}()
 	return statements, statementsError
 }
 
+//	PixStatement Iterator struct
+//
+//	Returned by Iterate to walk through PixStatement structs one at a time,
+//	requesting a new page only once the current one is exhausted. Check Err
+//	once Next returns false and Close it when leaving the loop early.
+
+type Iterator struct {
+	api   *utils.Client
+	pages *utils.Iterator
+	value PixStatement
+	err   Error.StarkErrors
+}
+
+func Iterate(params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve PixStatement structs
+	//
+	//	Same as Query, but returns an Iterator instead of a pair of channels
+	//
+	//	Example:
+	//	iterator := Iterate(params, nil)
+	//	defer iterator.Close()
+	//	for iterator.Next() {
+	//		fmt.Println(iterator.Value())
+	//	}
+	//	if err := iterator.Err(); err.Errors != nil {
+	//		...
+	//	}
+	return Client{Api: utils.Default(user)}.Iterate(params)
+}
+
+func IterateCtx(ctx context.Context, params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve PixStatement structs
+	//
+	//	Same as Iterate, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.IterateCtx(ctx, params)
+}
+
+func (c Client) Iterate(params map[string]interface{}) *Iterator {
+	//	Retrieve PixStatement structs
+	return c.IterateCtx(context.Background(), params)
+}
+
+func (c Client) IterateCtx(ctx context.Context, params map[string]interface{}) *Iterator {
+	//	Retrieve PixStatement structs
+	return &Iterator{api: c.Api, pages: c.Api.Iterate(ctx, resource, params)}
+}
+
+func (i *Iterator) Next() bool {
+	//	Move to the next PixStatement, returning false when there are no more or a request failed
+	var value PixStatement
+	if i.err.Errors != nil || !i.pages.Next() {
+		return false
+	}
+	i.err = i.api.Unmarshal(i.pages.Value(), &value)
+	if i.err.Errors != nil {
+		i.pages.Close()
+		return false
+	}
+	i.value = value
+	return true
+}
+
+func (i *Iterator) Value() PixStatement {
+	//	Current PixStatement, set by the last call to Next
+	return i.value
+}
+
+func (i *Iterator) Err() Error.StarkErrors {
+	//	Error that stopped the iteration, if any
+	if i.err.Errors != nil {
+		return i.err
+	}
+	return i.pages.Err()
+}
+
+func (i *Iterator) Close() {
+	//	Stop the iteration, releasing the current page
+	i.pages.Close()
+}
+
 func Csv(id string, user user.User) ([]byte, Error.StarkErrors) {
 	//	Retrieve a .csv PixStatement
 	//
@@ -137,7 +287,24 @@ func Csv(id string, user user.User) ([]byte, Error.StarkErrors) {
 	//
 	//	Return:
 	//	- .zip file containing a PixStatement in .csv format
-	return utils.GetContent(resource, id, nil, user, "csv")
+	return Client{Api: utils.Default(user)}.Csv(id)
+}
+
+func CsvCtx(ctx context.Context, id string, user user.User) ([]byte, Error.StarkErrors) {
+	//	Retrieve a .csv PixStatement
+	//
+	//	Same as Csv, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.CsvCtx(ctx, id)
+}
+
+func (c Client) Csv(id string) ([]byte, Error.StarkErrors) {
+	//	Retrieve a .csv PixStatement
+	return c.CsvCtx(context.Background(), id)
+}
+
+func (c Client) CsvCtx(ctx context.Context, id string) ([]byte, Error.StarkErrors) {
+	//	Retrieve a .csv PixStatement
+	return c.Api.GetContent(ctx, resource, id, nil, "csv")
 }
 
 func Page(params map[string]interface{}, user user.User) ([]PixStatement, string, Error.StarkErrors) {
@@ -156,11 +323,28 @@ func Page(params map[string]interface{}, user user.User) ([]PixStatement, string
 	//	Return:
 	//	- slice of PixStatement structs with updated attributes
 	//	- cursor to retrieve the next page of PixStatement structs
+	return Client{Api: utils.Default(user)}.Page(params)
+}
+
+func PageCtx(ctx context.Context, params map[string]interface{}, user user.User) ([]PixStatement, string, Error.StarkErrors) {
+	//	Retrieve paged PixStatement structs
+	//
+	//	Same as Page, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.PageCtx(ctx, params)
+}
+
+func (c Client) Page(params map[string]interface{}) ([]PixStatement, string, Error.StarkErrors) {
+	//	Retrieve paged PixStatement structs
+	return c.PageCtx(context.Background(), params)
+}
+
+func (c Client) PageCtx(ctx context.Context, params map[string]interface{}) ([]PixStatement, string, Error.StarkErrors) {
+	//	Retrieve paged PixStatement structs
 	var pixStatements []PixStatement
-	page, cursor, err := utils.Page(resource, params, user)
-	unmarshalError := json.Unmarshal(page, &pixStatements)
-	if unmarshalError != nil {
+	page, cursor, err := c.Api.Page(ctx, resource, params)
+	if err.Errors != nil {
 		return pixStatements, cursor, err
 	}
+	err = c.Api.Unmarshal(page, &pixStatements)
 	return pixStatements, cursor, err
 }
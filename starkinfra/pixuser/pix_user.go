@@ -1,6 +1,7 @@
 package pixuser
 
 import (
+	"context"
 	"encoding/json"
 	Error "github.com/starkinfra/core-go/starkcore/error"
 	"github.com/starkinfra/core-go/starkcore/user/user"
@@ -16,14 +17,25 @@ import (
 //
 //	Attributes (return-only):
 //	- Statistics [slice of Statistics structs]: Slice of PixUser.Statistics structs with fraud statistics of the user. ex: []pixuser.Statistics{{Value: 3, Type: "infractions", Source: "keyManagement"}}
+//	- Extra [map[string]json.RawMessage]: Fields of the PixUser sent by the API but not declared in this SDK version yet, keyed by their JSON name. ex: map[string]json.RawMessage{"newField": json.RawMessage(`"value"`)}
 
 type PixUser struct {
-	Statistics []Statistics `json:",omitempty"`
-	Id         string       `json:",omitempty"`
+	Statistics []Statistics               `json:",omitempty"`
+	Id         string                     `json:",omitempty"`
+	Extra      map[string]json.RawMessage `json:"-"`
 }
 
 var resource = map[string]string{"name": "PixUser"}
 
+//	PixUser Client struct
+//
+//	Runs the PixUser functions with the settings of its own utils.Client
+//	instead of starkinfra.User and the other package defaults.
+
+type Client struct {
+	Api *utils.Client
+}
+
 func Get(id string, user user.User) (PixUser, Error.StarkErrors) {
 	//	Retrieve a PixUser struct
 	//
@@ -38,11 +50,28 @@ func Get(id string, user user.User) (PixUser, Error.StarkErrors) {
 	//
 	//	Return:
 	//	- PixUser struct that corresponds to the given id.
+	return Client{Api: utils.Default(user)}.Get(id)
+}
+
+func GetCtx(ctx context.Context, id string, user user.User) (PixUser, Error.StarkErrors) {
+	//	Retrieve a PixUser struct
+	//
+	//	Same as Get, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.GetCtx(ctx, id)
+}
+
+func (c Client) Get(id string) (PixUser, Error.StarkErrors) {
+	//	Retrieve a PixUser struct
+	return c.GetCtx(context.Background(), id)
+}
+
+func (c Client) GetCtx(ctx context.Context, id string) (PixUser, Error.StarkErrors) {
+	//	Retrieve a PixUser struct
 	var pixUser PixUser
-	get, err := utils.Get(resource, id, nil, user)
-	unmarshalError := json.Unmarshal(get, &pixUser)
-	if unmarshalError != nil {
+	get, err := c.Api.Get(ctx, resource, id, nil)
+	if err.Errors != nil {
 		return pixUser, err
 	}
+	err = c.Api.Unmarshal(get, &pixUser)
 	return pixUser, err
 }
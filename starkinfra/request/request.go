@@ -1,12 +1,22 @@
 package request
 
 import (
+	"context"
 	"github.com/starkinfra/sdk-go/starkinfra/utils"
 	Error "github.com/starkinfra/core-go/starkcore/error"
 	"github.com/starkinfra/core-go/starkcore/user/user"
 	"github.com/starkinfra/core-go/starkcore/utils/request"
 )
 
+//	Request Client struct
+//
+//	Runs the raw request functions with the settings of its own utils.Client
+//	instead of starkinfra.User and the other package defaults.
+
+type Client struct {
+	Api *utils.Client
+}
+
 func Get(path string, query map[string]interface{}, user user.User) (request.Response, Error.StarkErrors) {
 	//	Retrieve any StarkInfra resource
     //	Receive a json of resources previously created in StarkInfra's API
@@ -20,7 +30,24 @@ func Get(path string, query map[string]interface{}, user user.User) (request.Res
 	// 
 	//  Return:
     //  Retrieve paged resources
-	content, err := utils.GetRaw(path, query, user, "Joker", false)
+	return Client{Api: utils.Default(user)}.Get(path, query)
+}
+
+func GetCtx(ctx context.Context, path string, query map[string]interface{}, user user.User) (request.Response, Error.StarkErrors) {
+	//	Retrieve any StarkInfra resource
+	//
+	//	Same as Get, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.GetCtx(ctx, path, query)
+}
+
+func (c Client) Get(path string, query map[string]interface{}) (request.Response, Error.StarkErrors) {
+	//	Retrieve any StarkInfra resource
+	return c.GetCtx(context.Background(), path, query)
+}
+
+func (c Client) GetCtx(ctx context.Context, path string, query map[string]interface{}) (request.Response, Error.StarkErrors) {
+	//	Retrieve any StarkInfra resource
+	content, err := c.Api.GetRaw(ctx, path, query, "Joker", false)
 	return content, err
 }
 
@@ -39,7 +66,24 @@ func Post(path string, body map[string][]map[string]interface{}, query map[strin
 	//	Return:
     //	- Retrieve created resources
 	//
-	content, err := utils.PostRaw(path, body, user, query, "Joker", false)
+	return Client{Api: utils.Default(user)}.Post(path, body, query)
+}
+
+func PostCtx(ctx context.Context, path string, body map[string][]map[string]interface{}, query map[string]interface{}, user user.User) (request.Response, Error.StarkErrors) {
+	//	Create any StarkInfra resource
+	//
+	//	Same as Post, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.PostCtx(ctx, path, body, query)
+}
+
+func (c Client) Post(path string, body map[string][]map[string]interface{}, query map[string]interface{}) (request.Response, Error.StarkErrors) {
+	//	Create any StarkInfra resource
+	return c.PostCtx(context.Background(), path, body, query)
+}
+
+func (c Client) PostCtx(ctx context.Context, path string, body map[string][]map[string]interface{}, query map[string]interface{}) (request.Response, Error.StarkErrors) {
+	//	Create any StarkInfra resource
+	content, err := c.Api.PostRaw(ctx, path, body, query, "Joker", false)
 	return content, err
 }
 
@@ -56,7 +100,24 @@ func Patch(path string, body map[string]interface{}, query map[string]interface{
     //	
 	// 	Return:
     // 	- Retrieve updated resource
-	content, err := utils.PatchRaw(path, body, user, query, "Joker", false)
+	return Client{Api: utils.Default(user)}.Patch(path, body, query)
+}
+
+func PatchCtx(ctx context.Context, path string, body map[string]interface{}, query map[string]interface{}, user user.User) (request.Response, Error.StarkErrors) {
+	//	Update any StarkInfra resource
+	//
+	//	Same as Patch, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.PatchCtx(ctx, path, body, query)
+}
+
+func (c Client) Patch(path string, body map[string]interface{}, query map[string]interface{}) (request.Response, Error.StarkErrors) {
+	//	Update any StarkInfra resource
+	return c.PatchCtx(context.Background(), path, body, query)
+}
+
+func (c Client) PatchCtx(ctx context.Context, path string, body map[string]interface{}, query map[string]interface{}) (request.Response, Error.StarkErrors) {
+	//	Update any StarkInfra resource
+	content, err := c.Api.PatchRaw(ctx, path, body, query, "Joker", false)
 	return content, err
 }
 
@@ -73,7 +134,24 @@ func Put(path string, body map[string][]map[string]interface{}, query map[string
     // 
 	//  Return:
     //  - json of the resource with updated attributes
-	content, err := utils.PutRaw(path, body, user, query, "Joker", false)
+	return Client{Api: utils.Default(user)}.Put(path, body, query)
+}
+
+func PutCtx(ctx context.Context, path string, body map[string][]map[string]interface{}, query map[string]interface{}, user user.User) (request.Response, Error.StarkErrors) {
+	//	Put any StarkInfra resource
+	//
+	//	Same as Put, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.PutCtx(ctx, path, body, query)
+}
+
+func (c Client) Put(path string, body map[string][]map[string]interface{}, query map[string]interface{}) (request.Response, Error.StarkErrors) {
+	//	Put any StarkInfra resource
+	return c.PutCtx(context.Background(), path, body, query)
+}
+
+func (c Client) PutCtx(ctx context.Context, path string, body map[string][]map[string]interface{}, query map[string]interface{}) (request.Response, Error.StarkErrors) {
+	//	Put any StarkInfra resource
+	content, err := c.Api.PutRaw(ctx, path, body, query, "Joker", false)
 	return content, err
 }
 
@@ -89,6 +167,23 @@ func Delete(path string, user user.User) (request.Response, Error.StarkErrors) {
 	// 
 	//	Return:
 	//	- json of the resource with updated attributes
-	content, err := utils.DeleteRaw(path, user, "Joker", false)
+	return Client{Api: utils.Default(user)}.Delete(path)
+}
+
+func DeleteCtx(ctx context.Context, path string, user user.User) (request.Response, Error.StarkErrors) {
+	//	Delete any StarkInfra resource
+	//
+	//	Same as Delete, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.DeleteCtx(ctx, path)
+}
+
+func (c Client) Delete(path string) (request.Response, Error.StarkErrors) {
+	//	Delete any StarkInfra resource
+	return c.DeleteCtx(context.Background(), path)
+}
+
+func (c Client) DeleteCtx(ctx context.Context, path string) (request.Response, Error.StarkErrors) {
+	//	Delete any StarkInfra resource
+	content, err := c.Api.DeleteRaw(ctx, path, "Joker", false)
 	return content, err
 }
\ No newline at end of file
@@ -3,11 +3,36 @@ package starkinfra
 import (
 	"github.com/starkinfra/core-go/starkcore/user/user"
 	"github.com/starkinfra/core-go/starkcore/utils/hosts"
+	"github.com/starkinfra/sdk-go/starkinfra/utils"
+	"net/http"
 )
 
-var SdkVersion = "1.2.0"
+var SdkVersion = utils.SdkVersion
 var Language = "pt-BR"
 var Timeout = 15
 var ApiVersion = "v2"
 var Host = hosts.Infra
 var User user.User = nil
+var Retry = utils.Retry{}
+var HttpClient *http.Client = nil
+var Middlewares []utils.Middleware = nil
+var Strict = false
+var PublicKey utils.PublicKeyProvider = nil
+
+func init() {
+	utils.Defaults = func() utils.Client {
+		return utils.Client{
+			User:        User,
+			SdkVersion:  SdkVersion,
+			Host:        Host,
+			ApiVersion:  ApiVersion,
+			Language:    Language,
+			Timeout:     Timeout,
+			HttpClient:  HttpClient,
+			Retry:       Retry,
+			Middlewares: Middlewares,
+			Strict:      Strict,
+			PublicKey:   PublicKey,
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package starktest
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/starkbank/ecdsa-go/v2/ellipticcurve/curve"
+	"github.com/starkbank/ecdsa-go/v2/ellipticcurve/ecdsa"
+	"github.com/starkbank/ecdsa-go/v2/ellipticcurve/privatekey"
+	"github.com/starkbank/ecdsa-go/v2/ellipticcurve/publickey"
+	"github.com/starkinfra/sdk-go/starkinfra"
+	"github.com/starkinfra/sdk-go/starkinfra/utils"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+)
+
+//	Signer struct
+//
+//	Stands in for Stark Infra when testing code that receives signed content,
+//	such as webhook Events, authorization requests and DynamicBrcode reads.
+//	It signs content with its own ECDSA key the same way Stark Infra does, and
+//	makes the SDK verify signatures against that key instead of the Stark Infra
+//	public key, so Parse functions, event.Handler and authorization.Handler can
+//	be tested with no network access.
+//
+//	Attributes:
+//	- PrivateKey [privatekey.PrivateKey]: Key content is signed with. A new secp256k1 key is generated by NewSigner
+//
+//	Example:
+//	signer := starktest.NewSigner()
+//	client := starkinfra.NewClient(user)
+//	signer.Trust(client.Client)
+//	content, signature, _ := signer.SignJSON(map[string]interface{}{"event": event})
+//	parsed, err := client.Event.Parse(content, signature)
+
+type Signer struct {
+	PrivateKey privatekey.PrivateKey
+}
+
+func NewSigner() *Signer {
+	//	Create a Signer with a new secp256k1 key pair
+	return &Signer{PrivateKey: privatekey.New(curve.Secp256k1)}
+}
+
+func (s *Signer) PublicKey() publickey.PublicKey {
+	//	Public key matching the Signer PrivateKey
+	return s.PrivateKey.PublicKey()
+}
+
+func (s *Signer) PublicKeyPem() string {
+	//	Public key matching the Signer PrivateKey, PEM encoded
+	return s.PublicKey().ToPem()
+}
+
+func (s *Signer) Provider() utils.StaticPublicKey {
+	//	PublicKeyProvider trusting only the Signer key
+	return utils.StaticPublicKey{Keys: []publickey.PublicKey{s.PublicKey()}}
+}
+
+func (s *Signer) Trust(client *utils.Client) {
+	//	Make client verify signed content against the Signer key
+	//
+	//	Parameters (required):
+	//	- client [*utils.Client]: Client whose PublicKey is replaced, such as the Client of a starkinfra.Client. ex: client.Client
+	client.PublicKey = s.Provider()
+}
+
+func (s *Signer) TrustDefaults() (restore func()) {
+	//	Make the package-level functions verify signed content against the Signer key
+	//
+	//	Sets starkinfra.PublicKey, which is shared by the whole process, so tests
+	//	relying on it must not run in parallel. Prefer Trust when the code under
+	//	test takes a Client.
+	//
+	//	Return:
+	//	- restore function setting starkinfra.PublicKey back to its previous value. ex: defer signer.TrustDefaults()()
+	previous := starkinfra.PublicKey
+	starkinfra.PublicKey = s.Provider()
+	return func() {
+		starkinfra.PublicKey = previous
+	}
+}
+
+func (s *Signer) Sign(content string) string {
+	//	Sign content the way Stark Infra does
+	//
+	//	Parameters (required):
+	//	- content [string]: Exact body to be sent. ex: "{\"event\": {...}}"
+	//
+	//	Return:
+	//	- base64 signature, as sent in the Digital-Signature header
+	return ecdsa.Sign(content, &s.PrivateKey).ToBase64()
+}
+
+func (s *Signer) SignJSON(payload interface{}) (content string, signature string, err error) {
+	//	Encode payload as JSON and sign it
+	//
+	//	Parameters (required):
+	//	- payload [interface{}]: Value to be encoded, such as a map or an SDK struct. Strings and byte slices are taken as they are. ex: map[string]interface{}{"event": map[string]interface{}{"id": "1"}}
+	//
+	//	Return:
+	//	- JSON content and its base64 signature, or an error if payload cannot be encoded
+	switch payload := payload.(type) {
+	case string:
+		content = payload
+	case []byte:
+		content = string(payload)
+	default:
+		bytes, marshalError := json.Marshal(payload)
+		if marshalError != nil {
+			return "", "", fmt.Errorf("starktest: payload cannot be encoded as JSON: %v", marshalError)
+		}
+		content = string(bytes)
+	}
+	return content, s.Sign(content), nil
+}
+
+func (s *Signer) Request(method string, target string, payload interface{}) (*http.Request, error) {
+	//	Build an incoming request as Stark Infra sends it, to be served by an http.Handler under test
+	//
+	//	Parameters (required):
+	//	- method [string]: HTTP method. ex: "POST"
+	//	- target [string]: Request url or path. ex: "/pix-request"
+	//	- payload [interface{}]: Body, encoded and signed as with SignJSON. ex: map[string]interface{}{"id": "1", "amount": 1000}
+	//
+	//	Return:
+	//	- request with its "Digital-Signature" header set, or an error if payload cannot be encoded
+	content, signature, err := s.SignJSON(payload)
+	if err != nil {
+		return nil, err
+	}
+	request := httptest.NewRequest(method, target, strings.NewReader(content))
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("Digital-Signature", signature)
+	return request, nil
+}
@@ -1,6 +1,7 @@
 package staticbrcode
 
 import (
+	"context"
 	"encoding/json"
 	Error "github.com/starkinfra/core-go/starkcore/error"
 	"github.com/starkinfra/core-go/starkcore/user/user"
@@ -34,26 +35,37 @@ import (
 //  - Url [string]: Url link to the BR Code image. ex: "https://brcode-h.sandbox.starkinfra.com/static-qrcode/97756273400d42ce9086404fe10ea0d6.png"
 //  - Updated [time.Time]: Latest update datetime for the StaticBrcode. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC),
 //  - Created [time.Time]: Creation datetime for the StaticBrcode. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC),
+//	- Extra [map[string]json.RawMessage]: Fields of the StaticBrcode sent by the API but not declared in this SDK version yet, keyed by their JSON name. ex: map[string]json.RawMessage{"newField": json.RawMessage(`"value"`)}
 
 type StaticBrcode struct {
-	Name             string     `json:",omitempty"`
-	KeyId            string     `json:",omitempty"`
-	City             string     `json:",omitempty"`
-	Amount           int        `json:",omitempty"`
-	ReconciliationId string     `json:",omitempty"`
-	CashierBankCode  string     `json:",omitempty"`
-	Description      string     `json:",omitempty"`
-	Tags             []string   `json:",omitempty"`
-	Type             string     `json:",omitempty"`
-	Id               string     `json:",omitempty"`
-	Uuid             string     `json:",omitempty"`
-	Url              string     `json:",omitempty"`
-	Updated          *time.Time `json:",omitempty"`
-	Created          *time.Time `json:",omitempty"`
+	Name             string                     `json:",omitempty"`
+	KeyId            string                     `json:",omitempty"`
+	City             string                     `json:",omitempty"`
+	Amount           int                        `json:",omitempty"`
+	ReconciliationId string                     `json:",omitempty"`
+	CashierBankCode  string                     `json:",omitempty"`
+	Description      string                     `json:",omitempty"`
+	Tags             []string                   `json:",omitempty"`
+	Type             string                     `json:",omitempty"`
+	Id               string                     `json:",omitempty"`
+	Uuid             string                     `json:",omitempty"`
+	Url              string                     `json:",omitempty"`
+	Updated          *time.Time                 `json:",omitempty"`
+	Created          *time.Time                 `json:",omitempty"`
+	Extra            map[string]json.RawMessage `json:"-"`
 }
 
 var resource = map[string]string{"name": "StaticBrcode"}
 
+//	StaticBrcode Client struct
+//
+//	Runs the StaticBrcode functions with the settings of its own utils.Client
+//	instead of starkinfra.User and the other package defaults.
+
+type Client struct {
+	Api *utils.Client
+}
+
 func Create(brcodes []StaticBrcode, user user.User) ([]StaticBrcode, Error.StarkErrors) {
 	//	Create StaticBrcodes
 	//
@@ -67,11 +79,28 @@ func Create(brcodes []StaticBrcode, user user.User) ([]StaticBrcode, Error.Stark
 	//
 	//	Return:
 	//	- slice of StaticBrcode structs with updated attributes
-	create, err := utils.Multi(resource, brcodes, nil, user)
-	unmarshalError := json.Unmarshal(create, &brcodes)
-	if unmarshalError != nil {
+	return Client{Api: utils.Default(user)}.Create(brcodes)
+}
+
+func CreateCtx(ctx context.Context, brcodes []StaticBrcode, user user.User) ([]StaticBrcode, Error.StarkErrors) {
+	//	Create StaticBrcodes
+	//
+	//	Same as Create, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.CreateCtx(ctx, brcodes)
+}
+
+func (c Client) Create(brcodes []StaticBrcode) ([]StaticBrcode, Error.StarkErrors) {
+	//	Create StaticBrcodes
+	return c.CreateCtx(context.Background(), brcodes)
+}
+
+func (c Client) CreateCtx(ctx context.Context, brcodes []StaticBrcode) ([]StaticBrcode, Error.StarkErrors) {
+	//	Create StaticBrcodes
+	create, err := c.Api.Multi(ctx, resource, brcodes, nil)
+	if err.Errors != nil {
 		return brcodes, err
 	}
+	err = c.Api.Unmarshal(create, &brcodes)
 	return brcodes, err
 }
 
@@ -88,12 +117,29 @@ func Get(uuid string, user user.User) (StaticBrcode, Error.StarkErrors) {
 	//
 	//	Return:
 	//	- staticBrcode struct that corresponds to the given uuid.
+	return Client{Api: utils.Default(user)}.Get(uuid)
+}
+
+func GetCtx(ctx context.Context, uuid string, user user.User) (StaticBrcode, Error.StarkErrors) {
+	//	Retrieve a specific StaticBrcode by its uuid
+	//
+	//	Same as Get, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.GetCtx(ctx, uuid)
+}
+
+func (c Client) Get(uuid string) (StaticBrcode, Error.StarkErrors) {
+	//	Retrieve a specific StaticBrcode by its uuid
+	return c.GetCtx(context.Background(), uuid)
+}
+
+func (c Client) GetCtx(ctx context.Context, uuid string) (StaticBrcode, Error.StarkErrors) {
+	//	Retrieve a specific StaticBrcode by its uuid
 	var staticBrcode StaticBrcode
-	get, err := utils.Get(resource, uuid, nil, user)
-	unmarshalError := json.Unmarshal(get, &staticBrcode)
-	if unmarshalError != nil {
+	get, err := c.Api.Get(ctx, resource, uuid, nil)
+	if err.Errors != nil {
 		return staticBrcode, err
 	}
+	err = c.Api.Unmarshal(get, &staticBrcode)
 	return staticBrcode, err
 }
 
@@ -113,29 +159,133 @@ func Query(params map[string]interface{}, user user.User) (chan StaticBrcode, ch
 	//
 	//	Return:
 	//	- channel of StaticBrcode structs with updated attributes
-	var staticBrcode StaticBrcode
+	return Client{Api: utils.Default(user)}.Query(params)
+}
+
+func QueryCtx(ctx context.Context, params map[string]interface{}, user user.User) (chan StaticBrcode, chan Error.StarkErrors) {
+	//	Retrieve StaticBrcode structs
+	//
+	//	Same as Query, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.QueryCtx(ctx, params)
+}
+
+func (c Client) Query(params map[string]interface{}) (chan StaticBrcode, chan Error.StarkErrors) {
+	//	Retrieve StaticBrcode structs
+	return c.QueryCtx(context.Background(), params)
+}
+
+func (c Client) QueryCtx(ctx context.Context, params map[string]interface{}) (chan StaticBrcode, chan Error.StarkErrors) {
+	//	Retrieve StaticBrcode structs
 	brcodes := make(chan StaticBrcode)
 	brcodesError := make(chan Error.StarkErrors)
-	query, errorChannel := utils.Query(resource, params, user)
+	query, errorChannel := c.Api.Stream(ctx, resource, params)
 	go func() {
+		defer close(brcodesError)
+		defer close(brcodes)
 		for content := range query {
-			contentByte, _ := json.Marshal(content)
-			err := json.Unmarshal(contentByte, &staticBrcode)
-			if err != nil {
-				brcodesError <- Error.UnknownError(err.Error())
+			var staticBrcode StaticBrcode
+			err := c.Api.Unmarshal(content, &staticBrcode)
+			if err.Errors != nil {
+				if !utils.SendError(ctx, brcodesError, err) {
+					return
+				}
 				continue
 			}
-			brcodes <- staticBrcode
+			select {
+			case brcodes <- staticBrcode:
+			case <-ctx.Done():
+				return
+			}
 		}
 		for err := range errorChannel {
-			brcodesError <- err
+			if !utils.SendError(ctx, brcodesError, err) {
+				return
+			}
 		}
-		close(brcodes)
-		close(brcodesError)
 	}()
 	return brcodes, brcodesError
 }
 
+//	StaticBrcode Iterator struct
+//
+//	Returned by Iterate to walk through StaticBrcode structs one at a time,
+//	requesting a new page only once the current one is exhausted. Check Err
+//	once Next returns false and Close it when leaving the loop early.
+
+type Iterator struct {
+	api   *utils.Client
+	pages *utils.Iterator
+	value StaticBrcode
+	err   Error.StarkErrors
+}
+
+func Iterate(params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve StaticBrcode structs
+	//
+	//	Same as Query, but returns an Iterator instead of a pair of channels
+	//
+	//	Example:
+	//	iterator := Iterate(params, nil)
+	//	defer iterator.Close()
+	//	for iterator.Next() {
+	//		fmt.Println(iterator.Value())
+	//	}
+	//	if err := iterator.Err(); err.Errors != nil {
+	//		...
+	//	}
+	return Client{Api: utils.Default(user)}.Iterate(params)
+}
+
+func IterateCtx(ctx context.Context, params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve StaticBrcode structs
+	//
+	//	Same as Iterate, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.IterateCtx(ctx, params)
+}
+
+func (c Client) Iterate(params map[string]interface{}) *Iterator {
+	//	Retrieve StaticBrcode structs
+	return c.IterateCtx(context.Background(), params)
+}
+
+func (c Client) IterateCtx(ctx context.Context, params map[string]interface{}) *Iterator {
+	//	Retrieve StaticBrcode structs
+	return &Iterator{api: c.Api, pages: c.Api.Iterate(ctx, resource, params)}
+}
+
+func (i *Iterator) Next() bool {
+	//	Move to the next StaticBrcode, returning false when there are no more or a request failed
+	var value StaticBrcode
+	if i.err.Errors != nil || !i.pages.Next() {
+		return false
+	}
+	i.err = i.api.Unmarshal(i.pages.Value(), &value)
+	if i.err.Errors != nil {
+		i.pages.Close()
+		return false
+	}
+	i.value = value
+	return true
+}
+
+func (i *Iterator) Value() StaticBrcode {
+	//	Current StaticBrcode, set by the last call to Next
+	return i.value
+}
+
+func (i *Iterator) Err() Error.StarkErrors {
+	//	Error that stopped the iteration, if any
+	if i.err.Errors != nil {
+		return i.err
+	}
+	return i.pages.Err()
+}
+
+func (i *Iterator) Close() {
+	//	Stop the iteration, releasing the current page
+	i.pages.Close()
+}
+
 func Page(params map[string]interface{}, user user.User) ([]StaticBrcode, string, Error.StarkErrors) {
 	//	Retrieve paged PixKey structs
 	//
@@ -155,11 +305,28 @@ func Page(params map[string]interface{}, user user.User) ([]StaticBrcode, string
 	//	Return:
 	//	- slice of StaticBrcode structs with updated attributes
 	//  - cursor to retrieve the next page of StaticBrcode structs
+	return Client{Api: utils.Default(user)}.Page(params)
+}
+
+func PageCtx(ctx context.Context, params map[string]interface{}, user user.User) ([]StaticBrcode, string, Error.StarkErrors) {
+	//	Retrieve paged PixKey structs
+	//
+	//	Same as Page, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.PageCtx(ctx, params)
+}
+
+func (c Client) Page(params map[string]interface{}) ([]StaticBrcode, string, Error.StarkErrors) {
+	//	Retrieve paged PixKey structs
+	return c.PageCtx(context.Background(), params)
+}
+
+func (c Client) PageCtx(ctx context.Context, params map[string]interface{}) ([]StaticBrcode, string, Error.StarkErrors) {
+	//	Retrieve paged PixKey structs
 	var staticBrcodes []StaticBrcode
-	page, cursor, err := utils.Page(resource, params, user)
-	unmarshalError := json.Unmarshal(page, &staticBrcodes)
-	if unmarshalError != nil {
+	page, cursor, err := c.Api.Page(ctx, resource, params)
+	if err.Errors != nil {
 		return staticBrcodes, cursor, err
 	}
+	err = c.Api.Unmarshal(page, &staticBrcodes)
 	return staticBrcodes, cursor, err
 }
@@ -0,0 +1,161 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	Errors "github.com/starkinfra/core-go/starkcore/error"
+	"github.com/starkinfra/core-go/starkcore/user/user"
+	"github.com/starkinfra/core-go/starkcore/utils/hosts"
+	"github.com/starkinfra/core-go/starkcore/utils/request"
+	"net/http"
+)
+
+//	Client struct
+//
+//	A Client holds every setting needed to talk to the Stark Infra API, so
+//	goroutines serving different workspaces or environments never share
+//	mutable state. The package-level functions of each resource build one
+//	from the starkinfra defaults on every call.
+//
+//	Attributes:
+//	- User [Organization/Project struct]: Organization or Project struct used to sign the requests
+//	- SdkVersion [string]: SDK version sent in the User-Agent header. ex: "1.2.0"
+//	- Host [string]: Stark host used to build the API url. ex: "infra"
+//	- ApiVersion [string]: API version used to build the API url. ex: "v2"
+//	- Language [string]: Language of the API error messages. ex: "en-US" or "pt-BR"
+//	- Timeout [int]: Request timeout in seconds. Ignored if HttpClient is set. ex: 15
+//	- HttpClient [*http.Client, default nil]: HTTP client used to send the requests. A new one is created on each request if nil
+//	- Retry [Retry struct, default no retries]: Retry policy for requests that failed with a timeout, a connection error, a 429 or a 5xx status
+//	- Middlewares [[]Middleware, default nil]: Middlewares wrapping every request, the first one being the outermost
+//	- Strict [bool, default false]: If true, response fields unknown to the SDK structs are reported as decodeErrors instead of being ignored
+//	- PublicKey [PublicKeyProvider, default nil]: Source of the Stark Infra public keys signed content is verified against. Fetched from the API and cached in memory if nil
+
+type Client struct {
+	User        user.User
+	SdkVersion  string
+	Host        string
+	ApiVersion  string
+	Language    string
+	Timeout     int
+	HttpClient  *http.Client
+	Retry       Retry
+	Middlewares []Middleware
+	Strict      bool
+	PublicKey   PublicKeyProvider
+}
+
+var SdkVersion = "1.2.0"
+
+// Defaults is set by the starkinfra package so that the package-level
+// functions keep reading starkinfra.User, starkinfra.Language and friends.
+var Defaults func() Client
+
+func Default(user user.User) *Client {
+	client := Client{
+		SdkVersion: SdkVersion,
+		Host:       hosts.Infra,
+		ApiVersion: "v2",
+		Language:   "pt-BR",
+		Timeout:    15,
+	}
+	if Defaults != nil {
+		client = Defaults()
+	}
+	if user != nil {
+		client.User = user
+	}
+	return &client
+}
+
+func (c *Client) orDefault() *Client {
+	if c == nil {
+		return Default(nil)
+	}
+	return c
+}
+
+func (c *Client) Page(ctx context.Context, resource map[string]string, params map[string]interface{}) ([]byte, string, Errors.StarkErrors) {
+	return c.orDefault().getPage(ctx, resource, params)
+}
+
+func (c *Client) Query(ctx context.Context, resource map[string]string, params map[string]interface{}) (chan map[string]interface{}, chan Errors.StarkErrors) {
+	stream, errorChannel := c.Stream(ctx, resource, params)
+	channel := make(chan map[string]interface{})
+	channelError := make(chan Errors.StarkErrors)
+	go func() {
+		defer close(channelError)
+		defer close(channel)
+		for content := range stream {
+			var data map[string]interface{}
+			if err := json.Unmarshal(content, &data); err != nil {
+				if !SendError(ctx, channelError, DecodeError(err.Error())) {
+					return
+				}
+				continue
+			}
+			select {
+			case channel <- data:
+			case <-ctx.Done():
+				return
+			}
+		}
+		for err := range errorChannel {
+			if !SendError(ctx, channelError, err) {
+				return
+			}
+		}
+	}()
+	return channel, channelError
+}
+
+func (c *Client) Stream(ctx context.Context, resource map[string]string, params map[string]interface{}) (chan json.RawMessage, chan Errors.StarkErrors) {
+	return c.orDefault().getStream(ctx, resource, params)
+}
+
+func (c *Client) Get(ctx context.Context, resource map[string]string, id string, query map[string]interface{}) ([]byte, Errors.StarkErrors) {
+	return c.orDefault().getId(ctx, resource, id, query)
+}
+
+func (c *Client) GetContent(ctx context.Context, resource map[string]string, id string, params map[string]interface{}, content string) ([]byte, Errors.StarkErrors) {
+	return c.orDefault().getContent(ctx, resource, id, content, params)
+}
+
+func (c *Client) SubResource(ctx context.Context, resource map[string]string, id string, subResource map[string]string) ([]byte, Errors.StarkErrors) {
+	return c.orDefault().getSubResource(ctx, resource, id, subResource, nil)
+}
+
+func (c *Client) Multi(ctx context.Context, resource map[string]string, entities interface{}, query map[string]interface{}) ([]byte, Errors.StarkErrors) {
+	return c.orDefault().postMulti(ctx, resource, entities, query)
+}
+
+func (c *Client) Single(ctx context.Context, resource map[string]string, entity interface{}) ([]byte, Errors.StarkErrors) {
+	return c.orDefault().postSingle(ctx, resource, entity, nil)
+}
+
+func (c *Client) Delete(ctx context.Context, resource map[string]string, id string) ([]byte, Errors.StarkErrors) {
+	return c.orDefault().deleteId(ctx, resource, id, nil)
+}
+
+func (c *Client) Patch(ctx context.Context, resource map[string]string, id string, payload map[string]interface{}) ([]byte, Errors.StarkErrors) {
+	return c.orDefault().patchId(ctx, resource, id, payload, nil)
+}
+
+func (c *Client) GetRaw(ctx context.Context, path string, query map[string]interface{}, prefix string, throwError bool) (request.Response, Errors.StarkErrors) {
+	return c.orDefault().fetch(ctx, "GET", path, nil, query, prefix, throwError)
+}
+
+func (c *Client) PostRaw(ctx context.Context, path string, body interface{}, query map[string]interface{}, prefix string, throwError bool) (request.Response, Errors.StarkErrors) {
+	return c.orDefault().fetch(ctx, "POST", path, body, query, prefix, throwError)
+}
+
+func (c *Client) PatchRaw(ctx context.Context, path string, body interface{}, query map[string]interface{}, prefix string, throwError bool) (request.Response, Errors.StarkErrors) {
+	return c.orDefault().fetch(ctx, "PATCH", path, body, query, prefix, throwError)
+}
+
+func (c *Client) PutRaw(ctx context.Context, path string, body interface{}, query map[string]interface{}, prefix string, throwError bool) (request.Response, Errors.StarkErrors) {
+	return c.orDefault().fetch(ctx, "PUT", path, body, query, prefix, throwError)
+}
+
+func (c *Client) DeleteRaw(ctx context.Context, path string, prefix string, throwError bool) (request.Response, Errors.StarkErrors) {
+	return c.orDefault().fetch(ctx, "DELETE", path, nil, nil, prefix, throwError)
+}
@@ -0,0 +1,163 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	Errors "github.com/starkinfra/core-go/starkcore/error"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+func DecodeError(message string) Errors.StarkErrors {
+	return Errors.StarkErrors{
+		Errors: []Errors.StarkError{{
+			Code:    "decodeError",
+			Message: fmt.Sprintf("Could not decode the API response: %v", message),
+		}},
+	}
+}
+
+func Unmarshal(data []byte, v interface{}) Errors.StarkErrors {
+	return Default(nil).Unmarshal(data, v)
+}
+
+func (c *Client) Unmarshal(data []byte, v interface{}) Errors.StarkErrors {
+
+	//	Decode JSON data into v, keeping the keys no struct field takes in its
+	//	Extra field and reporting them instead if the Client is Strict
+	//
+	//	Return:
+	//	- a decodeError if data does not fit v
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	if c.orDefault().Strict {
+		decoder.DisallowUnknownFields()
+	}
+	if err := decoder.Decode(v); err != nil {
+		return DecodeError(err.Error())
+	}
+	keepExtra(reflect.ValueOf(v), data)
+	return Errors.StarkErrors{}
+}
+
+func field(content []byte, key string) ([]byte, Errors.StarkErrors) {
+	var data map[string]json.RawMessage
+	if err := json.Unmarshal(content, &data); err != nil {
+		return nil, DecodeError(err.Error())
+	}
+	value, ok := data[key]
+	if !ok {
+		return nil, DecodeError(fmt.Sprintf("the response has no %v field", key))
+	}
+	return value, Errors.StarkErrors{}
+}
+
+var extraType = reflect.TypeOf(map[string]json.RawMessage{})
+
+type structFields struct {
+	names     map[string]int
+	extra     int
+	recursive bool
+}
+
+var fieldCache sync.Map
+
+func fieldsOf(t reflect.Type) *structFields {
+	if cached, ok := fieldCache.Load(t); ok {
+		return cached.(*structFields)
+	}
+	fields := &structFields{names: map[string]int{}, extra: -1}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+		if field.Name == "Extra" && field.Type == extraType {
+			fields.extra = i
+			continue
+		}
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		fields.names[strings.ToLower(name)] = i
+		if mayHoldExtra(field.Type, map[reflect.Type]bool{t: true}) {
+			fields.recursive = true
+		}
+	}
+	fieldCache.Store(t, fields)
+	return fields
+}
+
+func mayHoldExtra(t reflect.Type, seen map[reflect.Type]bool) bool {
+	for t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct || seen[t] {
+		return false
+	}
+	seen[t] = true
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.Name == "Extra" && field.Type == extraType {
+			return true
+		}
+		if field.PkgPath == "" && mayHoldExtra(field.Type, seen) {
+			return true
+		}
+	}
+	return false
+}
+
+func keepExtra(value reflect.Value, data []byte) {
+
+	//	Fill the Extra field of every struct in value with the JSON keys of
+	//	data that none of the struct fields took
+	switch value.Kind() {
+	case reflect.Ptr:
+		if !value.IsNil() {
+			keepExtra(value.Elem(), data)
+		}
+	case reflect.Slice, reflect.Array:
+		if !mayHoldExtra(value.Type(), map[reflect.Type]bool{}) {
+			return
+		}
+		var items []json.RawMessage
+		if json.Unmarshal(data, &items) != nil {
+			return
+		}
+		for i := 0; i < value.Len() && i < len(items); i++ {
+			keepExtra(value.Index(i), items[i])
+		}
+	case reflect.Struct:
+		fields := fieldsOf(value.Type())
+		if fields.extra < 0 && !fields.recursive {
+			return
+		}
+		var content map[string]json.RawMessage
+		if json.Unmarshal(data, &content) != nil {
+			return
+		}
+		extra := map[string]json.RawMessage{}
+		for key, raw := range content {
+			index, ok := fields.names[strings.ToLower(key)]
+			if !ok {
+				extra[key] = raw
+				continue
+			}
+			if fields.recursive {
+				keepExtra(value.Field(index), raw)
+			}
+		}
+		if fields.extra >= 0 {
+			value.Field(fields.extra).Set(reflect.Zero(extraType))
+			if len(extra) > 0 {
+				value.Field(fields.extra).Set(reflect.ValueOf(extra))
+			}
+		}
+	}
+}
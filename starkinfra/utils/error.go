@@ -0,0 +1,128 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	Errors "github.com/starkinfra/core-go/starkcore/error"
+	"net"
+	"strings"
+)
+
+//	Error kinds of the errors built by ToError, exported as starkinfra.ErrInput and the others
+
+var ErrInput = errors.New("starkinfra: input error")
+var ErrInternalServer = errors.New("starkinfra: internal server error")
+var ErrUnknown = errors.New("starkinfra: unknown error")
+var ErrInvalidSignature = errors.New("starkinfra: invalid signature")
+var ErrTimeout = errors.New("starkinfra: timeout")
+var ErrRateLimited = errors.New("starkinfra: rate limited")
+var ErrDecode = errors.New("starkinfra: decode error")
+
+//	Error struct
+//
+//	Go error built by ToError, exported as starkinfra.Error.
+
+type Error struct {
+	Kind   error
+	Errors []Errors.StarkError
+}
+
+func ToError(err Errors.StarkErrors) error {
+
+	//	Convert the Error.StarkErrors returned by the SDK functions into a Go error, or nil if it holds no errors
+	if len(err.Errors) == 0 {
+		return nil
+	}
+	return &Error{Kind: kind(err.Errors[0].Code), Errors: err.Errors}
+}
+
+func (e *Error) Error() string {
+	var messages []string
+	for _, err := range e.Errors {
+		messages = append(messages, fmt.Sprintf("%v: %v", err.Code, err.Message))
+	}
+	return fmt.Sprintf("%v: %v", e.Kind, strings.Join(messages, "; "))
+}
+
+func (e *Error) Unwrap() error {
+	return e.Kind
+}
+
+func (e *Error) Has(code string) bool {
+
+	//	Tell whether any of the individual errors has the given code
+	//
+	//	Parameters (required):
+	//	- code [string]: Error code. ex: starkinfra.CodeInsufficientBalance
+	for _, err := range e.Errors {
+		if err.Code == code {
+			return true
+		}
+	}
+	return false
+}
+
+func kind(code string) error {
+	switch code {
+	case "internalServerError":
+		return ErrInternalServer
+	case "unknownError":
+		return ErrUnknown
+	case "invalidSignatureError":
+		return ErrInvalidSignature
+	case "timeoutError":
+		return ErrTimeout
+	case "rateLimitError":
+		return ErrRateLimited
+	case "decodeError":
+		return ErrDecode
+	}
+	return ErrInput
+}
+
+func TimeoutError(message string) Errors.StarkErrors {
+	return Errors.StarkErrors{
+		Errors: []Errors.StarkError{{
+			Code:    "timeoutError",
+			Message: fmt.Sprintf("Request timed out: %v", message),
+		}},
+	}
+}
+
+func RateLimitError(message string) Errors.StarkErrors {
+	return Errors.StarkErrors{
+		Errors: []Errors.StarkError{{
+			Code:    "rateLimitError",
+			Message: fmt.Sprintf("Too many requests: %v", message),
+		}},
+	}
+}
+
+func transportError(ctx context.Context, err error) Errors.StarkErrors {
+	var netError net.Error
+	if errors.Is(err, context.DeadlineExceeded) || (errors.As(err, &netError) && netError.Timeout()) {
+		return TimeoutError(err.Error())
+	}
+	if ctx.Err() == context.DeadlineExceeded {
+		return TimeoutError(ctx.Err().Error())
+	}
+	return Errors.UnknownError(err.Error())
+}
+
+func ContextError(ctx context.Context) Errors.StarkErrors {
+
+	//	Convert the error of a done context into the error returned by the SDK functions
+	return transportError(ctx, ctx.Err())
+}
+
+func ValidationError(message string) Errors.StarkErrors {
+
+	//	Error returned when the SDK refuses an input before sending any request
+	return Errors.StarkErrors{
+		Errors: []Errors.StarkError{{
+			Code:    "inputError",
+			Message: message,
+		}},
+	}
+}
@@ -0,0 +1,103 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	Errors "github.com/starkinfra/core-go/starkcore/error"
+	"strconv"
+)
+
+//	Iterator struct
+//
+//	Walks through the entities of a resource one page at a time, following
+//	the cursor of each page. The next page is only requested once the current
+//	one is exhausted, and no goroutine is involved, so an Iterator that is left
+//	early holds nothing but its current page. Close drops it and stops any
+//	further request.
+
+type Iterator struct {
+	client    *Client
+	ctx       context.Context
+	resource  map[string]string
+	query     map[string]interface{}
+	remaining int
+	items     []json.RawMessage
+	value     json.RawMessage
+	done      bool
+	err       Errors.StarkErrors
+}
+
+func (c *Client) Iterate(ctx context.Context, resource map[string]string, params map[string]interface{}) *Iterator {
+	query := make(map[string]interface{})
+	for k, v := range params {
+		query[k] = v
+	}
+	limit, _ := strconv.Atoi(fmt.Sprintf("%v", params["limit"]))
+	return &Iterator{client: c.orDefault(), ctx: ctx, resource: resource, query: query, remaining: limit}
+}
+
+func (i *Iterator) Next() bool {
+
+	//	Move to the next entity, requesting the next page if needed
+	//
+	//	Return:
+	//	- false once there are no more entities, the Iterator was closed or a request failed, in which case Err tells why
+	for len(i.items) == 0 {
+		if i.done {
+			i.value = nil
+			return false
+		}
+		i.fetch()
+	}
+	i.value, i.items = i.items[0], i.items[1:]
+	return true
+}
+
+func (i *Iterator) Value() json.RawMessage {
+	return i.value
+}
+
+func (i *Iterator) Err() Errors.StarkErrors {
+	return i.err
+}
+
+func (i *Iterator) Close() {
+	i.done = true
+	i.items = nil
+	i.value = nil
+}
+
+func (i *Iterator) fetch() {
+	i.query["limit"] = nil
+	if i.remaining > 0 {
+		i.query["limit"] = i.remaining
+		if i.remaining > 100 {
+			i.query["limit"] = 100
+		}
+	}
+	entities, cursor, err := i.client.getPage(i.ctx, i.resource, i.query)
+	if err.Errors != nil {
+		i.err = err
+		i.done = true
+		return
+	}
+	var items []json.RawMessage
+	if unmarshalError := json.Unmarshal(entities, &items); unmarshalError != nil {
+		i.err = DecodeError(unmarshalError.Error())
+		i.done = true
+		return
+	}
+	if i.remaining > 0 {
+		if len(items) > i.remaining {
+			items = items[:i.remaining]
+		}
+		i.remaining -= len(items)
+		i.done = i.remaining == 0
+	}
+	i.items = items
+	i.query["cursor"] = cursor
+	if cursor == "" {
+		i.done = true
+	}
+}
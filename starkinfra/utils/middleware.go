@@ -0,0 +1,111 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	Url "github.com/starkinfra/core-go/starkcore/utils/url"
+	"io"
+	"net/http"
+	"strings"
+)
+
+//	Request struct
+//
+//	Outgoing request as seen by a Middleware. Changes made by a middleware are
+//	seen by the following ones and are signed and sent as they are.
+//
+//	Attributes:
+//	- Context [context.Context]: Context the request is bound to
+//	- Method [string]: HTTP method. ex: "POST"
+//	- BaseUrl [string]: API url the path is appended to. Change it to point the SDK at another server. ex: "https://sandbox.api.starkinfra.com/v2"
+//	- Path [string]: Resource path, without a leading slash. ex: "pix-request/5155165527080960"
+//	- Query [map[string]interface{}]: Query parameters, encoded the same way the SDK encodes them. ex: map[string]interface{}{"limit": 10}
+//	- Body [string]: JSON body, or "" if the request has none
+//	- Header [http.Header]: Headers other than the authentication ones, which are added when the request is sent
+
+type Request struct {
+	Context context.Context
+	Method  string
+	BaseUrl string
+	Path    string
+	Query   map[string]interface{}
+	Body    string
+	Header  http.Header
+}
+
+//	Response struct
+//
+//	Incoming response as seen by a Middleware.
+//
+//	Attributes:
+//	- Status [int]: HTTP status code. ex: 200
+//	- Header [http.Header]: Response headers
+//	- Content [[]byte]: Response body
+
+type Response struct {
+	Status  int
+	Header  http.Header
+	Content []byte
+}
+
+//	Handler func
+//
+//	Sends a Request and returns its Response. An error means no response was
+//	received at all, as with a connection failure.
+
+type Handler func(request *Request) (*Response, error)
+
+//	Middleware func
+//
+//	Wraps the Handler that sends each request of a Client, whether it comes
+//	from a resource function or from GetRaw, PostRaw, PatchRaw, PutRaw or
+//	DeleteRaw. A middleware may inspect or change the request, call next zero
+//	or more times and inspect or replace the response. Retried requests go
+//	through the whole chain again on every attempt.
+//
+//	Example:
+//	func Logger(next utils.Handler) utils.Handler {
+//		return func(request *utils.Request) (*utils.Response, error) {
+//			response, err := next(request)
+//			if err == nil {
+//				log.Printf("%v %v: %v", request.Method, request.Path, response.Status)
+//			}
+//			return response, err
+//		}
+//	}
+
+type Middleware func(next Handler) Handler
+
+func (c *Client) handler() Handler {
+	handler := c.do
+	for i := len(c.Middlewares) - 1; i >= 0; i-- {
+		handler = c.Middlewares[i](handler)
+	}
+	return handler
+}
+
+func (c *Client) do(request *Request) (*Response, error) {
+	url := fmt.Sprintf("%v/%v%v", strings.TrimSuffix(request.BaseUrl, "/"), strings.TrimPrefix(request.Path, "/"), Url.UrlEncode(request.Query))
+	req, err := http.NewRequestWithContext(request.Context, request.Method, url, strings.NewReader(request.Body))
+	if err != nil {
+		return nil, err
+	}
+	for key, values := range request.Header {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+	authenticationHeaders(c.User, request.Body, req)
+
+	rawResponse, err := c.httpClient().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer rawResponse.Body.Close()
+
+	content, err := io.ReadAll(rawResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+	return &Response{Status: rawResponse.StatusCode, Header: rawResponse.Header, Content: content}, nil
+}
@@ -0,0 +1,31 @@
+package utils
+
+import (
+	"reflect"
+	"strings"
+	"time"
+)
+
+func ParamsMap(params interface{}) map[string]interface{} {
+
+	//	Convert a QueryParams struct into the map taken by the Query, Iterate and Page functions
+	//
+	//	Each field is sent under the name in its json tag. Zero values are left
+	//	out, pointers are dereferenced and time.Time values become "2006-01-02" dates.
+	query := map[string]interface{}{}
+	value := reflect.ValueOf(params)
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if name == "" || name == "-" || value.Field(i).IsZero() {
+			continue
+		}
+		switch data := reflect.Indirect(value.Field(i)).Interface().(type) {
+		case time.Time:
+			query[name] = data.Format("2006-01-02")
+		default:
+			query[name] = data
+		}
+	}
+	return query
+}
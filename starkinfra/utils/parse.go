@@ -1,43 +1,70 @@
 package utils
 
 import (
-	"strings"
-	"github.com/starkinfra/core-go/starkcore/user/user"
+	"context"
+	"github.com/starkbank/ecdsa-go/v2/ellipticcurve/ecdsa"
+	"github.com/starkbank/ecdsa-go/v2/ellipticcurve/publickey"
+	Signature "github.com/starkbank/ecdsa-go/v2/ellipticcurve/signature"
 	Errors "github.com/starkinfra/core-go/starkcore/error"
-	"github.com/starkinfra/core-go/starkcore/utils/parse"
-	"github.com/starkinfra/sdk-go/starkinfra"
+	"github.com/starkinfra/core-go/starkcore/user/user"
+	"strings"
 )
 
 func ParseAndVerify(content string, signature string, key string, user user.User) (string, Errors.StarkErrors) {
-	if user == nil {
-		response, err := parse.ParseAndVerify(content, signature, starkinfra.SdkVersion, starkinfra.ApiVersion, starkinfra.Language, starkinfra.Timeout, starkinfra.Host, starkinfra.User, key)
-		if err.Errors != nil {
-			return "", err
-		}
-		return response.(string), err
+	return Default(user).ParseAndVerify(context.Background(), content, signature, key)
+}
+
+func Verify(content string, signature string, user user.User) (string, Errors.StarkErrors) {
+	return Default(user).Verify(context.Background(), content, signature)
+}
+
+func ReplaceEmptyStringField(jsonStr, pattern, replacement string) string {
+	return strings.ReplaceAll(jsonStr, pattern, replacement)
+}
+
+func (c *Client) ParseAndVerify(ctx context.Context, content string, signature string, key string) (string, Errors.StarkErrors) {
+	return c.Verify(ctx, content, signature)
+}
+
+func (c *Client) Verify(ctx context.Context, content string, signature string) (string, Errors.StarkErrors) {
+	c = c.orDefault()
+	signatureFromBase64, ok := decodeSignature(signature)
+	if !ok || signatureFromBase64.ToBase64() == "" {
+		return "", Errors.InvalidSignatureError("The provided signature is not valid")
 	}
-	response, err := parse.ParseAndVerify(content, signature, starkinfra.SdkVersion, starkinfra.ApiVersion, starkinfra.Language, starkinfra.Timeout, starkinfra.Host, user, key)
+
+	keys, err := c.publicKeys(ctx, false, nil)
 	if err.Errors != nil {
 		return "", err
 	}
-	return response.(string), err
-}
-
-func Verify(content string, signature string, user user.User) (string, Errors.StarkErrors) {
-	if user == nil {
-		response, err := parse.Verify(content, signature, starkinfra.SdkVersion, starkinfra.ApiVersion, starkinfra.Language, starkinfra.Timeout, starkinfra.Host, starkinfra.User)
-		if err.Errors != nil {
-			return "", err
-		}
-		return response.(string), err
+	if verify(content, signatureFromBase64, keys) {
+		return content, Errors.StarkErrors{}
 	}
-	response, err := parse.Verify(content, signature, starkinfra.SdkVersion, starkinfra.ApiVersion, starkinfra.Language, starkinfra.Timeout, starkinfra.Host, user)
+
+	keys, err = c.publicKeys(ctx, true, keys)
 	if err.Errors != nil {
 		return "", err
 	}
-	return response.(string), err
+	if verify(content, signatureFromBase64, keys) {
+		return content, Errors.StarkErrors{}
+	}
+	return "", Errors.InvalidSignatureError("The provided signature and content do not match the public key")
 }
 
-func ReplaceEmptyStringField(jsonStr, pattern, replacement string) string {
-	return strings.ReplaceAll(jsonStr, pattern, replacement)
+func decodeSignature(signature string) (decoded Signature.Signature, ok bool) {
+	defer func() {
+		if recover() != nil {
+			ok = false
+		}
+	}()
+	return Signature.FromBase64(signature), true
+}
+
+func verify(content string, signature Signature.Signature, keys []publickey.PublicKey) bool {
+	for _, key := range keys {
+		if ecdsa.Verify(content, signature, &key) {
+			return true
+		}
+	}
+	return false
 }
@@ -0,0 +1,158 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/starkbank/ecdsa-go/v2/ellipticcurve/publickey"
+	Errors "github.com/starkinfra/core-go/starkcore/error"
+	"sync"
+)
+
+//	PublicKeyProvider interface
+//
+//	Source of the Stark Infra public keys that signed content, such as webhook
+//	Events, authorization requests and DynamicBrcode reads, is verified against.
+//	Content is accepted if any of the keys checks out. When none does, the keys
+//	are asked for again with refresh set, so a provider that caches them can
+//	pick up a rotated key before the content is refused.
+//
+//	By default, the keys are fetched from the API and cached in memory. Set
+//	Client.PublicKey to a StaticPublicKey to verify content without any request.
+
+type PublicKeyProvider interface {
+	PublicKeys(ctx context.Context, refresh bool) ([]publickey.PublicKey, error)
+}
+
+//	StaticPublicKey struct
+//
+//	PublicKeyProvider holding fixed keys, such as the Stark Infra public key
+//	distributed to an environment with no access to the API, or a test key.
+//	Give it both the current and the next key to keep verifying through a rotation.
+//
+//	Attributes:
+//	- Keys [slice of publickey.PublicKey]: Keys content is verified against
+
+type StaticPublicKey struct {
+	Keys []publickey.PublicKey
+}
+
+func NewStaticPublicKey(pems ...string) (StaticPublicKey, Errors.StarkErrors) {
+
+	//	Create a StaticPublicKey from PEM strings
+	//
+	//	Parameters (required):
+	//	- pems [strings]: PEM encoded public keys. ex: "-----BEGIN PUBLIC KEY-----\nMFYwEAYHKoZIzj0CAQYFK4EEAAoDQgAE..."
+	//
+	//	Return:
+	//	- StaticPublicKey struct, or an inputError if no key was given or one of them is not a valid PEM public key
+	if len(pems) == 0 {
+		return StaticPublicKey{}, ValidationError("At least one public key must be given")
+	}
+	var keys []publickey.PublicKey
+	for _, pem := range pems {
+		key, ok := decodePublicKey(pem)
+		if !ok {
+			return StaticPublicKey{}, ValidationError(fmt.Sprintf("Invalid PEM public key: %q", pem))
+		}
+		keys = append(keys, key)
+	}
+	return StaticPublicKey{Keys: keys}, Errors.StarkErrors{}
+}
+
+func (s StaticPublicKey) PublicKeys(ctx context.Context, refresh bool) ([]publickey.PublicKey, error) {
+	return s.Keys, nil
+}
+
+var publicKeys = struct {
+	sync.Mutex
+	fetching sync.Mutex
+	cache    map[string][]publickey.PublicKey
+}{cache: map[string][]publickey.PublicKey{}}
+
+func (c *Client) publicKeys(ctx context.Context, refresh bool, stale []publickey.PublicKey) ([]publickey.PublicKey, Errors.StarkErrors) {
+	if c.PublicKey != nil {
+		keys, err := c.PublicKey.PublicKeys(ctx, refresh)
+		if err != nil {
+			return nil, Errors.UnknownError(fmt.Sprintf("Public key provider failed: %v", err))
+		}
+		return keys, Errors.StarkErrors{}
+	}
+
+	cacheKey := c.Host
+	if c.User != nil {
+		cacheKey = fmt.Sprintf("%v-%v", c.User.GetEnvironment(), c.Host)
+	}
+	if keys, ok := cachedPublicKeys(cacheKey, refresh, stale); ok {
+		return keys, Errors.StarkErrors{}
+	}
+
+	// Concurrent cold starts and refreshes after a rotation wait for a single
+	// fetch instead of each sending its own request.
+	publicKeys.fetching.Lock()
+	defer publicKeys.fetching.Unlock()
+	if keys, ok := cachedPublicKeys(cacheKey, refresh, stale); ok {
+		return keys, Errors.StarkErrors{}
+	}
+
+	keys, err := c.fetchPublicKeys(ctx)
+	if err.Errors != nil {
+		return nil, err
+	}
+	publicKeys.Lock()
+	publicKeys.cache[cacheKey] = keys
+	publicKeys.Unlock()
+	return keys, Errors.StarkErrors{}
+}
+
+func cachedPublicKeys(cacheKey string, refresh bool, stale []publickey.PublicKey) ([]publickey.PublicKey, bool) {
+	publicKeys.Lock()
+	defer publicKeys.Unlock()
+	keys, ok := publicKeys.cache[cacheKey]
+	if !ok || (refresh && samePublicKeys(keys, stale)) {
+		return nil, false
+	}
+	return keys, true
+}
+
+func samePublicKeys(keys []publickey.PublicKey, others []publickey.PublicKey) bool {
+	if len(keys) != len(others) {
+		return false
+	}
+	for i := range keys {
+		if keys[i].ToPem() != others[i].ToPem() {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *Client) fetchPublicKeys(ctx context.Context) ([]publickey.PublicKey, Errors.StarkErrors) {
+	response, err := c.fetch(ctx, "GET", "public-key", nil, map[string]interface{}{"limit": 1}, "", true)
+	if err.Errors != nil {
+		return nil, err
+	}
+	var data struct {
+		PublicKeys []struct {
+			Content string
+		}
+	}
+	unmarshalError := json.Unmarshal(response.Content, &data)
+	if unmarshalError != nil || len(data.PublicKeys) == 0 {
+		return nil, DecodeError(string(response.Content))
+	}
+	key, ok := decodePublicKey(data.PublicKeys[0].Content)
+	if !ok {
+		return nil, DecodeError(string(response.Content))
+	}
+	return []publickey.PublicKey{key}, Errors.StarkErrors{}
+}
+
+func decodePublicKey(pem string) (decoded publickey.PublicKey, ok bool) {
+	defer func() {
+		if recover() != nil {
+			ok = false
+		}
+	}()
+	return publickey.FromPem(pem), true
+}
@@ -0,0 +1,244 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/starkbank/ecdsa-go/v2/ellipticcurve/ecdsa"
+	"github.com/starkinfra/core-go/starkcore/environment"
+	Errors "github.com/starkinfra/core-go/starkcore/error"
+	"github.com/starkinfra/core-go/starkcore/user/user"
+	"github.com/starkinfra/core-go/starkcore/utils/api"
+	"github.com/starkinfra/core-go/starkcore/utils/checks"
+	"github.com/starkinfra/core-go/starkcore/utils/request"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+func (c *Client) fetch(ctx context.Context, method string, path string, payload interface{}, query map[string]interface{}, prefix string, throwError bool) (request.Response, Errors.StarkErrors) {
+	response, err, _ := c.send(ctx, method, path, payload, query, prefix, throwError)
+	return response, err
+}
+
+func (c *Client) send(ctx context.Context, method string, path string, payload interface{}, query map[string]interface{}, prefix string, throwError bool) (request.Response, Errors.StarkErrors, bool) {
+	retryable, err := c.Retry.allows(method, payload)
+	if err.Errors != nil {
+		return request.Response{}, err, false
+	}
+	for attempt := 0; ; attempt++ {
+		response, err, transient := c.attempt(ctx, method, path, payload, query, prefix, throwError)
+		if !transient || !retryable || attempt >= c.Retry.Attempts || !c.Retry.wait(ctx, attempt) {
+			return response, err, attempt > 0
+		}
+	}
+}
+
+func (c *Client) attempt(ctx context.Context, method string, path string, payload interface{}, query map[string]interface{}, prefix string, throwError bool) (request.Response, Errors.StarkErrors, bool) {
+	var url string
+	var body string
+	if c.User == nil {
+		return request.Response{}, Errors.UnknownError("No user was provided. Set starkinfra.User or pass a user to the Client"), false
+	}
+	language, languageErr := checks.CheckLanguage(c.Language)
+	if languageErr.Errors != nil {
+		return request.Response{}, languageErr, false
+	}
+
+	if payload != nil && payload != "" {
+		bytes, err := json.Marshal(payload)
+		if err != nil {
+			return request.Response{}, Errors.InputError(fmt.Sprintf("The request payload could not be encoded: %v", err)), false
+		}
+		body = string(bytes)
+	}
+
+	switch c.User.GetEnvironment() {
+	case environment.Environments.Production:
+		url = fmt.Sprintf("https://api.stark%v.com/%v", c.Host, c.ApiVersion)
+	case environment.Environments.Sandbox:
+		url = fmt.Sprintf("https://sandbox.api.stark%v.com/%v", c.Host, c.ApiVersion)
+	}
+
+	agent := fmt.Sprintf("Go-SDK-%v-%v", c.Host, c.SdkVersion)
+	if prefix != "" {
+		agent = fmt.Sprintf("%v-Go-SDK-%v-%v", prefix, c.Host, c.SdkVersion)
+	}
+	header := http.Header{}
+	header.Add("User-Agent", agent)
+	header.Add("Accept-Language", language)
+	header.Add("Content-Type", "application/json")
+
+	rawResponse, err := c.handler()(&Request{
+		Context: ctx,
+		Method:  method,
+		BaseUrl: url,
+		Path:    strings.TrimPrefix(path, "/"),
+		Query:   query,
+		Body:    body,
+		Header:  header,
+	})
+	if err != nil {
+		return request.Response{}, transportError(ctx, err), ctx.Err() == nil
+	}
+
+	response := request.Response{Status: rawResponse.Status, Content: rawResponse.Content}
+	transient := response.Status == 429 || response.Status >= 500
+
+	if throwError {
+		if response.Status == 400 {
+			return request.Response{}, Errors.InputError(string(response.Content)), transient
+		}
+		if response.Status == 500 {
+			return request.Response{}, Errors.InternalServerError(), transient
+		}
+		if response.Status == 429 {
+			return request.Response{}, RateLimitError(string(response.Content)), transient
+		}
+		if response.Status != 200 {
+			return request.Response{}, Errors.UnknownError(string(response.Content)), transient
+		}
+	}
+	return response, Errors.StarkErrors{}, transient
+}
+
+func (c *Client) httpClient() *http.Client {
+	if c.HttpClient != nil {
+		return c.HttpClient
+	}
+	return &http.Client{Timeout: time.Duration(c.Timeout) * time.Second}
+}
+
+func authenticationHeaders(user user.User, body string, req *http.Request) {
+	if reflect.TypeOf(user).Name() == "PublicUser" {
+		return
+	}
+	accessTime := strconv.FormatInt(time.Now().Unix(), 10)
+	message := fmt.Sprintf("%v:%v:%v", user.GetAcessId(), accessTime, body)
+	signature := ecdsa.Sign(message, user.GetPrivateKey()).ToBase64()
+	req.Header.Add("Access-Id", user.GetAcessId())
+	req.Header.Add("Access-Time", accessTime)
+	req.Header.Add("Access-Signature", signature)
+}
+
+func (c *Client) fetchEntity(ctx context.Context, method string, path string, payload interface{}, query map[string]interface{}, key string) ([]byte, Errors.StarkErrors) {
+	response, err := c.fetch(ctx, method, path, payload, query, "", true)
+	if err.Errors != nil {
+		return nil, err
+	}
+	return field(response.Content, key)
+}
+
+func (c *Client) getPage(ctx context.Context, resource map[string]string, query map[string]interface{}) ([]byte, string, Errors.StarkErrors) {
+	var page struct {
+		Cursor string
+	}
+	response, err := c.fetch(ctx, "GET", api.Endpoint(resource), nil, query, "", true)
+	if err.Errors != nil {
+		return nil, "", err
+	}
+	entities, err := field(response.Content, api.LastNamePlural(resource))
+	if err.Errors != nil {
+		return nil, "", err
+	}
+	if err := json.Unmarshal(response.Content, &page); err != nil {
+		return nil, "", DecodeError(err.Error())
+	}
+	return entities, page.Cursor, err
+}
+
+func (c *Client) getStream(ctx context.Context, resource map[string]string, query map[string]interface{}) (chan json.RawMessage, chan Errors.StarkErrors) {
+	channel := make(chan json.RawMessage)
+	errorChannel := make(chan Errors.StarkErrors, 1)
+	iterator := c.Iterate(ctx, resource, query)
+
+	go func() {
+		defer close(channel)
+		defer close(errorChannel)
+		for iterator.Next() {
+			select {
+			case channel <- iterator.Value():
+			case <-ctx.Done():
+				errorChannel <- transportError(ctx, ctx.Err())
+				return
+			}
+		}
+		if err := iterator.Err(); err.Errors != nil {
+			errorChannel <- err
+		}
+	}()
+	return channel, errorChannel
+}
+
+func (c *Client) getId(ctx context.Context, resource map[string]string, id string, query map[string]interface{}) ([]byte, Errors.StarkErrors) {
+	return c.fetchEntity(ctx, "GET", fmt.Sprintf("%v/%v", api.Endpoint(resource), id), nil, query, api.LastName(resource))
+}
+
+func (c *Client) getContent(ctx context.Context, resource map[string]string, id string, subResourceName string, query map[string]interface{}) ([]byte, Errors.StarkErrors) {
+	response, err := c.fetch(ctx, "GET", fmt.Sprintf("%v/%v/%v", api.Endpoint(resource), id, subResourceName), nil, query, "", true)
+	if err.Errors != nil {
+		return nil, err
+	}
+	return response.Content, err
+}
+
+func (c *Client) getSubResource(ctx context.Context, resource map[string]string, id string, subResource map[string]string, query map[string]interface{}) ([]byte, Errors.StarkErrors) {
+	path := fmt.Sprintf("%v/%v/%v", api.Endpoint(resource), id, api.Endpoint(subResource))
+	return c.fetchEntity(ctx, "GET", path, nil, query, api.LastName(subResource))
+}
+
+func (c *Client) postMulti(ctx context.Context, resource map[string]string, entities interface{}, query map[string]interface{}) ([]byte, Errors.StarkErrors) {
+	payload := api.ApiJson(entities, resource)
+	response, err, retried := c.send(ctx, "POST", api.Endpoint(resource), payload, query, "", true)
+	if err.Errors != nil {
+		if replayed, ok := c.replay(ctx, resource, payload, err, retried); ok {
+			return encodeReplayed(replayed)
+		}
+		return nil, err
+	}
+	return field(response.Content, api.LastNamePlural(resource))
+}
+
+func (c *Client) postSingle(ctx context.Context, resource map[string]string, entity interface{}, query map[string]interface{}) ([]byte, Errors.StarkErrors) {
+	payload := api.ApiJson(entity, resource)
+	response, err, retried := c.send(ctx, "POST", api.Endpoint(resource), payload, query, "", true)
+	if err.Errors != nil {
+		if replayed, ok := c.replay(ctx, resource, payload, err, retried); ok {
+			return encodeReplayed(replayed[0])
+		}
+		return nil, err
+	}
+	return field(response.Content, api.LastName(resource))
+}
+
+func encodeReplayed(replayed interface{}) ([]byte, Errors.StarkErrors) {
+	jsonBytes, err := json.Marshal(replayed)
+	if err != nil {
+		return nil, Errors.UnknownError(fmt.Sprintf("The replayed entities could not be encoded: %v", err))
+	}
+	return jsonBytes, Errors.StarkErrors{}
+}
+
+func (c *Client) deleteId(ctx context.Context, resource map[string]string, id string, query map[string]interface{}) ([]byte, Errors.StarkErrors) {
+	return c.fetchEntity(ctx, "DELETE", fmt.Sprintf("%v/%v", api.Endpoint(resource), id), nil, query, api.LastName(resource))
+}
+
+func (c *Client) patchId(ctx context.Context, resource map[string]string, id string, payload interface{}, query map[string]interface{}) ([]byte, Errors.StarkErrors) {
+	return c.fetchEntity(ctx, "PATCH", fmt.Sprintf("%v/%v", api.Endpoint(resource), id), api.ApiJson(payload, resource), query, api.LastName(resource))
+}
+
+func SendError(ctx context.Context, channel chan Errors.StarkErrors, err Errors.StarkErrors) bool {
+
+	//	Send an error to a Query error channel unless ctx is done first
+	//
+	//	Return:
+	//	- false if ctx was done before the error could be delivered, in which case the caller should stop
+	select {
+	case channel <- err:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
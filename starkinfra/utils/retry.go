@@ -0,0 +1,160 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	Errors "github.com/starkinfra/core-go/starkcore/error"
+	"math/rand"
+	"time"
+)
+
+//	Retry struct
+//
+//	Retry configures how a Client repeats requests that failed with a timeout,
+//	a connection error, a 429 or a 5xx status. GET requests are always safe to
+//	repeat. POST requests are only repeated when every entity carries an
+//	ExternalId, since the API refuses to create a second entity with the same
+//	ExternalId. If a repeated POST is refused for that reason, the entities
+//	created by the earlier attempt are looked up by their externalIds and returned.
+//
+//	Attributes:
+//	- Attempts [int, default 0]: Maximum number of retries after the first attempt. Requests are not retried if 0. ex: 3
+//	- BaseDelay [time.Duration, default 200ms]: Delay before the first retry, doubled on each following one. ex: 500 * time.Millisecond
+//	- MaxDelay [time.Duration, default 5s]: Upper bound for the delay between two attempts. ex: 10 * time.Second
+//
+//	Each delay is randomized between half and all of its value, so concurrent
+//	clients do not retry in lockstep.
+
+type Retry struct {
+	Attempts  int
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+}
+
+var duplicateExternalIdCodes = []string{"invalidExternalId"}
+
+func (r Retry) allows(method string, payload interface{}) (bool, Errors.StarkErrors) {
+	if r.Attempts <= 0 {
+		return false, Errors.StarkErrors{}
+	}
+	if method == "GET" {
+		return true, Errors.StarkErrors{}
+	}
+	if method != "POST" {
+		return false, Errors.StarkErrors{}
+	}
+	ids, err := externalIds(payload)
+	return len(ids) > 0, err
+}
+
+func (r Retry) wait(ctx context.Context, attempt int) bool {
+	base := r.BaseDelay
+	if base <= 0 {
+		base = 200 * time.Millisecond
+	}
+	max := r.MaxDelay
+	if max <= 0 {
+		max = 5 * time.Second
+	}
+	delay := base << uint(attempt)
+	if delay > max || delay <= 0 {
+		delay = max
+	}
+	delay = delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+func externalIds(payload interface{}) ([]string, Errors.StarkErrors) {
+
+	//	Returns the externalId of every entity in a creation payload, in order,
+	//	or nil if any of them is missing one
+	var data map[string]interface{}
+	bytes, err := json.Marshal(payload)
+	if err != nil {
+		return nil, Errors.InputError(fmt.Sprintf("The request payload could not be encoded: %v", err))
+	}
+	if json.Unmarshal(bytes, &data) != nil {
+		return nil, Errors.StarkErrors{}
+	}
+	return externalIdsOf(data), Errors.StarkErrors{}
+}
+
+func externalIdsOf(data map[string]interface{}) []string {
+	if id, ok := data["externalId"].(string); ok && id != "" {
+		return []string{id}
+	}
+	if len(data) != 1 {
+		return nil
+	}
+	var ids []string
+	for _, value := range data {
+		entities, ok := value.([]interface{})
+		if !ok || len(entities) == 0 {
+			return nil
+		}
+		for _, entity := range entities {
+			fields, _ := entity.(map[string]interface{})
+			id, _ := fields["externalId"].(string)
+			if id == "" {
+				return nil
+			}
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+func (c *Client) replay(ctx context.Context, resource map[string]string, payload interface{}, err Errors.StarkErrors, retried bool) ([]map[string]interface{}, bool) {
+
+	//	Looks up the entities of a retried creation that was refused for reusing
+	//	their externalIds, meaning an earlier attempt already created them
+	if !retried || !hasCode(err, duplicateExternalIdCodes) {
+		return nil, false
+	}
+	ids, idsErr := externalIds(payload)
+	if idsErr.Errors != nil || len(ids) == 0 || len(ids) > 100 {
+		return nil, false
+	}
+	page, _, pageErr := c.getPage(ctx, resource, map[string]interface{}{"externalIds": ids, "limit": len(ids)})
+	if pageErr.Errors != nil {
+		return nil, false
+	}
+	var entities []map[string]interface{}
+	if json.Unmarshal(page, &entities) != nil {
+		return nil, false
+	}
+	byExternalId := map[string]map[string]interface{}{}
+	for _, entity := range entities {
+		id, _ := entity["externalId"].(string)
+		byExternalId[id] = entity
+	}
+	var replayed []map[string]interface{}
+	for _, id := range ids {
+		entity, ok := byExternalId[id]
+		if !ok {
+			return nil, false
+		}
+		replayed = append(replayed, entity)
+	}
+	return replayed, true
+}
+
+func hasCode(err Errors.StarkErrors, codes []string) bool {
+	for _, e := range err.Errors {
+		for _, code := range codes {
+			if e.Code == code {
+				return true
+			}
+		}
+	}
+	return false
+}
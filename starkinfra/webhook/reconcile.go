@@ -0,0 +1,169 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	Error "github.com/starkinfra/core-go/starkcore/error"
+	"github.com/starkinfra/core-go/starkcore/user/user"
+	"github.com/starkinfra/sdk-go/starkinfra/utils"
+	"sort"
+	"strings"
+)
+
+//	Webhook Plan struct
+//
+//	Changes that bring the registered Webhooks to a desired set, as computed
+//	by Reconcile. Webhooks cannot be updated, so changing the Subscriptions of
+//	a Url means creating a new Webhook and deleting the old one.
+//
+//	Attributes:
+//	- Create [slice of Webhook structs]: Webhooks to be created, with their Id once created
+//	- Delete [slice of Webhook structs]: Registered Webhooks to be deleted
+//	- Keep [slice of Webhook structs]: Registered Webhooks that already match the desired set
+
+type Plan struct {
+	Create []Webhook
+	Delete []Webhook
+	Keep   []Webhook
+}
+
+func Reconcile(desired []Webhook, dryRun bool, user user.User) (Plan, Error.StarkErrors) {
+	//	Converge the registered Webhooks to a desired set
+	//
+	//	Compares the desired Webhooks with the ones returned by Query. A registered
+	//	Webhook is kept if a desired one has the same Url and Subscriptions, in any
+	//	order, and deleted otherwise. Desired Webhooks with no match are created.
+	//	Creations run before deletions, so a failure leaves the old Webhooks in
+	//	place and Events keep being delivered while a Url is subscribed again.
+	//	Until its old Webhook is deleted, an Event may be delivered to that Url
+	//	twice, so handle Events idempotently, such as with the Deduplicate method of event.Handler.
+	//
+	//	Parameters (required):
+	//	- desired [slice of Webhook structs]: Every Webhook that should be registered, with Url and Subscriptions
+	//	- dryRun [bool]: If true, only compute the Plan, without changing anything
+	//
+	//	Parameters (optional):
+	//	- user [Organization/Project struct, default nil]: Organization or Project struct. Not necessary if starkinfra.User was set before function call
+	//
+	//	Return:
+	//	- Plan with the changes made, or to be made if dryRun is true
+	return Client{Api: utils.Default(user)}.Reconcile(desired, dryRun)
+}
+
+func ReconcileCtx(ctx context.Context, desired []Webhook, dryRun bool, user user.User) (Plan, Error.StarkErrors) {
+	//	Converge the registered Webhooks to a desired set
+	//
+	//	Same as Reconcile, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.ReconcileCtx(ctx, desired, dryRun)
+}
+
+func (c Client) Reconcile(desired []Webhook, dryRun bool) (Plan, Error.StarkErrors) {
+	//	Converge the registered Webhooks to a desired set
+	return c.ReconcileCtx(context.Background(), desired, dryRun)
+}
+
+func (c Client) ReconcileCtx(ctx context.Context, desired []Webhook, dryRun bool) (Plan, Error.StarkErrors) {
+	//	Converge the registered Webhooks to a desired set
+	for _, webhook := range desired {
+		if webhook.Url == "" || len(webhook.Subscriptions) == 0 {
+			return Plan{}, utils.ValidationError(fmt.Sprintf("Desired Webhooks need a Url and at least one subscription: %v", webhook))
+		}
+	}
+
+	var registered []Webhook
+	iterator := c.IterateCtx(ctx, nil)
+	defer iterator.Close()
+	for iterator.Next() {
+		registered = append(registered, iterator.Value())
+	}
+	if err := iterator.Err(); err.Errors != nil {
+		return Plan{}, err
+	}
+
+	plan := Diff(desired, registered)
+	if dryRun {
+		return plan, Error.StarkErrors{}
+	}
+	for i, webhook := range plan.Create {
+		created, err := c.CreateCtx(ctx, webhook)
+		if err.Errors != nil {
+			return plan, err
+		}
+		plan.Create[i] = created
+	}
+	for _, webhook := range plan.Delete {
+		_, err := c.DeleteCtx(ctx, webhook.Id)
+		if err.Errors != nil {
+			return plan, err
+		}
+	}
+	return plan, Error.StarkErrors{}
+}
+
+func Diff(desired []Webhook, registered []Webhook) Plan {
+	//	Compute the Plan that turns the registered Webhooks into the desired ones
+	//
+	//	Parameters (required):
+	//	- desired [slice of Webhook structs]: Every Webhook that should be registered
+	//	- registered [slice of Webhook structs]: Webhooks currently registered, as returned by Query
+	//
+	//	Return:
+	//	- Plan, without making any request
+	var plan Plan
+	wanted := map[string]bool{}
+	for _, webhook := range desired {
+		wanted[key(webhook)] = true
+	}
+	for _, webhook := range registered {
+		if wanted[key(webhook)] {
+			plan.Keep = append(plan.Keep, webhook)
+			delete(wanted, key(webhook))
+			continue
+		}
+		plan.Delete = append(plan.Delete, webhook)
+	}
+	for _, webhook := range desired {
+		if wanted[key(webhook)] {
+			plan.Create = append(plan.Create, Webhook{Url: webhook.Url, Subscriptions: subscriptions(webhook)})
+			delete(wanted, key(webhook))
+		}
+	}
+	return plan
+}
+
+func (p Plan) Empty() bool {
+	//	Tell whether the registered Webhooks already match the desired ones
+	return len(p.Create) == 0 && len(p.Delete) == 0
+}
+
+func (p Plan) String() string {
+	//	Describe the Plan, one Webhook per line: "+" to be created, "-" to be deleted and "=" kept
+	var lines []string
+	for _, webhook := range p.Create {
+		lines = append(lines, fmt.Sprintf("+ %v %v", webhook.Url, strings.Join(webhook.Subscriptions, ",")))
+	}
+	for _, webhook := range p.Delete {
+		lines = append(lines, fmt.Sprintf("- %v %v (%v)", webhook.Url, strings.Join(subscriptions(webhook), ","), webhook.Id))
+	}
+	for _, webhook := range p.Keep {
+		lines = append(lines, fmt.Sprintf("= %v %v (%v)", webhook.Url, strings.Join(subscriptions(webhook), ","), webhook.Id))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func key(webhook Webhook) string {
+	return webhook.Url + " " + strings.Join(subscriptions(webhook), ",")
+}
+
+func subscriptions(webhook Webhook) []string {
+	unique := map[string]bool{}
+	var sorted []string
+	for _, subscription := range webhook.Subscriptions {
+		if !unique[subscription] {
+			unique[subscription] = true
+			sorted = append(sorted, subscription)
+		}
+	}
+	sort.Strings(sorted)
+	return sorted
+}
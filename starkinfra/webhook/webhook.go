@@ -1,6 +1,7 @@
 package webhook
 
 import (
+	"context"
 	"encoding/json"
 	Error "github.com/starkinfra/core-go/starkcore/error"
 	"github.com/starkinfra/core-go/starkcore/user/user"
@@ -18,17 +19,26 @@ import (
 //
 //	Attributes (return-only):
 //	- Id [string]: Unique id returned when the webhook is created. ex: "5656565656565656"
+//	- Extra [map[string]json.RawMessage]: Fields of the Webhook sent by the API but not declared in this SDK version yet, keyed by their JSON name. ex: map[string]json.RawMessage{"newField": json.RawMessage(`"value"`)}
 
 type Webhook struct {
-	Url           string   `json:",omitempty"`
-	Subscriptions []string `json:",omitempty"`
-	Id            string   `json:",omitempty"`
+	Url           string                     `json:",omitempty"`
+	Subscriptions []string                   `json:",omitempty"`
+	Id            string                     `json:",omitempty"`
+	Extra         map[string]json.RawMessage `json:"-"`
 }
 
-var object Webhook
-var objects []Webhook
 var resource = map[string]string{"name": "Webhook"}
 
+//	Webhook Client struct
+//
+//	Runs the Webhook functions with the settings of its own utils.Client
+//	instead of starkinfra.User and the other package defaults.
+
+type Client struct {
+	Api *utils.Client
+}
+
 func Create(webhook Webhook, user user.User) (Webhook, Error.StarkErrors) {
 	//	Create Webhook
 	//
@@ -42,11 +52,28 @@ func Create(webhook Webhook, user user.User) (Webhook, Error.StarkErrors) {
 	//
 	//	Return:
 	//	- webhook struct with updated attributes
-	create, err := utils.Single(resource, webhook, user)
-	unmarshalError := json.Unmarshal(create, &webhook)
-	if unmarshalError != nil {
+	return Client{Api: utils.Default(user)}.Create(webhook)
+}
+
+func CreateCtx(ctx context.Context, webhook Webhook, user user.User) (Webhook, Error.StarkErrors) {
+	//	Create Webhook
+	//
+	//	Same as Create, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.CreateCtx(ctx, webhook)
+}
+
+func (c Client) Create(webhook Webhook) (Webhook, Error.StarkErrors) {
+	//	Create Webhook
+	return c.CreateCtx(context.Background(), webhook)
+}
+
+func (c Client) CreateCtx(ctx context.Context, webhook Webhook) (Webhook, Error.StarkErrors) {
+	//	Create Webhook
+	create, err := c.Api.Single(ctx, resource, webhook)
+	if err.Errors != nil {
 		return webhook, err
 	}
+	err = c.Api.Unmarshal(create, &webhook)
 	return webhook, err
 }
 
@@ -63,11 +90,29 @@ func Get(id string, user user.User) (Webhook, Error.StarkErrors) {
 	//
 	//	Return:
 	//	- webhook struct that corresponds to the given id.
-	get, err := utils.Get(resource, id, nil, user)
-	unmarshalError := json.Unmarshal(get, &object)
-	if unmarshalError != nil {
+	return Client{Api: utils.Default(user)}.Get(id)
+}
+
+func GetCtx(ctx context.Context, id string, user user.User) (Webhook, Error.StarkErrors) {
+	//	Retrieve a specific Webhook by its id
+	//
+	//	Same as Get, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.GetCtx(ctx, id)
+}
+
+func (c Client) Get(id string) (Webhook, Error.StarkErrors) {
+	//	Retrieve a specific Webhook by its id
+	return c.GetCtx(context.Background(), id)
+}
+
+func (c Client) GetCtx(ctx context.Context, id string) (Webhook, Error.StarkErrors) {
+	//	Retrieve a specific Webhook by its id
+	var object Webhook
+	get, err := c.Api.Get(ctx, resource, id, nil)
+	if err.Errors != nil {
 		return object, err
 	}
+	err = c.Api.Unmarshal(get, &object)
 	return object, err
 }
 
@@ -83,28 +128,133 @@ func Query(params map[string]interface{}, user user.User) (chan Webhook, chan Er
 	//
 	//	Return:
 	//	- channel of Webhook structs with updated attributes
+	return Client{Api: utils.Default(user)}.Query(params)
+}
+
+func QueryCtx(ctx context.Context, params map[string]interface{}, user user.User) (chan Webhook, chan Error.StarkErrors) {
+	//	Retrieve Webhook
+	//
+	//	Same as Query, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.QueryCtx(ctx, params)
+}
+
+func (c Client) Query(params map[string]interface{}) (chan Webhook, chan Error.StarkErrors) {
+	//	Retrieve Webhook
+	return c.QueryCtx(context.Background(), params)
+}
+
+func (c Client) QueryCtx(ctx context.Context, params map[string]interface{}) (chan Webhook, chan Error.StarkErrors) {
+	//	Retrieve Webhook
 	webhooks := make(chan Webhook)
 	webhooksError := make(chan Error.StarkErrors)
-	query, errorChannel := utils.Query(resource, params, user)
+	query, errorChannel := c.Api.Stream(ctx, resource, params)
 	go func() {
+		defer close(webhooksError)
+		defer close(webhooks)
 		for content := range query {
-			contentByte, _ := json.Marshal(content)
-			err := json.Unmarshal(contentByte, &object)
-			if err != nil {
-				webhooksError <- Error.UnknownError(err.Error())
+			var object Webhook
+			err := c.Api.Unmarshal(content, &object)
+			if err.Errors != nil {
+				if !utils.SendError(ctx, webhooksError, err) {
+					return
+				}
 				continue
 			}
-			webhooks <- object
+			select {
+			case webhooks <- object:
+			case <-ctx.Done():
+				return
+			}
 		}
 		for err := range errorChannel {
-			webhooksError <- err
+			if !utils.SendError(ctx, webhooksError, err) {
+				return
+			}
 		}
-		close(webhooks)
-		close(webhooksError)
 	}()
 	return webhooks, webhooksError
 }
 
+//	Webhook Iterator struct
+//
+//	Returned by Iterate to walk through Webhook structs one at a time,
+//	requesting a new page only once the current one is exhausted. Check Err
+//	once Next returns false and Close it when leaving the loop early.
+
+type Iterator struct {
+	api   *utils.Client
+	pages *utils.Iterator
+	value Webhook
+	err   Error.StarkErrors
+}
+
+func Iterate(params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve Webhook
+	//
+	//	Same as Query, but returns an Iterator instead of a pair of channels
+	//
+	//	Example:
+	//	iterator := Iterate(params, nil)
+	//	defer iterator.Close()
+	//	for iterator.Next() {
+	//		fmt.Println(iterator.Value())
+	//	}
+	//	if err := iterator.Err(); err.Errors != nil {
+	//		...
+	//	}
+	return Client{Api: utils.Default(user)}.Iterate(params)
+}
+
+func IterateCtx(ctx context.Context, params map[string]interface{}, user user.User) *Iterator {
+	//	Retrieve Webhook
+	//
+	//	Same as Iterate, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.IterateCtx(ctx, params)
+}
+
+func (c Client) Iterate(params map[string]interface{}) *Iterator {
+	//	Retrieve Webhook
+	return c.IterateCtx(context.Background(), params)
+}
+
+func (c Client) IterateCtx(ctx context.Context, params map[string]interface{}) *Iterator {
+	//	Retrieve Webhook
+	return &Iterator{api: c.Api, pages: c.Api.Iterate(ctx, resource, params)}
+}
+
+func (i *Iterator) Next() bool {
+	//	Move to the next Webhook, returning false when there are no more or a request failed
+	var value Webhook
+	if i.err.Errors != nil || !i.pages.Next() {
+		return false
+	}
+	i.err = i.api.Unmarshal(i.pages.Value(), &value)
+	if i.err.Errors != nil {
+		i.pages.Close()
+		return false
+	}
+	i.value = value
+	return true
+}
+
+func (i *Iterator) Value() Webhook {
+	//	Current Webhook, set by the last call to Next
+	return i.value
+}
+
+func (i *Iterator) Err() Error.StarkErrors {
+	//	Error that stopped the iteration, if any
+	if i.err.Errors != nil {
+		return i.err
+	}
+	return i.pages.Err()
+}
+
+func (i *Iterator) Close() {
+	//	Stop the iteration, releasing the current page
+	i.pages.Close()
+}
+
 func Page(params map[string]interface{}, user user.User) ([]Webhook, string, Error.StarkErrors) {
 	//	Retrieve paged Webhook structs
 	//
@@ -120,11 +270,29 @@ func Page(params map[string]interface{}, user user.User) ([]Webhook, string, Err
 	//	Return:
 	//	- slice of Webhook structs with updated attributes
 	//	- cursor to retrieve the next page of Webhook structs
-	page, cursor, err := utils.Page(resource, params, user)
-	unmarshalError := json.Unmarshal(page, &objects)
-	if unmarshalError != nil {
+	return Client{Api: utils.Default(user)}.Page(params)
+}
+
+func PageCtx(ctx context.Context, params map[string]interface{}, user user.User) ([]Webhook, string, Error.StarkErrors) {
+	//	Retrieve paged Webhook structs
+	//
+	//	Same as Page, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.PageCtx(ctx, params)
+}
+
+func (c Client) Page(params map[string]interface{}) ([]Webhook, string, Error.StarkErrors) {
+	//	Retrieve paged Webhook structs
+	return c.PageCtx(context.Background(), params)
+}
+
+func (c Client) PageCtx(ctx context.Context, params map[string]interface{}) ([]Webhook, string, Error.StarkErrors) {
+	//	Retrieve paged Webhook structs
+	var objects []Webhook
+	page, cursor, err := c.Api.Page(ctx, resource, params)
+	if err.Errors != nil {
 		return objects, cursor, err
 	}
+	err = c.Api.Unmarshal(page, &objects)
 	return objects, cursor, err
 }
 
@@ -141,10 +309,28 @@ func Delete(id string, user user.User) (Webhook, Error.StarkErrors) {
 	//
 	//	Return:
 	//	- deleted Webhook struct
-	deleted, err := utils.Delete(resource, id, user)
-	unmarshalError := json.Unmarshal(deleted, &object)
-	if unmarshalError != nil {
+	return Client{Api: utils.Default(user)}.Delete(id)
+}
+
+func DeleteCtx(ctx context.Context, id string, user user.User) (Webhook, Error.StarkErrors) {
+	//	Delete a Webhook entity
+	//
+	//	Same as Delete, with the requests bound to ctx
+	return Client{Api: utils.Default(user)}.DeleteCtx(ctx, id)
+}
+
+func (c Client) Delete(id string) (Webhook, Error.StarkErrors) {
+	//	Delete a Webhook entity
+	return c.DeleteCtx(context.Background(), id)
+}
+
+func (c Client) DeleteCtx(ctx context.Context, id string) (Webhook, Error.StarkErrors) {
+	//	Delete a Webhook entity
+	var object Webhook
+	deleted, err := c.Api.Delete(ctx, resource, id)
+	if err.Errors != nil {
 		return object, err
 	}
+	err = c.Api.Unmarshal(deleted, &object)
 	return object, err
 }
@@ -0,0 +1,135 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"github.com/starkbank/ecdsa-go/v2/ellipticcurve/curve"
+	"github.com/starkbank/ecdsa-go/v2/ellipticcurve/ecdsa"
+	"github.com/starkbank/ecdsa-go/v2/ellipticcurve/privatekey"
+	"github.com/starkinfra/sdk-go/starkinfra/authorization"
+	IssuingToken "github.com/starkinfra/sdk-go/starkinfra/issuingtoken"
+	PixRequest "github.com/starkinfra/sdk-go/starkinfra/pixrequest"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+const pixRequestAuthorization = `{"id": "5656565656565656", "amount": 1000, "receiverTaxId": "012.345.678-90"}`
+
+var denied = PixRequest.Deny(PixRequest.ReasonOrderRejected)
+
+func authorizationHandler(t *testing.T) (*authorization.Handler, func(content string) string) {
+	key := privatekey.New(curve.Secp256k1)
+	transport := &scriptedTransport{responses: []scriptedResponse{
+		{200, fmt.Sprintf(`{"publicKeys": [{"content": %v}]}`, jsonString(key.PublicKey().ToPem()))},
+	}}
+	client, _ := pagingClient()
+	client.HttpClient = &http.Client{Transport: transport}
+	client.Host = strings.ToLower(strings.ReplaceAll(t.Name(), "/", ""))
+	sign := func(content string) string {
+		return ecdsa.Sign(content, &key).ToBase64()
+	}
+	return client.Authorization.NewHandler(), sign
+}
+
+func authorize(handler http.Handler, path string, content string, signature string) (int, string) {
+	request := httptest.NewRequest("POST", path, strings.NewReader(content))
+	request.Header.Set("Digital-Signature", signature)
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	return recorder.Code, strings.Join(strings.Fields(recorder.Body.String()), "")
+}
+
+func TestAuthorizationHandlerDecides(t *testing.T) {
+
+	handler, sign := authorizationHandler(t)
+	handler.OnPixRequest("/pix-request", func(ctx context.Context, request PixRequest.PixRequest) PixRequest.Authorization {
+		assert.Equal(t, 1000, request.Amount)
+		return PixRequest.Approve()
+	}, denied)
+
+	status, body := authorize(handler, "/pix-request", pixRequestAuthorization, sign(pixRequestAuthorization))
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, `{"authorization":{"status":"approved"}}`, body)
+}
+
+func TestAuthorizationHandlerFallbacks(t *testing.T) {
+
+	handler, sign := authorizationHandler(t)
+	handler.Deadline = 50 * time.Millisecond
+	var causes []error
+	handler.OnFallback = func(path string, cause error) {
+		causes = append(causes, cause)
+	}
+	handler.OnPixRequest("/slow", func(ctx context.Context, request PixRequest.PixRequest) PixRequest.Authorization {
+		<-ctx.Done()
+		return PixRequest.Approve()
+	}, denied)
+	handler.OnPixRequest("/panic", func(ctx context.Context, request PixRequest.PixRequest) PixRequest.Authorization {
+		panic("nil pointer")
+	}, denied)
+	handler.OnIssuingTokenActivation("/token", func(ctx context.Context, token IssuingToken.IssuingToken) IssuingToken.Activation {
+		return IssuingToken.Activation{Status: IssuingToken.StatusDenied}
+	}, IssuingToken.DenyActivation(IssuingToken.ReasonSubIssuerError))
+
+	for _, path := range []string{"/slow", "/panic"} {
+		status, body := authorize(handler, path, pixRequestAuthorization, sign(pixRequestAuthorization))
+		assert.Equal(t, http.StatusOK, status, path)
+		assert.Equal(t, `{"authorization":{"reason":"orderRejected","status":"denied"}}`, body, path)
+	}
+	token := `{"id": "1", "activationCode": "123456"}`
+	status, body := authorize(handler, "/token", token, sign(token))
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, `{"authorization":{"reason":"subIssuerError","status":"denied"}}`, body)
+
+	assert.Equal(t, 3, len(causes))
+	assert.Equal(t, context.DeadlineExceeded, causes[0])
+	assert.Contains(t, causes[1].Error(), "nil pointer")
+	assert.Contains(t, causes[2].Error(), "invalid answer")
+}
+
+func TestAuthorizationHandlerSlowVerification(t *testing.T) {
+
+	handler, sign := authorizationHandler(t)
+	handler.Api.HttpClient = &http.Client{Transport: blockingTransport{}}
+	handler.Deadline = 50 * time.Millisecond
+	var causes []error
+	handler.OnFallback = func(path string, cause error) {
+		causes = append(causes, cause)
+	}
+	handler.OnPixRequest("/pix-request", func(ctx context.Context, request PixRequest.PixRequest) PixRequest.Authorization {
+		t.Error("callback should not run")
+		return PixRequest.Approve()
+	}, PixRequest.Approve())
+
+	status, _ := authorize(handler, "/pix-request", pixRequestAuthorization, sign(pixRequestAuthorization))
+	assert.Equal(t, http.StatusGatewayTimeout, status)
+	assert.Nil(t, causes)
+}
+
+func TestAuthorizationHandlerInvalidFallback(t *testing.T) {
+
+	handler, _ := authorizationHandler(t)
+	assert.Panics(t, func() {
+		handler.OnPixRequest("/pix-request", func(ctx context.Context, request PixRequest.PixRequest) PixRequest.Authorization {
+			return PixRequest.Approve()
+		}, PixRequest.Authorization{Status: PixRequest.StatusDenied})
+	})
+}
+
+func TestAuthorizationHandlerRefusals(t *testing.T) {
+
+	handler, sign := authorizationHandler(t)
+	handler.OnPixRequest("/pix-request", func(ctx context.Context, request PixRequest.PixRequest) PixRequest.Authorization {
+		t.Error("callback should not run")
+		return PixRequest.Approve()
+	}, PixRequest.Approve())
+
+	status, _ := authorize(handler, "/pix-request", pixRequestAuthorization, sign("other content"))
+	assert.Equal(t, http.StatusUnauthorized, status)
+	status, _ = authorize(handler, "/pix-reversal", pixRequestAuthorization, sign(pixRequestAuthorization))
+	assert.Equal(t, http.StatusNotFound, status)
+}
@@ -0,0 +1,84 @@
+package sdk
+
+import (
+	IssuingPurchase "github.com/starkinfra/sdk-go/starkinfra/issuingpurchase"
+	IssuingToken "github.com/starkinfra/sdk-go/starkinfra/issuingtoken"
+	PixRequest "github.com/starkinfra/sdk-go/starkinfra/pixrequest"
+	PixReversal "github.com/starkinfra/sdk-go/starkinfra/pixreversal"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func compact(response string) string {
+	return strings.Join(strings.Fields(response), "")
+}
+
+func TestPixAuthorizationResponse(t *testing.T) {
+
+	response, err := PixRequest.Deny(PixRequest.ReasonTaxIdMismatch).Response()
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, `{"authorization":{"reason":"taxIdMismatch","status":"denied"}}`, compact(response))
+
+	response, err = PixReversal.Approve().Response()
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, `{"authorization":{"status":"approved"}}`, compact(response))
+
+	invalid := []PixRequest.Authorization{
+		{},
+		{Status: PixRequest.StatusDenied},
+		{Status: PixRequest.StatusDenied, Reason: "becauseNo"},
+		{Status: PixRequest.StatusApproved, Reason: PixRequest.ReasonOrderRejected},
+	}
+	for _, authorization := range invalid {
+		response, err := authorization.Response()
+		assert.Equal(t, "", response)
+		assert.Equal(t, "inputError", err.Errors[0].Code, authorization)
+	}
+}
+
+func TestIssuingPurchaseAuthorizationResponse(t *testing.T) {
+
+	purchase := IssuingPurchase.IssuingPurchase{Amount: 1000}
+
+	partial := IssuingPurchase.ApprovePartial(400)
+	partial.Tags = []string{"partial"}
+	assert.Nil(t, partial.ValidateFor(purchase).Errors)
+	response, err := partial.Response()
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, `{"authorization":{"amount":400,"status":"approved","tags":["partial"]}}`, compact(response))
+
+	assert.NotNil(t, IssuingPurchase.ApprovePartial(1001).ValidateFor(purchase).Errors)
+	assert.NotNil(t, IssuingPurchase.ApprovePartial(-1).Validate().Errors)
+	assert.NotNil(t, IssuingPurchase.ApprovePartial(0).Validate().Errors)
+	_, err = IssuingPurchase.ApprovePartial(0).Response()
+	assert.NotNil(t, err.Errors)
+	assert.NotNil(t, IssuingPurchase.Authorization{Status: IssuingPurchase.StatusApproved, Tags: []string{""}}.Validate().Errors)
+
+	denial := IssuingPurchase.Deny(IssuingPurchase.ReasonInsufficientBalance)
+	assert.Nil(t, denial.ValidateFor(purchase).Errors)
+	amount := 100
+	denial.Amount = &amount
+	assert.NotNil(t, denial.Validate().Errors)
+}
+
+func TestIssuingTokenAuthorizationResponse(t *testing.T) {
+
+	approval := IssuingToken.ApproveAuthorization("5656565656565656", IssuingToken.ActivationMethod{Type: "text", Value: "+5511989898989"})
+	response, err := approval.Response()
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, `{"authorization":{"activationMethods":[{"type":"text","value":"+5511989898989"}],"designId":"5656565656565656","status":"approved"}}`, compact(response))
+
+	assert.NotNil(t, IssuingToken.ApproveAuthorization("5656565656565656", IssuingToken.ActivationMethod{Type: "text"}).Validate().Errors)
+	assert.NotNil(t, IssuingToken.ApproveAuthorization("", IssuingToken.ActivationMethod{Type: "text", Value: "+5511989898989"}).Validate().Errors)
+	assert.NotNil(t, IssuingToken.ApproveAuthorization("5656565656565656").Validate().Errors)
+	denial := IssuingToken.DenyAuthorization(IssuingToken.ReasonBlockedCard)
+	assert.Nil(t, denial.Validate().Errors)
+	denial.DesignId = "5656565656565656"
+	assert.NotNil(t, denial.Validate().Errors)
+
+	response, err = IssuingToken.DenyActivation(IssuingToken.ReasonBruteForce).Response()
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, `{"authorization":{"reason":"bruteForce","status":"denied"}}`, compact(response))
+	assert.NotNil(t, IssuingToken.DenyActivation("").Validate().Errors)
+}
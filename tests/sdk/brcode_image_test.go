@@ -0,0 +1,61 @@
+package sdk
+
+import (
+	"bytes"
+	"github.com/starkinfra/sdk-go/starkinfra/brcode"
+	"github.com/stretchr/testify/assert"
+	"image/color"
+	"image/png"
+	"strings"
+	"testing"
+)
+
+func TestBrcodeImagePng(t *testing.T) {
+
+	content, err := brcode.Image{Size: 300}.Png(staticBrcode)
+	assert.Nil(t, err.Errors)
+	picture, decodeError := png.Decode(bytes.NewReader(content))
+	assert.Nil(t, decodeError)
+	assert.Equal(t, 300, picture.Bounds().Dx())
+	assert.Equal(t, 300, picture.Bounds().Dy())
+
+	white := color.GrayModel.Convert(color.White)
+	black := color.GrayModel.Convert(color.Black)
+	assert.Equal(t, white, color.GrayModel.Convert(picture.At(0, 0)))
+	assert.Equal(t, white, color.GrayModel.Convert(picture.At(150, 10)))
+
+	margin := 0
+	content, err = brcode.Image{Size: 300, QuietZone: &margin}.Png(staticBrcode)
+	assert.Nil(t, err.Errors)
+	picture, _ = png.Decode(bytes.NewReader(content))
+	assert.Equal(t, black, color.GrayModel.Convert(picture.At(20, 20)))
+}
+
+func TestBrcodeImageSvg(t *testing.T) {
+
+	margin := 0
+	low, err := brcode.Image{Level: brcode.LevelLow, QuietZone: &margin}.Svg(staticBrcode)
+	assert.Nil(t, err.Errors)
+	assert.True(t, strings.HasPrefix(low, `<svg xmlns="http://www.w3.org/2000/svg" width="256" height="256" viewBox="0 0 37 37"`), low[:120])
+	assert.Contains(t, low, `d="M0 0h7v1h-7z`)
+
+	high, err := brcode.Image{Level: brcode.LevelHigh}.Svg(staticBrcode)
+	assert.Nil(t, err.Errors)
+	assert.Contains(t, high, `viewBox="0 0 61 61"`)
+	assert.Contains(t, high, `d="M4 4h7v1h-7z`)
+}
+
+func TestBrcodeImageInvalid(t *testing.T) {
+
+	negative := -1
+	for _, image := range []brcode.Image{
+		{Level: "X"},
+		{QuietZone: &negative},
+		{Size: 20},
+	} {
+		_, err := image.Png(staticBrcode)
+		assert.Equal(t, "inputError", err.Errors[0].Code, image)
+	}
+	_, err := brcode.Image{}.Svg(staticBrcode[:len(staticBrcode)-1] + "0")
+	assert.Equal(t, "inputError", err.Errors[0].Code)
+}
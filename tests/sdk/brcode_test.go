@@ -0,0 +1,110 @@
+package sdk
+
+import (
+	"github.com/starkinfra/sdk-go/starkinfra/brcode"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+const staticBrcode = "00020126360014br.gov.bcb.pix0114+552840092118152040000530398654040.095802BR5915Jamie Lannister6009Sao Paulo620705038566304FC6C"
+
+func TestBrcodeParse(t *testing.T) {
+
+	parsed, err := brcode.Parse(staticBrcode)
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, "br.gov.bcb.pix", parsed.MerchantAccount.Gui)
+	assert.Equal(t, "+5528400921181", parsed.MerchantAccount.KeyId)
+	assert.Equal(t, 9, parsed.Amount)
+	assert.Equal(t, "Jamie Lannister", parsed.Name)
+	assert.Equal(t, "Sao Paulo", parsed.City)
+	assert.Equal(t, "856", parsed.ReconciliationId)
+	assert.Equal(t, "FC6C", parsed.Crc)
+
+	encoded, err := parsed.Encode()
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, staticBrcode, encoded)
+
+	manual := "00020126580014br.gov.bcb.pix0136123e4567-e12b-12d1-a456-4266554400005204000053039865802BR5913Fulano de Tal6008BRASILIA62070503***63041D3D"
+	parsed, err = brcode.Parse(manual)
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, 0, parsed.Amount)
+	assert.Equal(t, "***", parsed.ReconciliationId)
+
+	parsed, err = brcode.Parse("00020126420014br.gov.bcb.pix0120nedstark@hotmail.com52040000530398654075000.005802BR5909Ned Stark6014Rio de Janeiro621605126674869738606304FF71")
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, 500000, parsed.Amount)
+	assert.Equal(t, "nedstark@hotmail.com", parsed.MerchantAccount.KeyId)
+	assert.Equal(t, "667486973860", parsed.ReconciliationId)
+}
+
+func TestBrcodeRoundTrip(t *testing.T) {
+
+	extended := "00020126450014br.gov.bcb.pix0114+55119898989890305extra52040000530398654041.005802BR5910Tony Stark6003Rio62440506abc12350300017br.gov.bcb.brcode01051.0.063044B17"
+	parsed, err := brcode.Parse(extended)
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, "abc123", parsed.ReconciliationId)
+	assert.Equal(t, []brcode.Field{{Id: "50", Value: "0017br.gov.bcb.brcode01051.0.0"}}, parsed.AdditionalData)
+	assert.Equal(t, []brcode.Field{{Id: "03", Value: "extra"}}, parsed.MerchantAccount.Fields)
+	encoded, err := parsed.Encode()
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, extended, encoded)
+
+	other := "00020126280012br.com.other0108merchant27360014br.gov.bcb.pix0114+551198989898952040000530398654041.005802BR5910Tony Stark6003Rio62070503***6304AC17"
+	parsed, err = brcode.Parse(other)
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, "27", parsed.MerchantAccount.Id)
+	assert.Equal(t, []brcode.Field{{Id: "26", Value: "0012br.com.other0108merchant"}}, parsed.Fields)
+	encoded, err = parsed.Encode()
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, other, encoded)
+}
+
+func TestBrcodeParseInvalid(t *testing.T) {
+
+	for _, code := range []string{
+		"",
+		staticBrcode[:len(staticBrcode)-1] + "D",
+		staticBrcode[:40],
+		"0002016304" + brcode.Crc("0002016304"),
+		"0002AB" + staticBrcode[6:],
+	} {
+		_, err := brcode.Parse(code)
+		assert.Equal(t, "inputError", err.Errors[0].Code, code)
+	}
+}
+
+func TestBrcodeEncode(t *testing.T) {
+
+	code, err := brcode.Brcode{
+		MerchantAccount:  brcode.MerchantAccount{Url: "brcode-h.sandbox.starkinfra.com/v2/97756273400d42ce9086404fe10ea0d6"},
+		InitiationMethod: "12",
+		Amount:           123456,
+		Name:             "Tony Stark",
+		City:             "Rio de Janeiro",
+		ReconciliationId: "ah27s53agj6493hjds6836v49",
+		Fields:           []brcode.Field{{Id: "80", Value: "0014br.com.example"}},
+	}.Encode()
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, brcode.Crc(code[:len(code)-4]), code[len(code)-4:])
+
+	parsed, err := brcode.Parse(code)
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, "12", parsed.InitiationMethod)
+	assert.Equal(t, 123456, parsed.Amount)
+	assert.Equal(t, "986", parsed.Currency)
+	assert.Equal(t, "BR", parsed.Country)
+	assert.Equal(t, []brcode.Field{{Id: "80", Value: "0014br.com.example"}}, parsed.Fields)
+
+	for _, invalid := range []brcode.Brcode{
+		{Name: "Tony Stark", City: "Rio"},
+		{MerchantAccount: brcode.MerchantAccount{KeyId: "+5511989898989"}, Name: "Tony Stark", City: "Rio de Janeiro do Norte"},
+		{MerchantAccount: brcode.MerchantAccount{KeyId: "+5511989898989"}, Name: "Tony Stark", City: "Rio", ReconciliationId: "not-alphanumeric"},
+		{MerchantAccount: brcode.MerchantAccount{KeyId: "+5511989898989"}, Name: "Tony Stark", City: "Rio", Fields: []brcode.Field{{Id: "54", Value: "1.00"}}},
+		{MerchantAccount: brcode.MerchantAccount{KeyId: "+5511989898989"}, Name: "Tony Stark", City: "Rio", Fields: []brcode.Field{{Id: "26", Value: "0012br.com.other"}}},
+		{MerchantAccount: brcode.MerchantAccount{Id: "52", KeyId: "+5511989898989"}, Name: "Tony Stark", City: "Rio"},
+		{MerchantAccount: brcode.MerchantAccount{KeyId: "+5511989898989"}, Name: "Tony Stark", City: "Rio", AdditionalData: []brcode.Field{{Id: "05", Value: "abc"}}},
+	} {
+		_, err := invalid.Encode()
+		assert.Equal(t, "inputError", err.Errors[0].Code, invalid)
+	}
+}
@@ -0,0 +1,63 @@
+package sdk
+
+import (
+	"github.com/starkinfra/sdk-go/starkinfra"
+	"github.com/starkinfra/sdk-go/tests/utils"
+	"github.com/stretchr/testify/assert"
+	"sync"
+	"testing"
+)
+
+func TestClientPixBalanceGet(t *testing.T) {
+
+	client := starkinfra.NewClient(utils.ExampleProject)
+
+	balance, err := client.PixBalance.Get(nil)
+	if err.Errors != nil {
+		for _, e := range err.Errors {
+			t.Errorf("code: %s, message: %s", e.Code, e.Message)
+		}
+	}
+	assert.NotNil(t, balance.Id)
+}
+
+func TestClientPixRequestPage(t *testing.T) {
+
+	client := starkinfra.NewClient(utils.ExampleProject)
+
+	var params = map[string]interface{}{}
+	params["limit"] = 5
+
+	requests, _, err := client.PixRequest.Page(params)
+	if err.Errors != nil {
+		for _, e := range err.Errors {
+			t.Errorf("code: %s, message: %s", e.Code, e.Message)
+		}
+	}
+	for _, request := range requests {
+		assert.NotNil(t, request.Id)
+	}
+}
+
+func TestClientConcurrentSettings(t *testing.T) {
+
+	english := starkinfra.NewClient(utils.ExampleProject)
+	english.Language = "en-US"
+	portuguese := starkinfra.NewClient(utils.ExampleOrganization)
+
+	var wg sync.WaitGroup
+	for _, client := range []*starkinfra.Client{english, portuguese} {
+		wg.Add(1)
+		go func(client *starkinfra.Client) {
+			defer wg.Done()
+			_, err := client.PixRequest.Get("0")
+			assert.NotNil(t, err.Errors)
+		}(client)
+	}
+	wg.Wait()
+
+	assert.Equal(t, "en-US", english.PixRequest.Api.Language)
+	assert.Equal(t, "pt-BR", portuguese.PixRequest.Api.Language)
+	assert.Equal(t, utils.ExampleOrganization, portuguese.Webhook.Api.User)
+	assert.Equal(t, "pt-BR", starkinfra.Language)
+}
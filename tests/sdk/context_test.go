@@ -0,0 +1,68 @@
+package sdk
+
+import (
+	"context"
+	"github.com/starkinfra/sdk-go/starkinfra"
+	PixRequest "github.com/starkinfra/sdk-go/starkinfra/pixrequest"
+	"github.com/starkinfra/sdk-go/tests/utils"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+type endlessPages struct{}
+
+func (endlessPages) RoundTrip(req *http.Request) (*http.Response, error) {
+	body := `{"cursor": "next", "requests": [{"id": "1"}, {"id": "2"}]}`
+	return &http.Response{
+		StatusCode: 200,
+		Body:       ioutil.NopCloser(strings.NewReader(body)),
+		Header:     http.Header{},
+		Request:    req,
+	}, nil
+}
+
+func TestPixRequestQueryCtxCancel(t *testing.T) {
+
+	client := starkinfra.NewClient(utils.ExampleProject)
+	client.HttpClient = &http.Client{Transport: endlessPages{}}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	requests, errorChannel := client.PixRequest.QueryCtx(ctx, nil)
+
+	request := <-requests
+	assert.Equal(t, "1", request.Id)
+	cancel()
+
+	deadline := time.After(time.Second)
+	for requests != nil || errorChannel != nil {
+		select {
+		case _, ok := <-requests:
+			if !ok {
+				requests = nil
+			}
+		case _, ok := <-errorChannel:
+			if !ok {
+				errorChannel = nil
+			}
+		case <-deadline:
+			t.Fatal("query channels were not closed after the context was cancelled")
+		}
+	}
+}
+
+func TestPixRequestGetCtxDeadline(t *testing.T) {
+
+	starkinfra.User = utils.ExampleProject
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Nanosecond)
+	defer cancel()
+	time.Sleep(time.Millisecond)
+
+	_, err := PixRequest.GetCtx(ctx, "5656565656565656", nil)
+	assert.NotNil(t, err.Errors)
+	assert.Contains(t, err.Errors[0].Message, "context deadline exceeded")
+}
@@ -0,0 +1,51 @@
+package sdk
+
+import (
+	"errors"
+	"github.com/starkinfra/sdk-go/starkinfra"
+	PixRequest "github.com/starkinfra/sdk-go/starkinfra/pixrequest"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestDecodeTypeMismatch(t *testing.T) {
+
+	client := erroringClient(200, `{"request": {"id": "5656565656565656", "amount": "one thousand"}}`)
+	_, starkErrors := client.PixRequest.Get("5656565656565656")
+	assert.True(t, errors.Is(starkinfra.ToError(starkErrors), starkinfra.ErrDecode))
+
+	client = erroringClient(200, `{"requests": [{"id": "5656565656565656", "amount": []}]}`)
+	_, starkErrors = client.PixRequest.Create([]PixRequest.PixRequest{{Amount: 100}})
+	assert.Equal(t, starkinfra.CodeDecodeError, starkErrors.Errors[0].Code)
+}
+
+func TestDecodeMissingField(t *testing.T) {
+
+	client := erroringClient(200, `{"ledgerV2": {"id": "5656565656565656"}}`)
+	_, starkErrors := client.Ledger.Get("5656565656565656")
+	assert.Equal(t, starkinfra.CodeDecodeError, starkErrors.Errors[0].Code)
+
+	client = erroringClient(200, `not json`)
+	_, starkErrors = client.Event.Delete("5656565656565656")
+	assert.Equal(t, starkinfra.CodeDecodeError, starkErrors.Errors[0].Code)
+}
+
+func TestDecodeStrict(t *testing.T) {
+
+	content := `{"ledger": {"id": "5656565656565656", "newField": true}}`
+
+	ledger, starkErrors := erroringClient(200, content).Ledger.Get("5656565656565656")
+	assert.Nil(t, starkErrors.Errors)
+	assert.Equal(t, "5656565656565656", ledger.Id)
+
+	client := erroringClient(200, content)
+	client.Strict = true
+	_, starkErrors = client.Ledger.Get("5656565656565656")
+	assert.Equal(t, starkinfra.CodeDecodeError, starkErrors.Errors[0].Code)
+	assert.Contains(t, starkErrors.Errors[0].Message, "newField")
+
+	client = erroringClient(200, `{"cursor": null, "ledgers": [{"id": "1", "newField": true}]}`)
+	client.Strict = true
+	_, _, starkErrors = client.Ledger.Page(nil)
+	assert.Equal(t, starkinfra.CodeDecodeError, starkErrors.Errors[0].Code)
+}
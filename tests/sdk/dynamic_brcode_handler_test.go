@@ -0,0 +1,158 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	DynamicBrcode "github.com/starkinfra/sdk-go/starkinfra/dynamicbrcode"
+	"github.com/starkinfra/sdk-go/starkinfra/starktest"
+	"github.com/starkinfra/sdk-go/starkinfra/utils"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func dynamicBrcodeHandler(resolve func(ctx context.Context, uuid string) (DynamicBrcode.Read, error)) (*DynamicBrcode.Handler, *starktest.Signer) {
+	signer := starktest.NewSigner()
+	client, _ := pagingClient()
+	signer.Trust(client.Client)
+	return client.DynamicBrcode.NewHandler(resolve), signer
+}
+
+func read(handler http.Handler, uuid string, signature string) (int, string) {
+	request := httptest.NewRequest("GET", "/dynamic-brcode/"+uuid, nil)
+	request.Header.Set("Digital-Signature", signature)
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	return recorder.Code, recorder.Body.String()
+}
+
+func TestDynamicBrcodeHandlerServesReads(t *testing.T) {
+
+	reads := map[string]DynamicBrcode.Read{
+		"instant": instantRead(),
+		"due":     dueRead(),
+		"both":    DynamicBrcode.DueAndOrSubscription{Due: dueRead(), Subscription: subscription()},
+	}
+	handler, signer := dynamicBrcodeHandler(func(ctx context.Context, uuid string) (DynamicBrcode.Read, error) {
+		return reads[uuid], nil
+	})
+
+	for uuid, answer := range reads {
+		expected, _ := answer.Response()
+		status, body := read(handler, uuid, signer.Sign(uuid))
+		assert.Equal(t, http.StatusOK, status, uuid)
+		assert.Equal(t, expected, body, uuid)
+	}
+	status, _ := read(handler, "unknown", signer.Sign("unknown"))
+	assert.Equal(t, http.StatusNotFound, status)
+}
+
+func TestDynamicBrcodeHandlerRefusals(t *testing.T) {
+
+	var causes []error
+	handler, signer := dynamicBrcodeHandler(func(ctx context.Context, uuid string) (DynamicBrcode.Read, error) {
+		switch uuid {
+		case "missing":
+			return nil, fmt.Errorf("looking up %v: %w", uuid, DynamicBrcode.ErrNotFound)
+		case "broken":
+			return nil, fmt.Errorf("database is down")
+		case "panic":
+			panic("nil pointer")
+		case "invalid":
+			return DynamicBrcode.Instant{}, nil
+		}
+		t.Error("resolver should not run")
+		return nil, nil
+	})
+	handler.OnError = func(uuid string, cause error) {
+		causes = append(causes, cause)
+	}
+
+	status, _ := read(handler, "forged", signer.Sign("other"))
+	assert.Equal(t, http.StatusUnauthorized, status)
+	assert.True(t, errors.Is(causes[0], utils.ErrInvalidSignature))
+	for uuid, expected := range map[string]int{
+		"missing": http.StatusNotFound,
+		"broken":  http.StatusInternalServerError,
+		"panic":   http.StatusInternalServerError,
+		"invalid": http.StatusInternalServerError,
+	} {
+		status, _ := read(handler, uuid, signer.Sign(uuid))
+		assert.Equal(t, expected, status, uuid)
+	}
+	assert.Equal(t, 5, len(causes))
+
+	root := httptest.NewRecorder()
+	handler.ServeHTTP(root, httptest.NewRequest("GET", "/dynamic-brcode/", nil))
+	assert.Equal(t, http.StatusNotFound, root.Code)
+
+	request := httptest.NewRequest("POST", "/dynamic-brcode/instant", nil)
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+}
+
+func TestDynamicBrcodeHandlerDeadline(t *testing.T) {
+
+	var wait sync.WaitGroup
+	var lock sync.Mutex
+	var slow []time.Duration
+	var causes []error
+	handler, signer := dynamicBrcodeHandler(func(ctx context.Context, uuid string) (DynamicBrcode.Read, error) {
+		if uuid == "late" {
+			<-ctx.Done()
+		} else {
+			time.Sleep(100 * time.Millisecond)
+		}
+		return instantRead(), nil
+	})
+	handler.Deadline = 500 * time.Millisecond
+	handler.SlowThreshold = 50 * time.Millisecond
+	handler.OnSlow = func(uuid string, elapsed time.Duration) {
+		lock.Lock()
+		defer lock.Unlock()
+		slow = append(slow, elapsed)
+		wait.Done()
+	}
+	handler.OnError = func(uuid string, cause error) {
+		causes = append(causes, cause)
+	}
+
+	wait.Add(2)
+	status, _ := read(handler, "slow", signer.Sign("slow"))
+	assert.Equal(t, http.StatusOK, status)
+	status, _ = read(handler, "late", signer.Sign("late"))
+	assert.Equal(t, http.StatusGatewayTimeout, status)
+	wait.Wait()
+
+	assert.Equal(t, []error{context.DeadlineExceeded}, causes)
+	assert.Equal(t, 2, len(slow))
+	for _, elapsed := range slow {
+		assert.True(t, elapsed >= 100*time.Millisecond)
+	}
+}
+
+func TestDynamicBrcodeHandlerSlowVerification(t *testing.T) {
+
+	client, _ := pagingClient()
+	client.HttpClient = &http.Client{Transport: blockingTransport{}}
+	client.Host = strings.ToLower(t.Name())
+	handler := client.DynamicBrcode.NewHandler(func(ctx context.Context, uuid string) (DynamicBrcode.Read, error) {
+		t.Error("resolver should not run")
+		return instantRead(), nil
+	})
+	handler.Deadline = 50 * time.Millisecond
+	var causes []error
+	handler.OnError = func(uuid string, cause error) {
+		causes = append(causes, cause)
+	}
+
+	status, _ := read(handler, "instant", starktest.NewSigner().Sign("instant"))
+	assert.Equal(t, http.StatusGatewayTimeout, status)
+	assert.Equal(t, []error{context.DeadlineExceeded}, causes)
+}
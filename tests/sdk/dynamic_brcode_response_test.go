@@ -0,0 +1,183 @@
+package sdk
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"github.com/starkbank/ecdsa-go/v2/ellipticcurve/curve"
+	"github.com/starkbank/ecdsa-go/v2/ellipticcurve/ecdsa"
+	"github.com/starkbank/ecdsa-go/v2/ellipticcurve/privatekey"
+	"github.com/starkbank/ecdsa-go/v2/ellipticcurve/signature"
+	DynamicBrcode "github.com/starkinfra/sdk-go/starkinfra/dynamicbrcode"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+)
+
+var readCreated = time.Date(2020, 3, 10, 10, 30, 10, 0, time.FixedZone("BRT", -3*3600))
+
+func instantRead() DynamicBrcode.Instant {
+	return DynamicBrcode.Instant{
+		Version:          1,
+		Created:          readCreated,
+		KeyId:            "+5511989898989",
+		Status:           "created",
+		ReconciliationId: "cd65c78aeb6543eaaa0170f68bd741ee",
+		Amount:           1234,
+	}
+}
+
+func dueRead() DynamicBrcode.Due {
+	return DynamicBrcode.Due{
+		Version:            1,
+		Created:            readCreated,
+		Due:                time.Date(2020, 3, 20, 0, 0, 0, 0, time.UTC),
+		KeyId:              "+5511989898989",
+		Status:             "created",
+		ReconciliationId:   "cd65c78aeb6543eaaa0170f68bd741ee",
+		NominalAmount:      10000,
+		SenderName:         "Anthony Edward Stark",
+		SenderTaxId:        "01.001.001/0001-01",
+		ReceiverName:       "Jamie Lannister",
+		ReceiverTaxId:      "012.345.678-90",
+		ReceiverStreetLine: "Av. Paulista, 200",
+		ReceiverCity:       "Sao Paulo",
+		ReceiverStateCode:  "SP",
+		ReceiverZipCode:    "01234-567",
+		Discounts:          []DynamicBrcode.Discount{{Percentage: 5, Due: time.Date(2020, 3, 15, 0, 0, 0, 0, time.UTC)}},
+	}
+}
+
+func subscription() DynamicBrcode.Subscription {
+	return DynamicBrcode.Subscription{
+		Interval:         "month",
+		InstallmentStart: time.Date(2020, 4, 1, 0, 0, 0, 0, time.UTC),
+		ReferenceCode:    "contract-12345",
+		ReceiverName:     "Jamie Lannister",
+		ReceiverTaxId:    "012.345.678-90",
+		ReceiverBankCode: "20018183",
+		SenderFinalName:  "Anthony Edward Stark",
+		SenderFinalTaxId: "01.001.001/0001-01",
+		Amount:           1000,
+	}
+}
+
+func decodeRead(t *testing.T, read DynamicBrcode.Read) map[string]interface{} {
+	response, err := read.Response()
+	assert.Nil(t, err.Errors)
+	var fields map[string]interface{}
+	assert.Nil(t, json.Unmarshal([]byte(response), &fields))
+	return fields
+}
+
+func TestDynamicBrcodeReadResponses(t *testing.T) {
+
+	instant := decodeRead(t, instantRead())
+	assert.Equal(t, "2020-03-10T13:30:10.000000+00:00", instant["created"])
+	assert.Equal(t, float64(1234), instant["amount"])
+	assert.NotContains(t, instant, "cashAmount")
+
+	due := decodeRead(t, dueRead())
+	assert.Equal(t, "2020-03-20T00:00:00.000000+00:00", due["due"])
+	assert.Equal(t, []interface{}{map[string]interface{}{"percentage": float64(5), "due": "2020-03-15T00:00:00.000000+00:00"}}, due["discounts"])
+	assert.NotContains(t, due, "fine")
+
+	free := dueRead()
+	zero := 0.0
+	expiration := 3600
+	free.Fine, free.Interest, free.Expiration = &zero, &zero, &expiration
+	due = decodeRead(t, free)
+	assert.Equal(t, float64(0), due["fine"])
+	assert.Equal(t, float64(0), due["interest"])
+	assert.Equal(t, float64(3600), due["expiration"])
+
+	local := subscription()
+	local.InstallmentStart = time.Date(2020, 3, 31, 22, 0, 0, 0, time.FixedZone("BRT", -3*3600))
+	only := decodeRead(t, DynamicBrcode.SubscriptionRead{Version: 0, Created: readCreated, Status: "created", Subscription: local})
+	assert.Equal(t, "2020-04-01", only["subscription"].(map[string]interface{})["installmentStart"])
+	assert.NotContains(t, only, "reconciliationId")
+
+	both := decodeRead(t, DynamicBrcode.SubscriptionAndInstant{Instant: instantRead(), Subscription: subscription()})
+	assert.Equal(t, float64(1234), both["amount"])
+	assert.Equal(t, "month", both["subscription"].(map[string]interface{})["interval"])
+
+	either := decodeRead(t, DynamicBrcode.DueAndOrSubscription{Due: dueRead(), Subscription: subscription()})
+	assert.Equal(t, float64(10000), either["nominalAmount"])
+	assert.Equal(t, float64(1000), either["subscription"].(map[string]interface{})["amount"])
+}
+
+func TestDynamicBrcodeReadValidation(t *testing.T) {
+
+	short := instantRead()
+	short.ReconciliationId = "cd65c78aeb6543eaaa0170f68"
+	symbols := instantRead()
+	symbols.ReconciliationId = "cd65c78a-eb6543eaaa0170f68bd741ee"
+	cash := instantRead()
+	cash.CashAmount = 1000
+	missing := dueRead()
+	missing.ReceiverCity = ""
+	late := dueRead()
+	late.Discounts[0].Due = time.Date(2020, 3, 21, 0, 0, 0, 0, time.UTC)
+	state := dueRead()
+	state.ReceiverStateCode = "Sao Paulo"
+	negative := -1.0
+	fined := dueRead()
+	fined.Fine = &negative
+	unencodable := dueRead()
+	unencodable.Discounts[0].Percentage = math.NaN()
+	nan := math.NaN()
+	unencodable.Interest = &nan
+	expired := instantRead()
+	expiration := -1
+	expired.Expiration = &expiration
+	ended := subscription()
+	ended.InstallmentEnd = ended.InstallmentStart
+
+	for _, read := range []DynamicBrcode.Read{
+		DynamicBrcode.Instant{},
+		expired,
+		short,
+		symbols,
+		cash,
+		missing,
+		late,
+		state,
+		fined,
+		unencodable,
+		DynamicBrcode.SubscriptionRead{Created: readCreated, Status: "created", Subscription: ended},
+		DynamicBrcode.DueAndOrSubscription{Due: dueRead()},
+	} {
+		response, err := read.Response()
+		assert.Equal(t, "", response)
+		assert.Equal(t, "inputError", err.Errors[0].Code, read)
+	}
+	_, err := missing.Response()
+	assert.Contains(t, err.Errors[0].Message, "ReceiverCity")
+}
+
+func TestDynamicBrcodeJws(t *testing.T) {
+
+	key := privatekey.New(curve.Secp256k1)
+	response, _ := instantRead().Response()
+	jws, err := DynamicBrcode.Jws(response, &key, "5656565656565656")
+	assert.Nil(t, err.Errors)
+
+	parts := strings.Split(jws, ".")
+	assert.Equal(t, 3, len(parts))
+	header, _ := base64.RawURLEncoding.DecodeString(parts[0])
+	assert.JSONEq(t, `{"alg": "ES256K", "typ": "JOSE", "kid": "5656565656565656"}`, string(header))
+	payload, _ := base64.RawURLEncoding.DecodeString(parts[1])
+	assert.Equal(t, response, string(payload))
+
+	raw, _ := base64.RawURLEncoding.DecodeString(parts[2])
+	assert.Equal(t, 64, len(raw))
+	r, s := new(big.Int).SetBytes(raw[:32]), new(big.Int).SetBytes(raw[32:])
+	publicKey := key.PublicKey()
+	assert.True(t, ecdsa.Verify(parts[0]+"."+parts[1], signature.New(*r, *s), &publicKey))
+
+	jws, err = DynamicBrcode.Jws(response, nil, "")
+	assert.Equal(t, "", jws)
+	assert.Equal(t, "inputError", err.Errors[0].Code)
+}
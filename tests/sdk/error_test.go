@@ -0,0 +1,69 @@
+package sdk
+
+import (
+	"errors"
+	"github.com/starkinfra/sdk-go/starkinfra"
+	Event "github.com/starkinfra/sdk-go/starkinfra/event"
+	PixRequest "github.com/starkinfra/sdk-go/starkinfra/pixrequest"
+	Utils "github.com/starkinfra/sdk-go/tests/utils"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+	"time"
+)
+
+type blockingTransport struct{}
+
+func (blockingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	<-req.Context().Done()
+	return nil, req.Context().Err()
+}
+
+func erroringClient(status int, body string) *starkinfra.Client {
+	client := starkinfra.NewClient(Utils.ExampleProject)
+	client.HttpClient = &http.Client{Transport: &scriptedTransport{responses: []scriptedResponse{{status, body}}}}
+	return client
+}
+
+func TestErrorInputBatch(t *testing.T) {
+
+	client := erroringClient(400, `{"errors": [
+		{"code": "invalidExternalId", "message": "Element 0: externalId already in use"},
+		{"code": "insufficientBalance", "message": "Element 1: insufficient balance"}
+	]}`)
+	_, starkErrors := client.PixRequest.Create([]PixRequest.PixRequest{{Amount: 100}, {Amount: 200}})
+	err := starkinfra.ToError(starkErrors)
+
+	assert.True(t, errors.Is(err, starkinfra.ErrInput))
+	assert.False(t, errors.Is(err, starkinfra.ErrUnknown))
+
+	var starkError *starkinfra.Error
+	assert.True(t, errors.As(err, &starkError))
+	assert.Equal(t, 2, len(starkError.Errors))
+	assert.True(t, starkError.Has(starkinfra.CodeInvalidExternalId))
+	assert.True(t, starkError.Has(starkinfra.CodeInsufficientBalance))
+	assert.Contains(t, err.Error(), "Element 1: insufficient balance")
+}
+
+func TestErrorKinds(t *testing.T) {
+
+	_, starkErrors := erroringClient(500, "").PixRequest.Get("5656565656565656")
+	assert.True(t, errors.Is(starkinfra.ToError(starkErrors), starkinfra.ErrInternalServer))
+
+	_, starkErrors = erroringClient(429, "slow down").PixRequest.Get("5656565656565656")
+	assert.True(t, errors.Is(starkinfra.ToError(starkErrors), starkinfra.ErrRateLimited))
+
+	_, starkErrors = erroringClient(403, "forbidden").PixRequest.Get("5656565656565656")
+	assert.True(t, errors.Is(starkinfra.ToError(starkErrors), starkinfra.ErrUnknown))
+
+	client := starkinfra.NewClient(Utils.ExampleProject)
+	client.HttpClient = &http.Client{Transport: blockingTransport{}, Timeout: 10 * time.Millisecond}
+	_, starkErrors = client.PixRequest.Get("5656565656565656")
+	assert.True(t, errors.Is(starkinfra.ToError(starkErrors), starkinfra.ErrTimeout))
+
+	_, starkErrors = Event.Parse("{}", "invalid signature", Utils.ExampleProject)
+	assert.True(t, errors.Is(starkinfra.ToError(starkErrors), starkinfra.ErrInvalidSignature))
+
+	_, starkErrors = erroringClient(200, `{"request": {"id": "5656565656565656"}}`).PixRequest.Get("5656565656565656")
+	assert.Nil(t, starkinfra.ToError(starkErrors))
+}
@@ -0,0 +1,81 @@
+package sdk
+
+import (
+	"github.com/starkinfra/sdk-go/starkinfra/event"
+	"github.com/starkinfra/sdk-go/starkinfra/event/attempt"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func minute(m int) *time.Time {
+	datetime := time.Date(2022, 11, 10, 10, m, 0, 0, time.UTC)
+	return &datetime
+}
+
+func TestAttemptSummarize(t *testing.T) {
+
+	events := []event.Event{
+		{Id: "1", Subscription: "pix-request.in", IsDelivered: true, Created: minute(0)},
+		{Id: "2", Subscription: "pix-request.in", IsDelivered: true, Created: minute(1)},
+		{Id: "3", Subscription: "pix-request.in", IsDelivered: false, Created: minute(2)},
+		{Id: "4", Subscription: "issuing-card", IsDelivered: true, Created: minute(3)},
+	}
+	attempts := []attempt.Attempt{
+		{EventId: "2", WebhookId: "a", Code: "timeout", Created: minute(1)},
+		{EventId: "2", WebhookId: "a", Code: "badHttpStatus", Created: minute(2)},
+		{EventId: "3", WebhookId: "a", Code: "badHttpStatus", Created: minute(3)},
+		{EventId: "3", WebhookId: "b", Code: "badHttpStatus", Created: minute(4)},
+		{EventId: "3", WebhookId: "b", Code: "badHttpStatus", Created: minute(5)},
+		{EventId: "9", WebhookId: "b", Code: "badConnection", Created: minute(6)},
+	}
+
+	report := attempt.Summarize(attempts, events)
+
+	assert.Equal(t, attempt.WebhookReport{Failures: 3, Codes: map[string]int{"timeout": 1, "badHttpStatus": 2}}, report.Webhooks["a"])
+	assert.Equal(t, attempt.WebhookReport{Failures: 3, Codes: map[string]int{"badHttpStatus": 2, "badConnection": 1}}, report.Webhooks["b"])
+
+	pix := report.Subscriptions["pix-request.in"]
+	assert.Equal(t, 3, pix.Events)
+	assert.Equal(t, 2, pix.Delivered)
+	assert.Equal(t, 5, pix.Failures)
+	assert.InDelta(t, 5.0/7.0, pix.FailureRate, 1e-9)
+	assert.Equal(t, minute(2), pix.DeliveredAfter)
+	assert.Equal(t, 3, pix.LongestFailureStreak)
+
+	card := report.Subscriptions["issuing-card"]
+	assert.Equal(t, 0.0, card.FailureRate)
+	assert.Equal(t, 0, card.LongestFailureStreak)
+	assert.Equal(t, minute(3), card.DeliveredAfter)
+
+	unknown := report.Subscriptions[attempt.UnknownSubscription]
+	assert.Equal(t, 1, unknown.Failures)
+	assert.Nil(t, unknown.DeliveredAfter)
+	assert.Equal(t, 1.0, unknown.FailureRate)
+}
+
+func TestAttemptDiagnose(t *testing.T) {
+
+	client, transport := pagingClient(
+		scriptedResponse{200, `{"cursor": null, "events": [{"id": "1", "subscription": "pix-key", "isDelivered": true, "created": "2022-11-10T09:59:00+00:00"}]}`},
+		scriptedResponse{200, `{"cursor": null, "attempts": [
+			{"eventId": "1", "webhookId": "a", "code": "timeout", "created": "2022-11-10T10:00:00+00:00"},
+			{"eventId": "0", "webhookId": "a", "code": "timeout", "created": "2022-11-10T10:01:00+00:00"},
+			{"eventId": "9", "webhookId": "a", "code": "timeout", "created": "2022-11-10T10:02:00+00:00"}
+		]}`},
+		scriptedResponse{200, `{"cursor": null, "events": [{"id": "0", "subscription": "pix-key", "isDelivered": true, "created": "2022-11-09T23:59:00+00:00"}]}`},
+	)
+
+	report, err := client.EventAttempt.Diagnose(*minute(0), time.Time{})
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, 3, report.Webhooks["a"].Codes["timeout"])
+	assert.Equal(t, 2, report.Subscriptions["pix-key"].Failures)
+	assert.Equal(t, 1, report.Subscriptions["pix-key"].Events)
+	assert.True(t, minute(0).Equal(*report.Subscriptions["pix-key"].DeliveredAfter))
+	assert.Equal(t, 1, report.Subscriptions[attempt.UnknownSubscription].Failures)
+	assert.Equal(t, 3, len(transport.requests))
+	assert.Equal(t, "2022-11-10", transport.requests[0].URL.Query().Get("after"))
+	assert.Equal(t, "", transport.requests[1].URL.Query().Get("before"))
+	assert.Equal(t, "/v2/event", transport.requests[2].URL.Path)
+	assert.Equal(t, "0,9", transport.requests[2].URL.Query().Get("ids"))
+}
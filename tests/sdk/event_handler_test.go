@@ -0,0 +1,137 @@
+package sdk
+
+import (
+	"errors"
+	"fmt"
+	"github.com/starkbank/ecdsa-go/v2/ellipticcurve/curve"
+	"github.com/starkbank/ecdsa-go/v2/ellipticcurve/ecdsa"
+	"github.com/starkbank/ecdsa-go/v2/ellipticcurve/privatekey"
+	Error "github.com/starkinfra/core-go/starkcore/error"
+	"github.com/starkinfra/sdk-go/starkinfra"
+	"github.com/starkinfra/sdk-go/starkinfra/event"
+	IssuingCardLog "github.com/starkinfra/sdk-go/starkinfra/issuingcard/log"
+	PixRequestLog "github.com/starkinfra/sdk-go/starkinfra/pixrequest/log"
+	"github.com/starkinfra/sdk-go/starkinfra/utils"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+const pixRequestEvent = `{"event": {"id": "5656565656565656", "subscription": "pix-request.in", "log": {"id": "1", "type": "credited", "request": {"id": "2", "amount": 1000}}}}`
+
+func webhookHandler(t *testing.T) (*event.Handler, func(content string) string) {
+	key := privatekey.New(curve.Secp256k1)
+	transport := &scriptedTransport{responses: []scriptedResponse{
+		{200, fmt.Sprintf(`{"publicKeys": [{"content": %v}]}`, jsonString(key.PublicKey().ToPem()))},
+	}}
+	client, _ := pagingClient()
+	client.HttpClient = &http.Client{Transport: transport}
+	client.Host = strings.ToLower(strings.ReplaceAll(t.Name(), "/", ""))
+	sign := func(content string) string {
+		return ecdsa.Sign(content, &key).ToBase64()
+	}
+	return client.Event.NewHandler(), sign
+}
+
+func deliver(handler http.Handler, method string, content string, signature string) int {
+	request := httptest.NewRequest(method, "/webhook", strings.NewReader(content))
+	request.Header.Set("Digital-Signature", signature)
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	return recorder.Code
+}
+
+func TestEventHandlerDispatch(t *testing.T) {
+
+	handler, sign := webhookHandler(t)
+	var received []PixRequestLog.Log
+	handler.OnPixRequestIn(func(log PixRequestLog.Log) error {
+		received = append(received, log)
+		return nil
+	})
+	handler.OnIssuingCard(func(log IssuingCardLog.Log) error {
+		t.Error("issuing-card callback should not run")
+		return nil
+	})
+
+	assert.Equal(t, http.StatusOK, deliver(handler, "POST", pixRequestEvent, sign(pixRequestEvent)))
+	assert.Equal(t, 1, len(received))
+	assert.Equal(t, "credited", received[0].Type)
+	assert.Equal(t, "2", received[0].Request.Id)
+}
+
+func TestEventHandlerCallbackError(t *testing.T) {
+
+	handler, sign := webhookHandler(t)
+	handler.OnPixRequestIn(func(log PixRequestLog.Log) error {
+		return errors.New("database unavailable")
+	})
+
+	status := deliver(handler, "POST", pixRequestEvent, sign(pixRequestEvent))
+	assert.Equal(t, http.StatusInternalServerError, status)
+}
+
+func TestEventHandlerUnexpectedLogType(t *testing.T) {
+
+	event.Register("pix-request.in", func(api *utils.Client, content []byte) (interface{}, Error.StarkErrors) {
+		return map[string]interface{}{}, Error.StarkErrors{}
+	})
+	defer event.Register("pix-request.in", event.LogDecoder(PixRequestLog.Log{}))
+
+	handler, sign := webhookHandler(t)
+	handler.OnPixRequestIn(func(log PixRequestLog.Log) error {
+		t.Error("callback should not run")
+		return nil
+	})
+
+	status := deliver(handler, "POST", pixRequestEvent, sign(pixRequestEvent))
+	assert.Equal(t, http.StatusInternalServerError, status)
+
+	var logTypeError event.LogTypeError
+	err := handler.Dispatch(event.Event{Subscription: "pix-request.in", Log: "not a log"})
+	assert.True(t, errors.As(err, &logTypeError))
+	assert.Equal(t, "pix-request.in", logTypeError.Subscription)
+}
+
+func TestEventHandlerDispatchDecodeError(t *testing.T) {
+
+	event.Register("pix-request.in", func(api *utils.Client, content []byte) (interface{}, Error.StarkErrors) {
+		return nil, utils.DecodeError("corrupt log")
+	})
+	defer event.Register("pix-request.in", event.LogDecoder(PixRequestLog.Log{}))
+
+	handler := event.NewHandler(nil)
+	handler.OnPixRequestIn(func(log PixRequestLog.Log) error {
+		t.Error("callback should not run")
+		return nil
+	})
+
+	var starkError *starkinfra.Error
+	err := handler.Dispatch(event.Event{Subscription: "pix-request.in", Log: map[string]interface{}{"id": "1"}})
+	assert.True(t, errors.Is(err, starkinfra.ErrDecode))
+	assert.True(t, errors.As(err, &starkError))
+	assert.True(t, starkError.Has(starkinfra.CodeDecodeError))
+}
+
+func TestEventHandlerUnregisteredSubscription(t *testing.T) {
+
+	handler, sign := webhookHandler(t)
+
+	assert.Equal(t, http.StatusOK, deliver(handler, "POST", pixRequestEvent, sign(pixRequestEvent)))
+}
+
+func TestEventHandlerRefusals(t *testing.T) {
+
+	handler, sign := webhookHandler(t)
+	handler.OnPixRequestIn(func(log PixRequestLog.Log) error {
+		t.Error("callback should not run")
+		return nil
+	})
+
+	assert.Equal(t, http.StatusUnauthorized, deliver(handler, "POST", pixRequestEvent, sign("other content")))
+	assert.Equal(t, http.StatusUnauthorized, deliver(handler, "POST", pixRequestEvent, "not a signature"))
+	assert.Equal(t, http.StatusBadRequest, deliver(handler, "POST", "not json", sign("not json")))
+	assert.Equal(t, http.StatusMethodNotAllowed, deliver(handler, "GET", "", ""))
+}
@@ -0,0 +1,71 @@
+package sdk
+
+import (
+	"github.com/starkinfra/sdk-go/starkinfra/event"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestEventLogAccessors(t *testing.T) {
+
+	raw := rawEvent("pix-request.out", `{"id": "1", "type": "success", "request": {"id": "2", "amount": 100}}`)
+
+	log, ok, err := raw.AsPixRequestLog()
+	assert.True(t, ok)
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, "success", log.Type)
+	assert.Equal(t, 100, log.Request.Amount)
+
+	parsed, _ := raw.ParseLog()
+	log, ok, _ = parsed.AsPixRequestLog()
+	assert.True(t, ok)
+	assert.Equal(t, "2", log.Request.Id)
+
+	_, ok, err = parsed.AsPixReversalLog()
+	assert.False(t, ok)
+	assert.Nil(t, err.Errors)
+	_, ok, err = rawEvent("unknown-subscription", `{"id": "1"}`).AsPixRequestLog()
+	assert.False(t, ok)
+	assert.Nil(t, err.Errors)
+	_, ok, err = rawEvent("pix-request.in", `{"id": 1}`).AsPixRequestLog()
+	assert.False(t, ok)
+	assert.Equal(t, "decodeError", err.Errors[0].Code)
+}
+
+func TestEventLogAccessorsClientStrict(t *testing.T) {
+
+	client, _ := pagingClient()
+	client.Strict = true
+	raw := rawEvent("pix-request.in", `{"id": "1", "type": "credited", "unknownField": true}`)
+
+	_, ok, err := raw.AsPixRequestLog()
+	assert.True(t, ok)
+	assert.Nil(t, err.Errors)
+	_, ok, err = client.Event.AsPixRequestLog(raw)
+	assert.False(t, ok)
+	assert.Equal(t, "decodeError", err.Errors[0].Code)
+}
+
+func TestParseEventsCollectsErrors(t *testing.T) {
+
+	events := []event.Event{
+		rawEvent("pix-request.in", `{"id": "1", "type": "credited"}`),
+		rawEvent("pix-request.in", `{"id": 2}`),
+		rawEvent("issuing-card", `{"id": "3", "type": "created"}`),
+	}
+	events[1].Id = "bad"
+
+	parsed, err := event.ParseEvents(events)
+	assert.Equal(t, 3, len(parsed))
+	assert.Equal(t, 1, len(err.Errors))
+	assert.Equal(t, "decodeError", err.Errors[0].Code)
+	assert.True(t, strings.HasPrefix(err.Errors[0].Message, "Event bad: "))
+
+	_, ok, _ := parsed[0].AsPixRequestLog()
+	assert.True(t, ok)
+	assert.Equal(t, map[string]interface{}{"id": float64(2)}, parsed[1].Log)
+	card, ok, _ := parsed[2].AsIssuingCardLog()
+	assert.True(t, ok)
+	assert.Equal(t, "created", card.Type)
+}
@@ -0,0 +1,100 @@
+package sdk
+
+import (
+	"errors"
+	"github.com/starkinfra/sdk-go/starkinfra/event"
+	PixRequestLog "github.com/starkinfra/sdk-go/starkinfra/pixrequest/log"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestProcessorSkipsDuplicates(t *testing.T) {
+
+	var handled int32
+	processor := event.NewProcessor(event.NewMemoryStore(), func(e event.Event) error {
+		atomic.AddInt32(&handled, 1)
+		return nil
+	})
+
+	var wait sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wait.Add(1)
+		go func() {
+			defer wait.Done()
+			assert.Nil(t, processor.Process(event.Event{Id: "5656565656565656"}))
+		}()
+	}
+	wait.Wait()
+	assert.Nil(t, processor.Process(event.Event{Id: "5656565656565657"}))
+	assert.Equal(t, int32(2), handled)
+}
+
+func TestProcessorRetriesFailures(t *testing.T) {
+
+	store := event.NewMemoryStore()
+	fail := true
+	processor := event.NewProcessor(store, func(e event.Event) error {
+		if fail {
+			return errors.New("database unavailable")
+		}
+		return nil
+	})
+
+	assert.NotNil(t, processor.Process(event.Event{Id: "1"}))
+	handled, _ := store.Has("1")
+	assert.False(t, handled)
+
+	fail = false
+	assert.Nil(t, processor.Process(event.Event{Id: "1"}))
+	handled, _ = store.Has("1")
+	assert.True(t, handled)
+}
+
+func TestFileStore(t *testing.T) {
+
+	dir, _ := ioutil.TempDir("", "events")
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "handled.txt")
+
+	store, err := event.NewFileStore(path)
+	assert.Nil(t, err)
+	assert.Nil(t, store.Add("1"))
+	assert.Nil(t, store.Add("2"))
+	assert.Nil(t, store.Close())
+
+	file, _ := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0644)
+	file.WriteString("3")
+	file.Close()
+
+	store, err = event.NewFileStore(path)
+	assert.Nil(t, err)
+	defer store.Close()
+	for id, expected := range map[string]bool{"1": true, "2": true, "3": false} {
+		handled, _ := store.Has(id)
+		assert.Equal(t, expected, handled, id)
+	}
+	assert.Nil(t, store.Add("4"))
+	content, _ := ioutil.ReadFile(path)
+	assert.Equal(t, "1\n2\n4\n", string(content))
+}
+
+func TestEventHandlerDeduplicate(t *testing.T) {
+
+	handler, sign := webhookHandler(t)
+	handler.Deduplicate(event.NewMemoryStore())
+	var received int
+	handler.OnPixRequestIn(func(log PixRequestLog.Log) error {
+		received++
+		return nil
+	})
+
+	assert.Equal(t, http.StatusOK, deliver(handler, "POST", pixRequestEvent, sign(pixRequestEvent)))
+	assert.Equal(t, http.StatusOK, deliver(handler, "POST", pixRequestEvent, sign(pixRequestEvent)))
+	assert.Equal(t, 1, received)
+}
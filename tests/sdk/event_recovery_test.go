@@ -0,0 +1,183 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/starkinfra/sdk-go/starkinfra"
+	"github.com/starkinfra/sdk-go/starkinfra/event"
+	PixRequestLog "github.com/starkinfra/sdk-go/starkinfra/pixrequest/log"
+	Utils "github.com/starkinfra/sdk-go/tests/utils"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+type eventServer struct {
+	mutex     sync.Mutex
+	pages     map[string]string
+	delivered []string
+	queries   []*http.Request
+	refused   map[string]string
+}
+
+func (s *eventServer) RoundTrip(req *http.Request) (*http.Response, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	id := strings.TrimPrefix(req.URL.Path, "/v2/event/")
+	status, body := 200, `{"event": {"id": "`+id+`"}}`
+	if req.Method == "GET" {
+		s.queries = append(s.queries, req)
+		body = s.pages[req.URL.Query().Get("cursor")]
+	} else if refusal, ok := s.refused[id]; ok {
+		status, body = 400, refusal
+	} else {
+		s.delivered = append(s.delivered, id)
+	}
+	return &http.Response{
+		StatusCode: status,
+		Body:       ioutil.NopCloser(strings.NewReader(body)),
+		Header:     http.Header{},
+		Request:    req,
+	}, nil
+}
+
+func recoveryEvents(ids ...string) string {
+	var events []string
+	for _, id := range ids {
+		events = append(events, fmt.Sprintf(`{"id": "%v", "subscription": "pix-request.in", "log": {"id": "log-%v", "type": "credited"}}`, id, id))
+	}
+	return strings.Join(events, ", ")
+}
+
+func recoveryClient(server *eventServer) *starkinfra.Client {
+	client := starkinfra.NewClient(Utils.ExampleProject)
+	client.HttpClient = &http.Client{Transport: server}
+	return client
+}
+
+func TestEventRecovery(t *testing.T) {
+
+	server := &eventServer{pages: map[string]string{
+		"":       `{"cursor": "second", "events": [` + recoveryEvents("1", "2", "3") + `]}`,
+		"second": `{"cursor": null, "events": [` + recoveryEvents("4", "5") + `, {"id": "6", "subscription": "pix-request.in", "isDelivered": true, "log": {"id": "log-6"}}]}`,
+	}}
+	handler := event.NewHandler(nil)
+	handler.OnPixRequestIn(func(log PixRequestLog.Log) error {
+		if log.Id == "log-3" {
+			return errors.New("database unavailable")
+		}
+		return nil
+	})
+
+	recovery := recoveryClient(server).Event.NewRecovery(handler.Dispatch)
+	recovery.After = time.Date(2022, 11, 10, 0, 0, 0, 0, time.UTC)
+	recovery.Concurrency = 4
+	report, err := recovery.Run(context.Background())
+	assert.Nil(t, err.Errors)
+
+	sort.Strings(report.Delivered)
+	sort.Strings(server.delivered)
+	assert.Equal(t, []string{"1", "2", "4", "5"}, report.Delivered)
+	assert.Equal(t, []string{"1", "2", "4", "5"}, server.delivered)
+	assert.Equal(t, 1, len(report.Failed))
+	assert.NotNil(t, report.Failed["3"])
+
+	query := server.queries[0].URL.Query()
+	assert.Equal(t, "", query.Get("isDelivered"))
+	assert.Equal(t, "2022-11-10", query.Get("after"))
+	assert.Equal(t, "100", query.Get("limit"))
+}
+
+func TestEventRecoveryTypedFailures(t *testing.T) {
+
+	server := &eventServer{
+		pages:   map[string]string{"": `{"cursor": null, "events": [` + recoveryEvents("1", "2") + `]}`},
+		refused: map[string]string{"2": `{"errors": [{"code": "invalidEvent", "message": "first"}, {"code": "eventLocked", "message": "second"}]}`},
+	}
+	recovery := recoveryClient(server).Event.NewRecovery(func(event event.Event) error {
+		return nil
+	})
+	report, err := recovery.Run(context.Background())
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, []string{"1"}, report.Delivered)
+
+	var starkError *starkinfra.Error
+	assert.True(t, errors.Is(report.Failed["2"], starkinfra.ErrInput))
+	assert.True(t, errors.As(report.Failed["2"], &starkError))
+	assert.True(t, starkError.Has("invalidEvent"))
+	assert.True(t, starkError.Has("eventLocked"))
+}
+
+func TestEventRecoveryCheckpoint(t *testing.T) {
+
+	dir, _ := ioutil.TempDir("", "recovery")
+	defer os.RemoveAll(dir)
+	checkpoint := &event.FileCheckpoint{Path: filepath.Join(dir, "recovery.checkpoint")}
+	checkpoint.Save("second")
+
+	server := &eventServer{pages: map[string]string{
+		"":       `{"cursor": "second", "events": [` + recoveryEvents("1") + `]}`,
+		"second": `{"cursor": null, "events": [` + recoveryEvents("2") + `]}`,
+	}}
+	recovery := recoveryClient(server).Event.NewRecovery(func(e event.Event) error {
+		return nil
+	})
+	recovery.Checkpoint = checkpoint
+	report, err := recovery.Run(context.Background())
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, []string{"2"}, report.Delivered)
+
+	cursor, loadError := checkpoint.Load()
+	assert.Nil(t, loadError)
+	assert.Equal(t, "", cursor)
+}
+
+func TestEventRecoveryCheckpointKeepsFailures(t *testing.T) {
+
+	dir, _ := ioutil.TempDir("", "recovery")
+	defer os.RemoveAll(dir)
+	checkpoint := &event.FileCheckpoint{Path: filepath.Join(dir, "recovery.checkpoint")}
+
+	server := &eventServer{pages: map[string]string{
+		"":       `{"cursor": "second", "events": [` + recoveryEvents("1") + `]}`,
+		"second": `{"cursor": "third", "events": [` + recoveryEvents("2") + `]}`,
+		"third":  `{"cursor": null, "events": [` + recoveryEvents("3") + `]}`,
+	}}
+	recovery := recoveryClient(server).Event.NewRecovery(func(e event.Event) error {
+		if e.Id == "2" {
+			return errors.New("database unavailable")
+		}
+		return nil
+	})
+	recovery.Checkpoint = checkpoint
+	report, err := recovery.Run(context.Background())
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, []string{"1", "3"}, report.Delivered)
+
+	cursor, _ := checkpoint.Load()
+	assert.Equal(t, "second", cursor)
+}
+
+func TestEventRecoveryCanceled(t *testing.T) {
+
+	server := &eventServer{pages: map[string]string{
+		"":       `{"cursor": "second", "events": [` + recoveryEvents("1", "2") + `]}`,
+		"second": `{"cursor": null, "events": [` + recoveryEvents("3") + `]}`,
+	}}
+	ctx, cancel := context.WithCancel(context.Background())
+	recovery := recoveryClient(server).Event.NewRecovery(func(e event.Event) error {
+		cancel()
+		return nil
+	})
+	_, err := recovery.Run(ctx)
+	assert.Equal(t, "unknownError", err.Errors[0].Code)
+	assert.Equal(t, 1, len(server.queries))
+}
@@ -0,0 +1,77 @@
+package sdk
+
+import (
+	"encoding/json"
+	Error "github.com/starkinfra/core-go/starkcore/error"
+	"github.com/starkinfra/sdk-go/starkinfra/event"
+	IndividualIdentityLog "github.com/starkinfra/sdk-go/starkinfra/individualidentity/log"
+	LedgerLog "github.com/starkinfra/sdk-go/starkinfra/ledger/log"
+	PixInternalTransactionReportLog "github.com/starkinfra/sdk-go/starkinfra/pixinternaltransactionreport/log"
+	"github.com/starkinfra/sdk-go/starkinfra/utils"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func rawEvent(subscription string, log string) event.Event {
+	var data interface{}
+	json.Unmarshal([]byte(log), &data)
+	return event.Event{Id: "5656565656565656", Subscription: subscription, Log: data}
+}
+
+func TestEventRegistryDecodesEveryLogPackage(t *testing.T) {
+
+	parsed, err := rawEvent("individual-identity", `{"id": "1", "type": "created"}`).ParseLog()
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, "created", parsed.Log.(IndividualIdentityLog.Log).Type)
+
+	parsed, err = rawEvent("ledger", `{"id": "2", "type": "success"}`).ParseLog()
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, "2", parsed.Log.(LedgerLog.Log).Id)
+
+	parsed, err = rawEvent("pix-internal-transaction-report", `{"id": "3"}`).ParseLog()
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, "3", parsed.Log.(PixInternalTransactionReportLog.Log).Id)
+
+	assert.Subset(t, event.Subscriptions(), []string{"business-attachment", "credit-holmes", "individual-document", "issuing-embossing-request", "issuing-holder", "issuing-restock", "issuing-stock", "pix-fraud"})
+}
+
+func TestEventRegistryUnknownSubscription(t *testing.T) {
+
+	parsed, err := rawEvent("unknown-subscription", `{"id": "1"}`).ParseLog()
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, map[string]interface{}{"id": "1"}, parsed.Log)
+}
+
+type customLog struct {
+	Id     string
+	Status string
+}
+
+func TestEventRegistryRegister(t *testing.T) {
+
+	event.Register("custom-subscription", event.LogDecoder(customLog{}))
+	parsed, err := rawEvent("custom-subscription", `{"id": "1", "status": "done"}`).ParseLog()
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, customLog{Id: "1", Status: "done"}, parsed.Log)
+	assert.Contains(t, event.Subscriptions(), "custom-subscription")
+
+	event.Register("failing-subscription", func(api *utils.Client, content []byte) (interface{}, Error.StarkErrors) {
+		return nil, Error.UnknownError("cannot decode")
+	})
+	_, err = rawEvent("failing-subscription", `{}`).ParseLog()
+	assert.Equal(t, "unknownError", err.Errors[0].Code)
+}
+
+func TestEventRegistryClientStrict(t *testing.T) {
+
+	client, _ := pagingClient()
+	client.Strict = true
+	strange := rawEvent("ledger", `{"id": "2", "type": "success", "newField": true}`)
+
+	_, err := client.Event.ParseLog(strange)
+	assert.Equal(t, "decodeError", err.Errors[0].Code)
+
+	parsed, err := strange.ParseLog()
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, "2", parsed.Log.(LedgerLog.Log).Id)
+}
@@ -0,0 +1,75 @@
+package sdk
+
+import (
+	"encoding/json"
+	"github.com/starkbank/ecdsa-go/v2/ellipticcurve/curve"
+	"github.com/starkbank/ecdsa-go/v2/ellipticcurve/ecdsa"
+	"github.com/starkbank/ecdsa-go/v2/ellipticcurve/privatekey"
+	"github.com/starkinfra/sdk-go/starkinfra"
+	IssuingPurchase "github.com/starkinfra/sdk-go/starkinfra/issuingpurchase"
+	Utils "github.com/starkinfra/sdk-go/tests/utils"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+func TestExtraGet(t *testing.T) {
+
+	client := erroringClient(200, `{"chargeback": {"id": "5656565656565656", "amount": 100, "newField": {"a": 1}}}`)
+	chargeback, err := client.PixChargeback.Get("5656565656565656")
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, 100, chargeback.Amount)
+	assert.Equal(t, 1, len(chargeback.Extra))
+	assert.JSONEq(t, `{"a": 1}`, string(chargeback.Extra["newField"]))
+
+	bytes, _ := json.Marshal(chargeback)
+	assert.NotContains(t, string(bytes), "newField")
+}
+
+func TestExtraPageAndQuery(t *testing.T) {
+
+	content := `{"cursor": null, "logs": [
+		{"id": "1", "type": "created", "request": {"id": "10", "newRequestField": "x"}, "newLogField": true},
+		{"id": "2", "type": "sent", "request": {"id": "20"}}
+	]}`
+
+	logs, _, err := erroringClient(200, content).PixRequestLog.Page(nil)
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, "true", string(logs[0].Extra["newLogField"]))
+	assert.Equal(t, `"x"`, string(logs[0].Request.Extra["newRequestField"]))
+	assert.Nil(t, logs[1].Extra)
+	assert.Nil(t, logs[1].Request.Extra)
+
+	queried, errorChannel := erroringClient(200, content).PixRequestLog.Query(nil)
+	var received []string
+	for log := range queried {
+		received = append(received, string(log.Extra["newLogField"]))
+	}
+	for e := range errorChannel {
+		assert.Nil(t, e.Errors)
+	}
+	assert.Equal(t, []string{"true", ""}, received)
+}
+
+func TestExtraParse(t *testing.T) {
+
+	privateKey := privatekey.New(curve.Secp256k1)
+	content := `{"id": "5656565656565656", "amount": 100, "newField": "kept"}`
+	signature := ecdsa.Sign(content, &privateKey).ToBase64()
+
+	client := starkinfra.NewClient(Utils.ExampleProject)
+	client.HttpClient = &http.Client{Transport: &scriptedTransport{responses: []scriptedResponse{
+		{200, `{"publicKeys": [{"content": ` + jsonString(privateKey.PublicKey().ToPem()) + `}]}`},
+	}}}
+	client.Host = "infra-extra-test"
+
+	purchase, err := client.IssuingPurchase.Parse(content, signature)
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, `"kept"`, string(purchase.Extra["newField"]))
+	assert.IsType(t, IssuingPurchase.IssuingPurchase{}, purchase)
+}
+
+func jsonString(value string) string {
+	bytes, _ := json.Marshal(value)
+	return string(bytes)
+}
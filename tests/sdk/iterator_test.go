@@ -0,0 +1,112 @@
+package sdk
+
+import (
+	"context"
+	"github.com/starkinfra/sdk-go/starkinfra"
+	Utils "github.com/starkinfra/sdk-go/tests/utils"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+func pagingClient(responses ...scriptedResponse) (*starkinfra.Client, *scriptedTransport) {
+	transport := &scriptedTransport{responses: responses}
+	client := starkinfra.NewClient(Utils.ExampleProject)
+	client.HttpClient = &http.Client{Transport: transport}
+	return client, transport
+}
+
+func TestIteratorPages(t *testing.T) {
+
+	client, transport := pagingClient(
+		scriptedResponse{200, `{"cursor": "next", "requests": [{"id": "1"}, {"id": "2"}]}`},
+		scriptedResponse{200, `{"cursor": null, "requests": [{"id": "3"}]}`},
+	)
+
+	requests := client.PixRequest.Iterate(map[string]interface{}{"status": "success"})
+	defer requests.Close()
+	var ids []string
+	for requests.Next() {
+		ids = append(ids, requests.Value().Id)
+	}
+	assert.Nil(t, requests.Err().Errors)
+	assert.Equal(t, []string{"1", "2", "3"}, ids)
+	assert.Equal(t, 2, len(transport.requests))
+	assert.Equal(t, "next", transport.requests[1].URL.Query().Get("cursor"))
+	assert.Equal(t, "success", transport.requests[1].URL.Query().Get("status"))
+}
+
+func TestIteratorLimit(t *testing.T) {
+
+	client, transport := pagingClient(
+		scriptedResponse{200, `{"cursor": "next", "requests": [{"id": "1"}, {"id": "2"}, {"id": "3"}]}`},
+	)
+
+	requests := client.PixRequest.Iterate(map[string]interface{}{"limit": 2})
+	var ids []string
+	for requests.Next() {
+		ids = append(ids, requests.Value().Id)
+	}
+	assert.Equal(t, []string{"1", "2"}, ids)
+	assert.Equal(t, 1, len(transport.requests))
+	assert.Equal(t, "2", transport.requests[0].URL.Query().Get("limit"))
+}
+
+func TestIteratorClose(t *testing.T) {
+
+	client, transport := pagingClient(
+		scriptedResponse{200, `{"cursor": "next", "requests": [{"id": "1"}, {"id": "2"}]}`},
+	)
+
+	requests := client.PixRequest.Iterate(nil)
+	assert.True(t, requests.Next())
+	requests.Close()
+	assert.False(t, requests.Next())
+	assert.Nil(t, requests.Err().Errors)
+	assert.Equal(t, 1, len(transport.requests))
+}
+
+func TestIteratorErrors(t *testing.T) {
+
+	client, _ := pagingClient(
+		scriptedResponse{200, `{"cursor": "next", "requests": [{"id": "1"}]}`},
+		scriptedResponse{400, `{"errors": [{"code": "invalidCursor", "message": "cursor expired"}]}`},
+	)
+	requests := client.PixRequest.Iterate(nil)
+	assert.True(t, requests.Next())
+	assert.False(t, requests.Next())
+	assert.Equal(t, "invalidCursor", requests.Err().Errors[0].Code)
+
+	client, _ = pagingClient(
+		scriptedResponse{200, `{"cursor": null, "requests": [{"id": "1", "amount": "oops"}, {"id": "2"}]}`},
+	)
+	requests = client.PixRequest.Iterate(nil)
+	assert.False(t, requests.Next())
+	assert.False(t, requests.Next())
+	assert.Equal(t, starkinfra.CodeDecodeError, requests.Err().Errors[0].Code)
+
+	client, _ = pagingClient(
+		scriptedResponse{200, `{"cursor": 5, "requests": [{"id": "1"}]}`},
+	)
+	requests = client.PixRequest.Iterate(nil)
+	assert.False(t, requests.Next())
+	assert.Equal(t, starkinfra.CodeDecodeError, requests.Err().Errors[0].Code)
+}
+
+func TestQueryDecodeError(t *testing.T) {
+
+	client, _ := pagingClient(
+		scriptedResponse{200, `{"cursor": null, "requests": [1, {"id": "2"}]}`},
+	)
+	entities, errorChannel := client.Query(context.Background(), map[string]string{"name": "PixRequest"}, nil)
+	err := <-errorChannel
+	assert.Equal(t, starkinfra.CodeDecodeError, err.Errors[0].Code)
+	assert.Equal(t, "2", (<-entities)["id"])
+}
+
+func TestIteratorBalanceError(t *testing.T) {
+
+	client, _ := pagingClient(scriptedResponse{500, ""})
+	_, err := client.PixBalance.Get(nil)
+	assert.Equal(t, starkinfra.CodeInternalServerError, err.Errors[0].Code)
+}
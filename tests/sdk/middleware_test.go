@@ -0,0 +1,90 @@
+package sdk
+
+import (
+	"errors"
+	"github.com/starkinfra/sdk-go/starkinfra"
+	PixRequest "github.com/starkinfra/sdk-go/starkinfra/pixrequest"
+	"github.com/starkinfra/sdk-go/starkinfra/utils"
+	Utils "github.com/starkinfra/sdk-go/tests/utils"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func standIn(server *httptest.Server) utils.Middleware {
+	return func(next utils.Handler) utils.Handler {
+		return func(request *utils.Request) (*utils.Response, error) {
+			request.BaseUrl = server.URL + "/v2"
+			return next(request)
+		}
+	}
+}
+
+func TestMiddlewareStandInServer(t *testing.T) {
+
+	var paths []string
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		paths = append(paths, r.URL.RequestURI())
+		bodies = append(bodies, string(body))
+		assert.NotEmpty(t, r.Header.Get("Access-Signature"))
+		w.Header().Set("Request-Id", "42")
+		w.Write([]byte(`{"requests": [{"id": "5656565656565656", "amount": 1000}]}`))
+	}))
+	defer server.Close()
+
+	var seen []*utils.Response
+	audit := func(next utils.Handler) utils.Handler {
+		return func(request *utils.Request) (*utils.Response, error) {
+			response, err := next(request)
+			seen = append(seen, response)
+			return response, err
+		}
+	}
+
+	client := starkinfra.NewClient(Utils.ExampleProject)
+	client.Middlewares = []utils.Middleware{audit, standIn(server)}
+
+	requests, err := client.PixRequest.Create([]PixRequest.PixRequest{{Amount: 1000}})
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, "5656565656565656", requests[0].Id)
+
+	response, err := client.Request.Get("pix-request", map[string]interface{}{"limit": 1})
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, 200, response.Status)
+
+	assert.Equal(t, []string{"/v2/pix-request", "/v2/pix-request?limit=1"}, paths)
+	assert.Contains(t, bodies[0], `"amount":1000`)
+	assert.Equal(t, 2, len(seen))
+	assert.Equal(t, "42", seen[1].Header.Get("Request-Id"))
+}
+
+func TestMiddlewareFaultInjection(t *testing.T) {
+
+	failures := 2
+	faults := func(next utils.Handler) utils.Handler {
+		return func(request *utils.Request) (*utils.Response, error) {
+			if failures > 0 {
+				failures--
+				return nil, errors.New("connection reset by fault injection")
+			}
+			return &utils.Response{Status: 200, Content: []byte(`{"request": {"id": "5656565656565656"}}`)}, nil
+		}
+	}
+
+	client := starkinfra.NewClient(Utils.ExampleProject)
+	client.Middlewares = []utils.Middleware{faults}
+
+	_, err := client.PixRequest.Get("5656565656565656")
+	assert.Contains(t, err.Errors[0].Message, "connection reset by fault injection")
+
+	client.Retry = utils.Retry{Attempts: 2, BaseDelay: time.Millisecond}
+	failures = 2
+	request, err := client.PixRequest.Get("5656565656565656")
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, "5656565656565656", request.Id)
+}
@@ -0,0 +1,113 @@
+package sdk
+
+import (
+	"context"
+	"github.com/starkbank/ecdsa-go/v2/ellipticcurve/curve"
+	"github.com/starkbank/ecdsa-go/v2/ellipticcurve/ecdsa"
+	"github.com/starkbank/ecdsa-go/v2/ellipticcurve/privatekey"
+	"github.com/starkbank/ecdsa-go/v2/ellipticcurve/publickey"
+	"github.com/starkinfra/sdk-go/starkinfra/utils"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"sync"
+	"testing"
+)
+
+type refreshRecorder struct {
+	keys      []publickey.PublicKey
+	refreshes []bool
+}
+
+func (r *refreshRecorder) PublicKeys(ctx context.Context, refresh bool) ([]publickey.PublicKey, error) {
+	r.refreshes = append(r.refreshes, refresh)
+	return r.keys, nil
+}
+
+func TestStaticPublicKeyOffline(t *testing.T) {
+
+	key := privatekey.New(curve.Secp256k1)
+	provider, err := utils.NewStaticPublicKey(key.PublicKey().ToPem())
+	assert.Nil(t, err.Errors)
+
+	client, transport := pagingClient(scriptedResponse{500, `{}`})
+	client.PublicKey = provider
+
+	request, err := client.PixRequest.Parse(pixRequestAuthorization, ecdsa.Sign(pixRequestAuthorization, &key).ToBase64())
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, 1000, request.Amount)
+
+	event, err := client.Event.Parse(pixRequestEvent, ecdsa.Sign(pixRequestEvent, &key).ToBase64())
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, "pix-request.in", event.Subscription)
+
+	uuid, err := client.DynamicBrcode.Verify("21f174ab942843eb90837a5c3135dfd6", ecdsa.Sign("21f174ab942843eb90837a5c3135dfd6", &key).ToBase64())
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, "21f174ab942843eb90837a5c3135dfd6", uuid)
+
+	other := privatekey.New(curve.Secp256k1)
+	_, err = client.PixRequest.Parse(pixRequestAuthorization, ecdsa.Sign(pixRequestAuthorization, &other).ToBase64())
+	assert.Equal(t, "invalidSignatureError", err.Errors[0].Code)
+	assert.Equal(t, 0, len(transport.requests))
+}
+
+func TestStaticPublicKeyRotation(t *testing.T) {
+
+	current := privatekey.New(curve.Secp256k1)
+	next := privatekey.New(curve.Secp256k1)
+	provider, err := utils.NewStaticPublicKey(current.PublicKey().ToPem(), next.PublicKey().ToPem())
+	assert.Nil(t, err.Errors)
+
+	client, _ := pagingClient()
+	client.PublicKey = provider
+	for _, key := range []privatekey.PrivateKey{current, next} {
+		_, err := client.Verify(context.Background(), "content", ecdsa.Sign("content", &key).ToBase64())
+		assert.Nil(t, err.Errors)
+	}
+
+	_, err = utils.NewStaticPublicKey("not a key")
+	assert.Equal(t, "inputError", err.Errors[0].Code)
+	_, err = utils.NewStaticPublicKey()
+	assert.Equal(t, "inputError", err.Errors[0].Code)
+}
+
+func TestPublicKeyProviderRefresh(t *testing.T) {
+
+	key := privatekey.New(curve.Secp256k1)
+	provider := &refreshRecorder{keys: []publickey.PublicKey{privatekey.New(curve.Secp256k1).PublicKey()}}
+	client, _ := pagingClient()
+	client.PublicKey = provider
+
+	_, err := client.Verify(context.Background(), "content", ecdsa.Sign("content", &key).ToBase64())
+	assert.Equal(t, "invalidSignatureError", err.Errors[0].Code)
+	assert.Equal(t, []bool{false, true}, provider.refreshes)
+}
+
+func TestPublicKeyCache(t *testing.T) {
+
+	old := privatekey.New(curve.Secp256k1)
+	rotated := privatekey.New(curve.Secp256k1)
+	client, transport := pagingClient(
+		scriptedResponse{200, `{"publicKeys": [{"content": ` + jsonString(old.PublicKey().ToPem()) + `}]}`},
+		scriptedResponse{200, `{"publicKeys": [{"content": ` + jsonString(rotated.PublicKey().ToPem()) + `}]}`},
+	)
+	client.Host = "infra-public-key-cache-test"
+
+	var wait sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wait.Add(1)
+		go func() {
+			defer wait.Done()
+			_, err := client.Verify(context.Background(), "content", ecdsa.Sign("content", &old).ToBase64())
+			assert.Nil(t, err.Errors)
+		}()
+	}
+	wait.Wait()
+	assert.Equal(t, 1, len(transport.requests))
+
+	for i := 0; i < 2; i++ {
+		_, err := client.Verify(context.Background(), "content", ecdsa.Sign("content", &rotated).ToBase64())
+		assert.Nil(t, err.Errors)
+	}
+	assert.Equal(t, 2, len(transport.requests))
+	assert.Equal(t, http.MethodGet, transport.requests[1].Method)
+}
@@ -0,0 +1,48 @@
+package sdk
+
+import (
+	Event "github.com/starkinfra/sdk-go/starkinfra/event"
+	PixRequest "github.com/starkinfra/sdk-go/starkinfra/pixrequest"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestQueryParamsMap(t *testing.T) {
+
+	params := PixRequest.QueryParams{
+		Limit:       10,
+		After:       time.Date(2024, 1, 5, 15, 30, 0, 0, time.UTC),
+		Status:      []string{"success", "failed"},
+		EndToEndIds: []string{"E20018183202201201450u34sDGd19lz"},
+	}.Map()
+
+	assert.Equal(t, map[string]interface{}{
+		"limit":       10,
+		"after":       "2024-01-05",
+		"status":      []string{"success", "failed"},
+		"endToEndIds": []string{"E20018183202201201450u34sDGd19lz"},
+	}, params)
+
+	delivered := false
+	assert.Equal(t, map[string]interface{}{"isDelivered": false}, Event.QueryParams{IsDelivered: &delivered}.Map())
+	assert.Equal(t, map[string]interface{}{}, Event.QueryParams{}.Map())
+}
+
+func TestQueryParamsWireFormat(t *testing.T) {
+
+	client, transport := pagingClient(scriptedResponse{200, `{"cursor": null, "requests": []}`})
+
+	_, _, err := client.PixRequest.Page(PixRequest.QueryParams{
+		Limit:  5,
+		Before: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+		Tags:   []string{"tony", "stark"},
+	}.Map())
+	assert.Nil(t, err.Errors)
+
+	query := transport.requests[0].URL.Query()
+	assert.Equal(t, "5", query.Get("limit"))
+	assert.Equal(t, "2024-02-01", query.Get("before"))
+	assert.Equal(t, "tony,stark", query.Get("tags"))
+	assert.Equal(t, "", query.Get("status"))
+}
@@ -0,0 +1,102 @@
+package sdk
+
+import (
+	"github.com/starkinfra/sdk-go/starkinfra"
+	PixRequest "github.com/starkinfra/sdk-go/starkinfra/pixrequest"
+	"github.com/starkinfra/sdk-go/starkinfra/utils"
+	Utils "github.com/starkinfra/sdk-go/tests/utils"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+type scriptedResponse struct {
+	status int
+	body   string
+}
+
+type scriptedTransport struct {
+	responses []scriptedResponse
+	requests  []*http.Request
+}
+
+func (s *scriptedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	s.requests = append(s.requests, req)
+	response := s.responses[0]
+	if len(s.responses) > 1 {
+		s.responses = s.responses[1:]
+	}
+	return &http.Response{
+		StatusCode: response.status,
+		Body:       ioutil.NopCloser(strings.NewReader(response.body)),
+		Header:     http.Header{},
+		Request:    req,
+	}, nil
+}
+
+func retryingClient(transport *scriptedTransport) *starkinfra.Client {
+	client := starkinfra.NewClient(Utils.ExampleProject)
+	client.HttpClient = &http.Client{Transport: transport}
+	client.Retry = utils.Retry{Attempts: 3, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond}
+	return client
+}
+
+func TestRetryGet(t *testing.T) {
+
+	transport := &scriptedTransport{responses: []scriptedResponse{
+		{503, "unavailable"},
+		{500, "oops"},
+		{200, `{"request": {"id": "5656565656565656"}}`},
+	}}
+
+	request, err := retryingClient(transport).PixRequest.Get("5656565656565656")
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, "5656565656565656", request.Id)
+	assert.Equal(t, 3, len(transport.requests))
+}
+
+func TestRetryCreateWithoutExternalId(t *testing.T) {
+
+	transport := &scriptedTransport{responses: []scriptedResponse{
+		{500, "oops"},
+		{200, `{"requests": [{"id": "5656565656565656"}]}`},
+	}}
+
+	_, err := retryingClient(transport).PixRequest.Create([]PixRequest.PixRequest{{Amount: 100}})
+	assert.Equal(t, "internalServerError", err.Errors[0].Code)
+	assert.Equal(t, 1, len(transport.requests))
+}
+
+func TestRetryCreateReplaysDuplicateExternalId(t *testing.T) {
+
+	transport := &scriptedTransport{responses: []scriptedResponse{
+		{504, "gateway timeout"},
+		{400, `{"errors": [{"code": "invalidExternalId", "message": "externalId already in use"}]}`},
+		{200, `{"cursor": null, "requests": [{"id": "2", "externalId": "b"}, {"id": "1", "externalId": "a"}]}`},
+	}}
+
+	requests, err := retryingClient(transport).PixRequest.Create([]PixRequest.PixRequest{
+		{Amount: 100, ExternalId: "a"},
+		{Amount: 200, ExternalId: "b"},
+	})
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, "1", requests[0].Id)
+	assert.Equal(t, "2", requests[1].Id)
+	assert.Equal(t, 3, len(transport.requests))
+	assert.Equal(t, "GET", transport.requests[2].Method)
+	assert.Equal(t, "a,b", transport.requests[2].URL.Query().Get("externalIds"))
+}
+
+func TestRetryCreateDuplicateExternalIdWithoutRetry(t *testing.T) {
+
+	transport := &scriptedTransport{responses: []scriptedResponse{
+		{400, `{"errors": [{"code": "invalidExternalId", "message": "externalId already in use"}]}`},
+	}}
+
+	_, err := retryingClient(transport).PixRequest.Create([]PixRequest.PixRequest{{Amount: 100, ExternalId: "a"}})
+	assert.Equal(t, "invalidExternalId", err.Errors[0].Code)
+	assert.Equal(t, 1, len(transport.requests))
+}
@@ -0,0 +1,74 @@
+package sdk
+
+import (
+	"context"
+	"github.com/starkinfra/sdk-go/starkinfra/event"
+	PixRequest "github.com/starkinfra/sdk-go/starkinfra/pixrequest"
+	PixRequestLog "github.com/starkinfra/sdk-go/starkinfra/pixrequest/log"
+	"github.com/starkinfra/sdk-go/starkinfra/starktest"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSignerTrust(t *testing.T) {
+
+	signer := starktest.NewSigner()
+	client, transport := pagingClient(scriptedResponse{500, `{}`})
+	signer.Trust(client.Client)
+
+	content, signature, err := signer.SignJSON(map[string]interface{}{"id": "1", "amount": 1000})
+	assert.Nil(t, err)
+	request, parseError := client.PixRequest.Parse(content, signature)
+	assert.Nil(t, parseError.Errors)
+	assert.Equal(t, 1000, request.Amount)
+
+	_, parseError = client.PixRequest.Parse(content, starktest.NewSigner().Sign(content))
+	assert.Equal(t, "invalidSignatureError", parseError.Errors[0].Code)
+	assert.Equal(t, 0, len(transport.requests))
+}
+
+func TestSignerTrustDefaults(t *testing.T) {
+
+	signer := starktest.NewSigner()
+	restore := signer.TrustDefaults()
+
+	parsed, err := event.Parse(pixRequestEvent, signer.Sign(pixRequestEvent), nil)
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, "5656565656565656", parsed.Id)
+
+	restore()
+	client, _ := pagingClient(scriptedResponse{500, `{}`})
+	_, err = client.Verify(context.Background(), pixRequestEvent, signer.Sign(pixRequestEvent))
+	assert.NotNil(t, err.Errors)
+}
+
+func TestSignerRequest(t *testing.T) {
+
+	signer := starktest.NewSigner()
+	client, _ := pagingClient()
+	signer.Trust(client.Client)
+
+	var received []PixRequestLog.Log
+	handler := client.Event.NewHandler()
+	handler.OnPixRequestIn(func(log PixRequestLog.Log) error {
+		received = append(received, log)
+		return nil
+	})
+
+	request, err := signer.Request("POST", "/webhook", map[string]interface{}{"event": map[string]interface{}{
+		"id":           "1",
+		"subscription": "pix-request.in",
+		"log":          PixRequestLog.Log{Id: "2", Type: "credited", Request: PixRequest.PixRequest{Amount: 500}},
+	}})
+	assert.Nil(t, err)
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, 1, len(received))
+	assert.Equal(t, 500, received[0].Request.Amount)
+
+	_, err = signer.Request("POST", "/webhook", make(chan int))
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,53 @@
+package sdk
+
+import (
+	PixRequest "github.com/starkinfra/sdk-go/starkinfra/pixrequest"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestStreamFreshValues(t *testing.T) {
+
+	client := erroringClient(200, `{"cursor": null, "requests": [
+		{"id": "1", "tags": ["a", "b"], "amount": 100},
+		{"id": "2", "tags": ["c"]}
+	]}`)
+
+	requests, errorChannel := client.PixRequest.Query(nil)
+	var received []PixRequest.PixRequest
+	for request := range requests {
+		received = append(received, request)
+	}
+	for err := range errorChannel {
+		assert.Nil(t, err.Errors)
+	}
+
+	assert.Equal(t, 2, len(received))
+	assert.Equal(t, []string{"a", "b"}, received[0].Tags)
+	assert.Equal(t, []string{"c"}, received[1].Tags)
+	assert.Equal(t, 100, received[0].Amount)
+	assert.Equal(t, 0, received[1].Amount)
+}
+
+func TestStreamDecodeError(t *testing.T) {
+
+	client := erroringClient(200, `{"cursor": null, "requests": [{"id": "1", "amount": "oops"}, {"id": "2"}]}`)
+
+	requests, errorChannel := client.PixRequest.Query(nil)
+	var ids []string
+	var codes []string
+	loop:
+	for {
+		select {
+		case err := <-errorChannel:
+			codes = append(codes, err.Errors[0].Code)
+		case request, ok := <-requests:
+			if !ok {
+				break loop
+			}
+			ids = append(ids, request.Id)
+		}
+	}
+	assert.Equal(t, []string{"2"}, ids)
+	assert.Equal(t, []string{"decodeError"}, codes)
+}
@@ -0,0 +1,63 @@
+package sdk
+
+import (
+	"github.com/starkinfra/sdk-go/starkinfra/webhook"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+var registeredWebhooks = `{"cursor": null, "webhooks": [
+	{"id": "1", "url": "https://example.com/a", "subscriptions": ["pix-request.in", "pix-key"]},
+	{"id": "2", "url": "https://example.com/b", "subscriptions": ["issuing-card"]},
+	{"id": "3", "url": "https://example.com/a", "subscriptions": ["pix-key", "pix-request.in"]}
+]}`
+
+var desiredWebhooks = []webhook.Webhook{
+	{Url: "https://example.com/a", Subscriptions: []string{"pix-key", "pix-request.in", "pix-key"}},
+	{Url: "https://example.com/b", Subscriptions: []string{"issuing-card", "issuing-purchase"}},
+}
+
+func TestWebhookReconcileDryRun(t *testing.T) {
+
+	client, transport := pagingClient(scriptedResponse{200, registeredWebhooks})
+	plan, err := client.Webhook.Reconcile(desiredWebhooks, true)
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, 1, len(transport.requests))
+	assert.Equal(t, []webhook.Webhook{{Url: "https://example.com/b", Subscriptions: []string{"issuing-card", "issuing-purchase"}}}, plan.Create)
+	assert.Equal(t, []string{"2", "3"}, []string{plan.Delete[0].Id, plan.Delete[1].Id})
+	assert.Equal(t, "1", plan.Keep[0].Id)
+	assert.False(t, plan.Empty())
+	assert.Equal(t, "+ https://example.com/b issuing-card,issuing-purchase\n"+
+		"- https://example.com/b issuing-card (2)\n"+
+		"- https://example.com/a pix-key,pix-request.in (3)\n"+
+		"= https://example.com/a pix-key,pix-request.in (1)", plan.String())
+}
+
+func TestWebhookReconcile(t *testing.T) {
+
+	client, transport := pagingClient(
+		scriptedResponse{200, registeredWebhooks},
+		scriptedResponse{200, `{"webhook": {"id": "4", "url": "https://example.com/b", "subscriptions": ["issuing-card", "issuing-purchase"]}}`},
+		scriptedResponse{200, `{"webhook": {"id": "2"}}`},
+		scriptedResponse{200, `{"webhook": {"id": "3"}}`},
+	)
+
+	plan, err := client.Webhook.Reconcile(desiredWebhooks, false)
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, "4", plan.Create[0].Id)
+	var calls []string
+	for _, request := range transport.requests {
+		calls = append(calls, request.Method+" "+request.URL.Path)
+	}
+	assert.Equal(t, []string{"GET /v2/webhook", "POST /v2/webhook", "DELETE /v2/webhook/2", "DELETE /v2/webhook/3"}, calls)
+}
+
+func TestWebhookReconcileInvalid(t *testing.T) {
+
+	client, transport := pagingClient(scriptedResponse{200, registeredWebhooks})
+
+	_, err := client.Webhook.Reconcile([]webhook.Webhook{{Url: "https://example.com/a"}}, false)
+	assert.Equal(t, "inputError", err.Errors[0].Code)
+	assert.Equal(t, 0, len(transport.requests))
+	assert.True(t, webhook.Diff(nil, nil).Empty())
+}